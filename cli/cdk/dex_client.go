@@ -64,6 +64,7 @@ func NewClient(cmd *cobra.Command) *client.DexAPI {
 	r := httptransport.New(cfg.Host, "/api", scheme)
 	r.Context = cmd.Context()
 	r.Consumers["application/x-ndjson"] = runtime.ByteStreamConsumer()
+	r.Consumers["application/gzip"] = runtime.ByteStreamConsumer()
 	r.DefaultAuthentication = httptransport.BearerToken(accessToken)
 	r.EnableConnectionReuse()
 
@@ -0,0 +1,21 @@
+package cdk
+
+// defaultPageSize is the page size used by list commands while following
+// the pages of a list API.
+const defaultPageSize = 100
+
+// PageSize returns the page size to be requested for fetching at-most
+// 'limit' items. limit <= 0 means all items.
+func PageSize(limit int) *int64 {
+	size := int64(defaultPageSize)
+	if limit > 0 && limit < defaultPageSize {
+		size = int64(limit)
+	}
+	return &size
+}
+
+// HasMorePages returns true if next page should be fetched given the next
+// page token of the last response and number of items fetched so far.
+func HasMorePages(nextPageToken string, fetched, limit int) bool {
+	return nextPageToken != "" && (limit <= 0 || fetched < limit)
+}
@@ -0,0 +1,150 @@
+package firehoses
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/ghodss/yaml"
+	"github.com/odpf/salt/printer"
+	"github.com/odpf/salt/term"
+	"github.com/spf13/cobra"
+
+	"github.com/odpf/dex/cli/cdk"
+	"github.com/odpf/dex/generated/client/operations"
+	"github.com/odpf/dex/generated/models"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+func alertsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "alerts <project> <firehoseURN>",
+		Short: "List alerts triggered for a firehose",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			alerts, err := getFirehoseAlerts(cmd, args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			return cdk.Display(cmd, alerts, func(w io.Writer, v any) error {
+				if len(alerts) == 0 {
+					_, err := fmt.Fprintln(w, "No alerts triggered.")
+					return err
+				}
+
+				report := [][]string{{
+					term.Bold("TRIGGERED AT"), term.Bold("SEVERITY"),
+					term.Bold("METRIC"), term.Bold("VALUE"), term.Bold("RULE"),
+				}}
+				for _, alert := range alerts {
+					report = append(report, []string{
+						time.Time(alert.TriggeredAt).Local().Format(time.RFC3339),
+						colourSeverity(alert.Severity),
+						alert.Metric,
+						alert.Value,
+						alert.Rule,
+					})
+				}
+				printer.Table(w, report)
+				return nil
+			})
+		},
+	}
+
+	return cmd
+}
+
+func alertPolicyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "alert-policy <command>",
+		Short: "View or modify the alert policy of a firehose",
+		Example: heredoc.Doc(`
+			$ dex firehose alert-policy get project-x orn:entropy:firehose:project-x:my-firehose
+			$ dex firehose alert-policy set project-x orn:entropy:firehose:project-x:my-firehose ./policy.yaml
+		`),
+	}
+
+	cmd.AddCommand(
+		&cobra.Command{
+			Use:   "get <project> <firehoseURN>",
+			Short: "Display the alert policy of a firehose",
+			Args:  cobra.ExactArgs(2),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				spinner := printer.Spin("Fetching alert policy...")
+				params := &operations.GetFirehoseAlertPolicyParams{
+					ProjectSlug: args[0],
+					FirehoseUrn: args[1],
+				}
+				resp, err := cdk.NewClient(cmd).Operations.GetFirehoseAlertPolicy(params)
+				spinner.Stop()
+				if err != nil {
+					return err
+				}
+				return cdk.Display(cmd, resp.GetPayload(), cdk.YAMLFormat)
+			},
+		},
+		&cobra.Command{
+			Use:   "set <project> <firehoseURN> <filepath>",
+			Short: "Replace the alert policy of a firehose with the one in the file",
+			Args:  cobra.ExactArgs(3),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				b, err := os.ReadFile(args[2])
+				if err != nil {
+					return err
+				}
+
+				var policy models.AlertPolicy
+				if err := yaml.Unmarshal(b, &policy); err != nil {
+					return errors.Errorf("invalid alert policy file: %s", err)
+				}
+
+				spinner := printer.Spin("Updating alert policy...")
+				params := &operations.UpsertFirehoseAlertPolicyParams{
+					ProjectSlug: args[0],
+					FirehoseUrn: args[1],
+					Body:        &policy,
+				}
+				resp, err := cdk.NewClient(cmd).Operations.UpsertFirehoseAlertPolicy(params)
+				spinner.Stop()
+				if err != nil {
+					return err
+				}
+				return cdk.Display(cmd, resp.GetPayload(), cdk.YAMLFormat)
+			},
+		},
+	)
+
+	return cmd
+}
+
+func getFirehoseAlerts(cmd *cobra.Command, prjSlug, urn string) ([]*models.Alert, error) {
+	spinner := printer.Spin("Fetching alerts...")
+	defer spinner.Stop()
+
+	params := &operations.GetFirehoseAlertsParams{
+		ProjectSlug: prjSlug,
+		FirehoseUrn: urn,
+	}
+
+	dexAPI := cdk.NewClient(cmd)
+	resp, err := dexAPI.Operations.GetFirehoseAlerts(params)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetPayload().Items, nil
+}
+
+func colourSeverity(severity string) string {
+	switch strings.ToUpper(severity) {
+	case "CRITICAL":
+		return term.Red(severity)
+	case "WARNING":
+		return term.Yellow(severity)
+	default:
+		return severity
+	}
+}
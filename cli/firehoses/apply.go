@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/MakeNowJust/heredoc"
 	"github.com/odpf/salt/printer"
 	"github.com/spf13/cobra"
 
@@ -14,67 +15,114 @@ import (
 )
 
 func applyCommand() *cobra.Command {
-	var configFile string
-	var onlyCreate bool
+	var files []string
+	var onlyCreate, dryRun, plan, prune bool
 
 	cmd := &cobra.Command{
-		Use:   "apply <project> <filepath>",
-		Short: "Create/Update a firehose as described in a file",
-		Args:  cobra.ExactArgs(2),
+		Use:   "apply <project> [filepath]",
+		Short: "Create/Update firehoses as described in files",
+		Long: heredoc.Doc(`
+			Create/Update firehoses as described in files.
+
+			Files can contain multiple firehoses as YAML documents separated by '---'.
+			If a directory is given, all YAML files in it are applied. When more than
+			one firehose is declared, all firehoses of the project are reconciled with
+			the declarations and a summary is displayed.
+		`),
+		Args: cobra.RangeArgs(1, 2),
+		Example: heredoc.Doc(`
+			$ dex firehose apply project-x ./firehose.yaml
+			$ dex firehose apply project-x ./firehose.yaml --dry-run
+			$ dex firehose apply project-x ./firehose.yaml --plan
+			$ dex firehose apply project-x -f ./firehoses/ --prune
+		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			var firehoseDef models.Firehose
-			if err := readYAMLFile(args[1], &firehoseDef); err != nil {
-				return err
+			prjSlug := args[0]
+			if len(args) > 1 {
+				files = append(files, args[1])
 			}
 
-			urn := generateFirehoseURN(args[0], firehoseDef.Name)
-
-			var existing *models.Firehose
-			var err error
+			if len(files) == 0 {
+				return errors.New("no files specified. use --file or pass the file path as argument")
+			} else if prune && onlyCreate {
+				return errors.New("--prune cannot be used with --create")
+			}
 
-			isUpdate := !onlyCreate
-			if !onlyCreate {
-				notFoundErr := &operations.GetFirehoseNotFound{}
-				existing, err = getFirehose(cmd, args[0], urn)
-				if err != nil && !errors.As(err, &notFoundErr) {
-					return err
-				}
-				isUpdate = existing != nil
+			defs, err := readFirehoseDefs(files)
+			if err != nil {
+				return err
+			} else if len(defs) == 0 {
+				return errors.New("no firehoses declared in the given files")
 			}
 
-			var finalVersion *models.Firehose
-			if isUpdate {
-				// Firehose already exists. Treat this as update.
-				finalVersion, err = updateFirehose(cmd, args[0], *existing, firehoseDef)
-				if err != nil {
-					return errors.Errorf("update failed: %s", err)
-				}
-			} else {
-				// Firehose does not already exist. Treat this as create.
-				finalVersion, err = createFirehose(cmd, args[0], firehoseDef)
-				if err != nil {
-					return errors.Errorf("create failed: %s", err)
-				}
+			if len(defs) == 1 && !prune {
+				return applySingle(cmd, prjSlug, defs[0], onlyCreate, dryRun, plan)
 			}
 
-			return cdk.Display(cmd, finalVersion, func(w io.Writer, v any) error {
-				msg := "Create request placed"
-				if isUpdate {
-					msg = "Update request placed"
-				}
-				_, err := fmt.Fprintf(w, "%s.\nUse `dex firehose view %s %s` to check status.\n",
-					msg, args[0], finalVersion.Urn)
-				return err
+			return reconcileFirehoses(cmd, prjSlug, defs, reconcileOpts{
+				OnlyCreate: onlyCreate,
+				DryRun:     dryRun || plan,
+				ShowDiff:   plan,
+				Prune:      prune,
 			})
 		},
 	}
 
+	cmd.Flags().StringSliceVarP(&files, "file", "f", nil, "Files or directories with firehose definitions")
 	cmd.Flags().BoolVar(&onlyCreate, "create", false, "Allow creation only")
-	cmd.Flags().StringVarP(&configFile, "config", "c", "./config.yaml", "Config file path")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only validate the file on the server without applying")
+	cmd.Flags().BoolVar(&plan, "plan", false, "Only show the changes that would be applied")
+	cmd.Flags().BoolVar(&prune, "prune", false, "Delete firehoses in the project that are not declared, unless any firehose fails to apply")
 	return cmd
 }
 
-func createFirehose(cmd *cobra.Command, prjSlug string, def models.Firehose) (*models.Firehose, error) {
+func applySingle(cmd *cobra.Command, prjSlug string, firehoseDef models.Firehose, onlyCreate, dryRun, plan bool) error {
+	urn := generateFirehoseURN(prjSlug, firehoseDef.Name)
+
+	var existing *models.Firehose
+	var err error
+
+	isUpdate := !onlyCreate
+	if !onlyCreate {
+		notFoundErr := &operations.GetFirehoseNotFound{}
+		existing, err = getFirehose(cmd, prjSlug, urn)
+		if err != nil && !errors.As(err, &notFoundErr) {
+			return err
+		}
+		isUpdate = existing != nil
+	}
+
+	if dryRun || plan {
+		return planFirehose(cmd, prjSlug, existing, firehoseDef, plan)
+	}
+
+	var finalVersion *models.Firehose
+	if isUpdate {
+		// Firehose already exists. Treat this as update.
+		finalVersion, err = updateFirehose(cmd, prjSlug, *existing, firehoseDef, false)
+		if err != nil {
+			return errors.Errorf("update failed: %s", err)
+		}
+	} else {
+		// Firehose does not already exist. Treat this as create.
+		finalVersion, err = createFirehose(cmd, prjSlug, firehoseDef, false)
+		if err != nil {
+			return errors.Errorf("create failed: %s", err)
+		}
+	}
+
+	return cdk.Display(cmd, finalVersion, func(w io.Writer, v any) error {
+		msg := "Create request placed"
+		if isUpdate {
+			msg = "Update request placed"
+		}
+		_, err := fmt.Fprintf(w, "%s.\nUse `dex firehose view %s %s` to check status.\n",
+			msg, prjSlug, finalVersion.Urn)
+		return err
+	})
+}
+
+func createFirehose(cmd *cobra.Command, prjSlug string, def models.Firehose, dryRun bool) (*models.Firehose, error) {
 	spinner := printer.Spin("Creating new firehose")
 	defer spinner.Stop()
 
@@ -82,23 +130,37 @@ func createFirehose(cmd *cobra.Command, prjSlug string, def models.Firehose) (*m
 	params := &operations.CreateFirehoseParams{
 		Body:        &def,
 		ProjectSlug: prjSlug,
+		DryRun:      &dryRun,
 	}
 
 	dexAPI := cdk.NewClient(cmd)
-	created, createErr := dexAPI.Operations.CreateFirehose(params)
+	validated, created, createErr := dexAPI.Operations.CreateFirehose(params)
 	if createErr != nil {
 		return nil, createErr
+	} else if validated != nil {
+		return validated.GetPayload(), nil
 	}
 	return created.GetPayload(), nil
 }
 
-func updateFirehose(cmd *cobra.Command, prjSlug string, existing, updated models.Firehose) (*models.Firehose, error) {
+func updateFirehose(cmd *cobra.Command, prjSlug string, existing, updated models.Firehose, dryRun bool) (*models.Firehose, error) {
+	updateResp, err := sendUpdate(cmd, prjSlug, existing, updated, dryRun)
+	if err != nil {
+		return nil, err
+	}
+	return updateResp.GetPayload(), nil
+}
+
+// sendUpdate updates the firehose and returns the response including the
+// headers.
+func sendUpdate(cmd *cobra.Command, prjSlug string, existing, updated models.Firehose, dryRun bool) (*operations.UpdateFirehoseOK, error) {
 	spinner := printer.Spin(fmt.Sprintf("Updating %s", existing.Urn))
 	defer spinner.Stop()
 
 	params := &operations.UpdateFirehoseParams{
 		ProjectSlug: prjSlug,
 		FirehoseUrn: existing.Urn,
+		DryRun:      &dryRun,
 		Body: operations.UpdateFirehoseBody{
 			Configs:     updated.Configs,
 			Description: updated.Description,
@@ -106,9 +168,5 @@ func updateFirehose(cmd *cobra.Command, prjSlug string, existing, updated models
 	}
 
 	dexAPI := cdk.NewClient(cmd)
-	updateResp, updateErr := dexAPI.Operations.UpdateFirehose(params)
-	if updateErr != nil {
-		return nil, updateErr
-	}
-	return updateResp.GetPayload(), nil
+	return dexAPI.Operations.UpdateFirehose(params)
 }
@@ -0,0 +1,176 @@
+package firehoses
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/odpf/salt/printer"
+	"github.com/odpf/salt/term"
+	"github.com/spf13/cobra"
+
+	"github.com/odpf/dex/cli/cdk"
+	"github.com/odpf/dex/generated/client/operations"
+	"github.com/odpf/dex/generated/models"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+func autoscalePolicyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "autoscale-policy <command>",
+		Short: "View or modify the autoscale policy of a firehose",
+		Long: heredoc.Doc(`
+			View or modify the autoscale policy of a firehose.
+
+			The firehose is scaled between the min and max replicas to keep the consumer
+			lag close to the target lag. Each decision is recorded with its reason and
+			the scaling is recorded in the history of the firehose.
+		`),
+		Example: heredoc.Doc(`
+			$ dex firehose autoscale-policy get project-x orn:entropy:firehose:project-x:my-firehose
+			$ dex firehose autoscale-policy set project-x orn:entropy:firehose:project-x:my-firehose --min 1 --max 8 --target-lag 50000
+			$ dex firehose autoscale-policy delete project-x orn:entropy:firehose:project-x:my-firehose
+		`),
+	}
+
+	cmd.AddCommand(
+		&cobra.Command{
+			Use:   "get <project> <firehoseURN>",
+			Short: "Display the autoscale policy of a firehose with its recent decisions",
+			Args:  cobra.ExactArgs(2),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				spinner := printer.Spin("Fetching autoscale policy...")
+				params := &operations.GetFirehoseAutoscalePolicyParams{
+					ProjectSlug: args[0],
+					FirehoseUrn: args[1],
+				}
+				resp, err := cdk.NewClient(cmd).Operations.GetFirehoseAutoscalePolicy(params)
+				spinner.Stop()
+				if err != nil {
+					return err
+				}
+
+				policy := resp.GetPayload()
+				return cdk.Display(cmd, policy, func(w io.Writer, v any) error {
+					return printAutoscalePolicy(w, policy)
+				})
+			},
+		},
+		autoscalePolicySetCommand(),
+		&cobra.Command{
+			Use:   "delete <project> <firehoseURN>",
+			Short: "Delete the autoscale policy of a firehose",
+			Args:  cobra.ExactArgs(2),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				spinner := printer.Spin("Deleting autoscale policy...")
+				params := &operations.DeleteFirehoseAutoscalePolicyParams{
+					ProjectSlug: args[0],
+					FirehoseUrn: args[1],
+				}
+				_, err := cdk.NewClient(cmd).Operations.DeleteFirehoseAutoscalePolicy(params)
+				spinner.Stop()
+				if err != nil {
+					return errors.Errorf("delete autoscale policy failed: %s", err)
+				}
+
+				res := map[string]string{"urn": args[1], "result": resultDeleted}
+				return cdk.Display(cmd, res, func(w io.Writer, v any) error {
+					_, err := fmt.Fprintf(w, "%s Autoscale policy of %s deleted.\n", term.SuccessIcon(), args[1])
+					return err
+				})
+			},
+		},
+	)
+
+	return cmd
+}
+
+func autoscalePolicySetCommand() *cobra.Command {
+	var minReplicas, maxReplicas int64
+	var targetLag int64
+	var cooldown time.Duration
+	var disabled bool
+
+	cmd := &cobra.Command{
+		Use:   "set <project> <firehoseURN>",
+		Short: "Create or replace the autoscale policy of a firehose",
+		Args:  cobra.ExactArgs(2),
+		Example: heredoc.Doc(`
+			$ dex firehose autoscale-policy set project-x orn:entropy:firehose:project-x:my-firehose --min 1 --max 8 --target-lag 50000 --cooldown 10m
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			enabled := !disabled
+			cooldownSeconds := int64(cooldown / time.Second)
+
+			spinner := printer.Spin("Updating autoscale policy...")
+			params := &operations.UpsertFirehoseAutoscalePolicyParams{
+				ProjectSlug: args[0],
+				FirehoseUrn: args[1],
+				Body: operations.UpsertFirehoseAutoscalePolicyBody{
+					MinReplicas:     &minReplicas,
+					MaxReplicas:     &maxReplicas,
+					TargetLag:       &targetLag,
+					CooldownSeconds: cooldownSeconds,
+					Enabled:         &enabled,
+				},
+			}
+			resp, err := cdk.NewClient(cmd).Operations.UpsertFirehoseAutoscalePolicy(params)
+			spinner.Stop()
+			if err != nil {
+				return errors.Errorf("update autoscale policy failed: %s", err)
+			}
+
+			policy := resp.GetPayload()
+			return cdk.Display(cmd, policy, func(w io.Writer, v any) error {
+				return printAutoscalePolicy(w, policy)
+			})
+		},
+	}
+
+	cmd.Flags().Int64Var(&minReplicas, "min", 1, "Minimum number of replicas")
+	cmd.Flags().Int64Var(&maxReplicas, "max", 0, "Maximum number of replicas")
+	cmd.Flags().Int64Var(&targetLag, "target-lag", 0, "Consumer lag to maintain across the partitions of the topic")
+	cmd.Flags().DurationVar(&cooldown, "cooldown", 5*time.Minute, "Minimum duration between two scalings")
+	cmd.Flags().BoolVar(&disabled, "disabled", false, "Keep the policy without evaluating it")
+	_ = cmd.MarkFlagRequired("max")
+	_ = cmd.MarkFlagRequired("target-lag")
+	return cmd
+}
+
+func printAutoscalePolicy(w io.Writer, p *models.AutoscalePolicy) error {
+	_, _ = fmt.Fprintf(w, "Autoscale policy of %s\n", term.Bold(p.Urn))
+	_, _ = fmt.Fprintf(w, "Replicas:   %d - %d\n", p.MinReplicas, p.MaxReplicas)
+	_, _ = fmt.Fprintf(w, "Target lag: %d\n", p.TargetLag)
+	_, _ = fmt.Fprintf(w, "Cooldown:   %s\n", time.Duration(p.CooldownSeconds)*time.Second)
+	_, _ = fmt.Fprintf(w, "Enabled:    %t\n", p.Enabled)
+
+	if len(p.Decisions) == 0 {
+		return nil
+	}
+
+	report := [][]string{{
+		term.Bold("AT"), term.Bold("LAG"), term.Bold("REPLICAS"),
+		term.Bold("RESULT"), term.Bold("REASON"), term.Bold("OPERATION"),
+	}}
+	for _, d := range p.Decisions {
+		result := term.Green("scaled")
+		if d.Error != "" {
+			result = term.Red("failed: " + d.Error)
+		} else if !d.Scaled {
+			result = term.Yellow("held")
+		}
+
+		report = append(report, []string{
+			formatDateTime(d.At),
+			fmt.Sprint(d.Lag),
+			fmt.Sprintf("%d → %d", d.CurrentReplicas, d.DesiredReplicas),
+			result,
+			d.Reason,
+			d.OperationID,
+		})
+	}
+	_, _ = fmt.Fprintln(w)
+	printer.Table(w, report)
+	return nil
+}
@@ -0,0 +1,94 @@
+package firehoses
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/odpf/salt/printer"
+	"github.com/odpf/salt/term"
+	"github.com/spf13/cobra"
+
+	"github.com/odpf/dex/cli/cdk"
+	"github.com/odpf/dex/generated/client/operations"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+func cloneCommand() *cobra.Command {
+	var body operations.CloneFirehoseBody
+	var envVars []string
+	var dryRun bool
+	var wait waitOpts
+
+	cmd := &cobra.Command{
+		Use:   "clone <project> <firehoseURN>",
+		Short: "Clone an existing firehose",
+		Long: heredoc.Doc(`
+			Clone an existing firehose into the same or another project.
+
+			The clone uses its own consumer group ('<project>-<name>' unless set)
+			so that it does not share offsets with the source firehose.
+		`),
+		Args: cobra.ExactArgs(2),
+		Example: heredoc.Doc(`
+			$ dex firehose clone project-x orn:entropy:firehose:project-x:my-firehose --name my-firehose-copy
+			$ dex firehose clone project-x orn:entropy:firehose:project-x:my-firehose --to-project project-y \
+				--kube-cluster orn:entropy:kubernetes:project-y:cluster-1 --env SINK_BIGQUERY_DATASET_NAME=staging --stopped
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			prjSlug, urn := args[0], args[1]
+
+			if len(envVars) > 0 {
+				body.EnvVars = map[string]string{}
+			}
+			for _, kv := range envVars {
+				k, v, found := strings.Cut(kv, "=")
+				if !found || strings.TrimSpace(k) == "" {
+					return errors.Errorf("--env must be in 'KEY=value' format, not '%s'", kv)
+				}
+				body.EnvVars[strings.TrimSpace(k)] = v
+			}
+
+			spinner := printer.Spin("Cloning firehose")
+			params := &operations.CloneFirehoseParams{
+				Body:        body,
+				ProjectSlug: prjSlug,
+				FirehoseUrn: urn,
+				DryRun:      &dryRun,
+			}
+
+			dexAPI := cdk.NewClient(cmd)
+			validated, cloned, err := dexAPI.Operations.CloneFirehose(params)
+			spinner.Stop()
+			if err != nil {
+				return errors.Errorf("clone failed: %s", err)
+			}
+
+			if dryRun {
+				return cdk.Display(cmd, validated.GetPayload(), func(w io.Writer, v any) error {
+					_, err := fmt.Fprintf(w, "%s Validation successful. No changes were applied.\n", term.SuccessIcon())
+					return err
+				})
+			}
+
+			created := cloned.GetPayload()
+			targetPrj := prjSlug
+			if body.Project != "" {
+				targetPrj = body.Project
+			}
+			return finishAction(cmd, targetPrj, created.Urn, wait, created, "Clone")
+		},
+	}
+
+	cmd.Flags().StringVar(&body.Project, "to-project", "", "Project to create the clone in (defaults to the project of the source)")
+	cmd.Flags().StringVar(&body.Name, "name", "", "Name of the clone (defaults to the name of the source)")
+	cmd.Flags().StringVar(&body.Title, "title", "", "Title of the clone (defaults to the title of the source)")
+	cmd.Flags().StringVar(&body.KubeCluster, "kube-cluster", "", "URN of the kubernetes cluster to deploy the clone to")
+	cmd.Flags().StringVar(&body.ConsumerGroupID, "consumer-group", "", "Kafka consumer group of the clone")
+	cmd.Flags().StringArrayVar(&envVars, "env", nil, "Env var to override in 'KEY=value' format (can be repeated)")
+	cmd.Flags().BoolVar(&body.Stopped, "stopped", false, "Create the clone in stopped state")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only validate the clone without creating")
+	wait.addFlags(cmd)
+	return cmd
+}
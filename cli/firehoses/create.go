@@ -0,0 +1,126 @@
+package firehoses
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/odpf/salt/printer"
+	"github.com/odpf/salt/term"
+	"github.com/spf13/cobra"
+
+	"github.com/odpf/dex/cli/cdk"
+	"github.com/odpf/dex/generated/client/operations"
+	"github.com/odpf/dex/generated/models"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+func createCommand() *cobra.Command {
+	var file, template string
+	var vars []string
+	var def models.Firehose
+	var dryRun bool
+	var wait waitOpts
+
+	cmd := &cobra.Command{
+		Use:   "create <project>",
+		Short: "Create a new firehose",
+		Long: heredoc.Doc(`
+			Create a new firehose from a definition file or a firehose template.
+
+			When a template is given, configs missing from the definition are
+			rendered from the template. Template variables are set using --var.
+		`),
+		Args: cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ dex firehose create project-x -f ./firehose.yaml
+			$ dex firehose create project-x --template kafka-to-bq --title "Booking Events" \
+				--kube-cluster orn:entropy:kubernetes:project-x:cluster-1 --var topic=bookings
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			prjSlug := args[0]
+
+			var firehoseDef models.Firehose
+			if file != "" {
+				defs, err := readFirehoseDefs([]string{file})
+				if err != nil {
+					return err
+				} else if len(defs) != 1 {
+					return errors.Errorf("file must declare exactly one firehose, found %d", len(defs))
+				}
+				firehoseDef = defs[0]
+			} else if template == "" {
+				return errors.New("either --file or --template must be specified")
+			}
+
+			// flags take precedence over the file.
+			if def.Title != "" {
+				firehoseDef.Title = def.Title
+			}
+			if def.Name != "" {
+				firehoseDef.Name = def.Name
+			}
+			if def.Description != "" {
+				firehoseDef.Description = def.Description
+			}
+			if def.KubeCluster != "" {
+				firehoseDef.KubeCluster = def.KubeCluster
+			}
+
+			created, err := createFirehoseFromTemplate(cmd, prjSlug, firehoseDef, template, vars, dryRun)
+			if err != nil {
+				return errors.Errorf("create failed: %s", err)
+			}
+
+			if dryRun {
+				return cdk.Display(cmd, created, func(w io.Writer, v any) error {
+					_, err := fmt.Fprintf(w, "%s Validation successful. No changes were applied.\n", term.SuccessIcon())
+					return err
+				})
+			}
+			return finishAction(cmd, prjSlug, created.Urn, wait, created, "Create")
+		},
+	}
+
+	cmd.Flags().StringVarP(&file, "file", "f", "", "File with the firehose definition")
+	cmd.Flags().StringVar(&template, "template", "", "Name of the firehose template to render configs from")
+	cmd.Flags().StringArrayVar(&vars, "var", nil, "Template variable in 'name=value' format (can be repeated)")
+	cmd.Flags().StringVar(&def.Title, "title", "", "Title of the firehose")
+	cmd.Flags().StringVar(&def.Name, "name", "", "Name of the firehose (derived from title if not set)")
+	cmd.Flags().StringVar(&def.Description, "description", "", "Description of the firehose")
+	cmd.Flags().StringVar(&def.KubeCluster, "kube-cluster", "", "URN of the kubernetes cluster to deploy to")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only validate and render the firehose without creating")
+	wait.addFlags(cmd)
+	return cmd
+}
+
+func createFirehoseFromTemplate(cmd *cobra.Command, prjSlug string, def models.Firehose,
+	template string, vars []string, dryRun bool,
+) (*models.Firehose, error) {
+	for _, v := range vars {
+		if name, _, found := strings.Cut(v, "="); !found || strings.TrimSpace(name) == "" {
+			return nil, errors.Errorf("--var must be in 'name=value' format, not '%s'", v)
+		}
+	}
+
+	spinner := printer.Spin("Creating new firehose")
+	defer spinner.Stop()
+
+	params := &operations.CreateFirehoseParams{
+		Body:        &def,
+		ProjectSlug: prjSlug,
+		DryRun:      &dryRun,
+		Template:    optionalString(template),
+		Var:         vars,
+	}
+
+	dexAPI := cdk.NewClient(cmd)
+	validated, created, err := dexAPI.Operations.CreateFirehose(params)
+	if err != nil {
+		return nil, err
+	} else if validated != nil {
+		return validated.GetPayload(), nil
+	}
+	return created.GetPayload(), nil
+}
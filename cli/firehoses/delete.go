@@ -0,0 +1,71 @@
+package firehoses
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/odpf/salt/printer"
+	"github.com/odpf/salt/prompt"
+	"github.com/odpf/salt/term"
+	"github.com/spf13/cobra"
+
+	"github.com/odpf/dex/cli/cdk"
+	"github.com/odpf/dex/generated/client/operations"
+)
+
+func deleteCommand() *cobra.Command {
+	var skipConfirm bool
+
+	cmd := &cobra.Command{
+		Use:   "delete <project> <firehoseURN>",
+		Short: "Delete a firehose",
+		Long:  "Delete a firehose. The firehose is stopped and all its resources are released.",
+		Args:  cobra.ExactArgs(2),
+		Example: heredoc.Doc(`
+			$ dex firehose delete project-x orn:entropy:firehose:project-x:my-firehose
+			$ dex firehose delete project-x orn:entropy:firehose:project-x:my-firehose --yes
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			prjSlug, urn := args[0], args[1]
+
+			if !skipConfirm {
+				msg := fmt.Sprintf("Delete firehose '%s'? This cannot be undone.", urn)
+				confirmed, err := prompt.New().Confirm(msg, false)
+				if err != nil {
+					return err
+				} else if !confirmed {
+					_, _ = fmt.Fprintln(cmd.OutOrStdout(), "Aborted. Firehose was not deleted.")
+					return nil
+				}
+			}
+
+			if err := deleteFirehose(cmd, prjSlug, urn); err != nil {
+				return err
+			}
+
+			res := map[string]string{"urn": urn, "result": resultDeleted}
+			return cdk.Display(cmd, res, func(w io.Writer, v any) error {
+				_, err := fmt.Fprintf(w, "%s Firehose %s deleted.\n", term.SuccessIcon(), urn)
+				return err
+			})
+		},
+	}
+
+	cmd.Flags().BoolVarP(&skipConfirm, "yes", "y", false, "Skip the confirmation prompt")
+	return cmd
+}
+
+func deleteFirehose(cmd *cobra.Command, prjSlug, urn string) error {
+	spinner := printer.Spin(fmt.Sprintf("Deleting %s", urn))
+	defer spinner.Stop()
+
+	params := &operations.DeleteFirehoseParams{
+		ProjectSlug: prjSlug,
+		FirehoseUrn: urn,
+	}
+
+	dexAPI := cdk.NewClient(cmd)
+	_, err := dexAPI.Operations.DeleteFirehose(params)
+	return err
+}
@@ -2,15 +2,23 @@ package firehoses
 
 import (
 	"encoding/json"
+	"io/fs"
 	"os"
+	"path/filepath"
+	"regexp"
 	"strings"
 	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/ghodss/yaml"
 	"github.com/spf13/cobra"
+
+	"github.com/odpf/dex/generated/models"
+	"github.com/odpf/dex/pkg/errors"
 )
 
+var yamlDocSeparator = regexp.MustCompile(`(?m)^---[ \t]*$`)
+
 func Commands() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "firehose <command>",
@@ -19,7 +27,7 @@ func Commands() *cobra.Command {
 		Long:    "You can create/manage/view firehoses using this command.",
 		Example: heredoc.Doc(`
 			$ dex firehose list project-x
-			$ dex firehose create -c ./config.yaml
+			$ dex firehose create project-x -f ./firehose.yaml
 		`),
 		Annotations: map[string]string{
 			"group": "core",
@@ -29,6 +37,10 @@ func Commands() *cobra.Command {
 	cmd.AddCommand(
 		viewCommand(),
 		listCommand(),
+		createCommand(),
+		cloneCommand(),
+		migrateCommand(),
+		rollbackCommand(),
 		applyCommand(),
 		scaleCommand(),
 		startCommand(),
@@ -36,24 +48,87 @@ func Commands() *cobra.Command {
 		logsCommand(),
 		upgradeCommand(),
 		resetOffsetCommand(),
+		deleteCommand(),
+		historyCommand(),
+		lagCommand(),
+		alertsCommand(),
+		alertPolicyCommand(),
+		scheduleCommand(),
+		autoscalePolicyCommand(),
+		watchCommand(),
 	)
 
 	cmd.PersistentFlags().DurationP("timeout", "T", 10*time.Second, "Timeout for the operation")
 	return cmd
 }
 
-func readYAMLFile(filePath string, into interface{}) error {
-	b, err := os.ReadFile(filePath)
-	if err != nil {
-		return err
+// readFirehoseDefs reads firehose definitions from the given paths. Paths can
+// be files or directories. All YAML files in a directory (recursively) are read.
+// A file can contain multiple definitions as YAML documents separated by '---'.
+func readFirehoseDefs(paths []string) ([]models.Firehose, error) {
+	var defs []models.Firehose
+	for _, p := range paths {
+		files, err := collectYAMLFiles(p)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, filePath := range files {
+			b, err := os.ReadFile(filePath)
+			if err != nil {
+				return nil, err
+			}
+
+			for i, doc := range yamlDocSeparator.Split(string(b), -1) {
+				jsonB, err := yaml.YAMLToJSON([]byte(doc))
+				if err != nil {
+					return nil, errors.Errorf("%s (document %d): %s", filePath, i+1, err)
+				} else if string(jsonB) == "null" {
+					continue // empty document.
+				}
+
+				var def models.Firehose
+				if err := json.Unmarshal(jsonB, &def); err != nil {
+					return nil, errors.Errorf("%s (document %d): %s", filePath, i+1, err)
+				}
+				defs = append(defs, def)
+			}
+		}
 	}
+	return defs, nil
+}
 
-	jsonB, err := yaml.YAMLToJSON(b)
+func collectYAMLFiles(path string) ([]string, error) {
+	fi, err := os.Stat(path)
 	if err != nil {
-		return err
+		return nil, err
+	} else if !fi.IsDir() {
+		return []string{path}, nil
 	}
 
-	return json.Unmarshal(jsonB, into)
+	var files []string
+	walkErr := filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		ext := strings.ToLower(filepath.Ext(p))
+		if !d.IsDir() && (ext == ".yaml" || ext == ".yml") {
+			files = append(files, p)
+		}
+		return nil
+	})
+	return files, walkErr
+}
+
+// optionalString returns nil if the given string is empty after trimming
+// spaces. This is useful for setting optional query params.
+func optionalString(s string) *string {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+	return &s
 }
 
 func generateFirehoseURN(project, name string) string {
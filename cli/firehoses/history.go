@@ -0,0 +1,348 @@
+package firehoses
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/go-openapi/strfmt"
+	"github.com/odpf/salt/printer"
+	"github.com/odpf/salt/term"
+	"github.com/spf13/cobra"
+
+	"github.com/odpf/dex/cli/cdk"
+	"github.com/odpf/dex/generated/client/operations"
+	"github.com/odpf/dex/generated/models"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+const defaultHistoryFormat = "delta"
+
+type historyOpts struct {
+	Format   string
+	Actor    string
+	Reason   string
+	Since    string
+	Until    string
+	Limit    int
+	Revision int
+}
+
+func historyCommand() *cobra.Command {
+	var opts historyOpts
+
+	cmd := &cobra.Command{
+		Use:   "history <project> <firehoseURN>",
+		Short: "Show revision history of a firehose",
+		Long: heredoc.Doc(`
+			Show revision history of a firehose as a timeline.
+
+			Each revision shows the time, the reason, the user who made the change
+			and the changes from the previous revision. By default the fields that
+			were added (+), modified (~) or removed (-) are shown. Use --format to
+			show a unified diff or a JSON Patch instead.
+
+			Use --revision to show the full spec of the firehose at a revision.
+		`),
+		Args: cobra.ExactArgs(2),
+		Example: heredoc.Doc(`
+			$ dex firehose history project-x orn:entropy:firehose:project-x:my-firehose
+			$ dex firehose history project-x orn:entropy:firehose:project-x:my-firehose --format unified --limit 5
+			$ dex firehose history project-x orn:entropy:firehose:project-x:my-firehose --actor john@example.com --since 2022-10-01T00:00:00Z
+			$ dex firehose history project-x orn:entropy:firehose:project-x:my-firehose --revision 3
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Revision > 0 {
+				rev, err := getFirehoseRevision(cmd, args[0], args[1], opts.Revision)
+				if err != nil {
+					return err
+				}
+
+				return cdk.Display(cmd, rev, func(w io.Writer, v any) error {
+					return printRevision(w, rev)
+				})
+			}
+
+			revisions, err := getFirehoseHistory(cmd, args[0], args[1], opts)
+			if err != nil {
+				return err
+			}
+
+			return cdk.Display(cmd, revisions, func(w io.Writer, v any) error {
+				return printHistory(w, revisions)
+			})
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&opts.Format, "format", defaultHistoryFormat, "Format of the changes (delta, unified or jsonpatch)")
+	flags.StringVar(&opts.Actor, "actor", "", "Only show the revisions made by the user with this email or id")
+	flags.StringVar(&opts.Reason, "reason", "", "Only show the revisions whose reason contains this text")
+	flags.StringVar(&opts.Since, "since", "", "Only show the revisions created at or after this time (RFC3339)")
+	flags.StringVar(&opts.Until, "until", "", "Only show the revisions created at or before this time (RFC3339)")
+	flags.IntVar(&opts.Limit, "limit", 0, "Only show the most recent revisions")
+	flags.IntVar(&opts.Revision, "revision", 0, "Show the full spec at this revision")
+	return cmd
+}
+
+func getFirehoseHistory(cmd *cobra.Command, prjSlug, urn string, opts historyOpts) ([]*models.RevisionDiff, error) {
+	params := &operations.GetFirehoseHistoryParams{
+		ProjectSlug: prjSlug,
+		FirehoseUrn: urn,
+		Format:      optionalString(opts.Format),
+		Actor:       optionalString(opts.Actor),
+		Reason:      optionalString(opts.Reason),
+	}
+
+	var err error
+	if params.Since, err = optionalDateTime("since", opts.Since); err != nil {
+		return nil, err
+	}
+	if params.Until, err = optionalDateTime("until", opts.Until); err != nil {
+		return nil, err
+	}
+	if opts.Limit > 0 {
+		limit := int64(opts.Limit)
+		params.Limit = &limit
+	}
+
+	spinner := printer.Spin("Fetching history...")
+	defer spinner.Stop()
+
+	dexAPI := cdk.NewClient(cmd)
+	resp, err := dexAPI.Operations.GetFirehoseHistory(params)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetPayload().Items, nil
+}
+
+func getFirehoseRevision(cmd *cobra.Command, prjSlug, urn string, revision int) (*models.Revision, error) {
+	spinner := printer.Spin("Fetching revision...")
+	defer spinner.Stop()
+
+	params := &operations.GetFirehoseRevisionParams{
+		ProjectSlug: prjSlug,
+		FirehoseUrn: urn,
+		Revision:    int64(revision),
+	}
+
+	dexAPI := cdk.NewClient(cmd)
+	resp, err := dexAPI.Operations.GetFirehoseRevision(params)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetPayload(), nil
+}
+
+func optionalDateTime(name, s string) (*strfmt.DateTime, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil, errors.Errorf("--%s must be a valid RFC3339 timestamp: %s", name, err)
+	}
+	dt := strfmt.DateTime(t)
+	return &dt, nil
+}
+
+func printHistory(w io.Writer, revisions []*models.RevisionDiff) error {
+	if len(revisions) == 0 {
+		_, err := fmt.Fprintln(w, "No revisions found.")
+		return err
+	}
+
+	for i, rev := range revisions {
+		header := fmt.Sprintf("%s %s  %s",
+			term.Blue("●"),
+			term.Bold(fmt.Sprintf("Revision %d", rev.Revision)),
+			term.Grey(time.Time(rev.UpdatedAt).Local().Format(time.RFC1123)))
+		_, _ = fmt.Fprintln(w, header)
+
+		connector := term.Blue("│")
+		if reason := rev.Reason; reason != "" {
+			_, _ = fmt.Fprintf(w, "%s   Reason: %s\n", connector, reason)
+		}
+		if actor := rev.UpdatedByEmail; actor != "" {
+			_, _ = fmt.Fprintf(w, "%s   By:     %s\n", connector, actor)
+		}
+
+		for _, line := range renderChanges(rev.Diff) {
+			_, _ = fmt.Fprintf(w, "%s     %s\n", connector, line)
+		}
+
+		if i < len(revisions)-1 {
+			_, _ = fmt.Fprintln(w, connector)
+		}
+	}
+	return nil
+}
+
+func printRevision(w io.Writer, rev *models.Revision) error {
+	_, _ = fmt.Fprintf(w, "%s  %s\n",
+		term.Bold(fmt.Sprintf("Revision %d", rev.Revision)),
+		term.Grey(time.Time(rev.UpdatedAt).Local().Format(time.RFC1123)))
+	if rev.Reason != "" {
+		_, _ = fmt.Fprintf(w, "Reason: %s\n", rev.Reason)
+	}
+	if rev.UpdatedByEmail != "" {
+		_, _ = fmt.Fprintf(w, "By:     %s\n", rev.UpdatedByEmail)
+	}
+
+	spec, err := json.MarshalIndent(rev.Spec, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", spec)
+	return err
+}
+
+// renderChanges renders the diff of a revision in any of the supported
+// formats as a list of colourised lines.
+func renderChanges(d any) []string {
+	switch v := d.(type) {
+	case string:
+		return renderUnified(v)
+
+	case []any:
+		return renderJSONPatch(v)
+
+	default:
+		return renderDelta(d)
+	}
+}
+
+func renderUnified(patch string) []string {
+	var lines []string
+	for _, line := range strings.Split(strings.TrimRight(patch, "\n"), "\n") {
+		switch {
+		case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+			lines = append(lines, term.Bold(line))
+		case strings.HasPrefix(line, "@@"):
+			lines = append(lines, term.Grey(line))
+		case strings.HasPrefix(line, "+"):
+			lines = append(lines, term.Green(line))
+		case strings.HasPrefix(line, "-"):
+			lines = append(lines, term.Red(line))
+		default:
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+func renderJSONPatch(ops []any) []string {
+	var lines []string
+	for _, item := range ops {
+		op, _ := item.(map[string]any)
+		name, _ := op["op"].(string)
+		path, _ := op["path"].(string)
+
+		switch name {
+		case "add":
+			lines = append(lines, term.Greenf("add     %s: %s", path, compactJSON(op["value"])))
+		case "remove":
+			lines = append(lines, term.Redf("remove  %s", path))
+		default:
+			lines = append(lines, term.Yellowf("%-7s %s: %s", name, path, compactJSON(op["value"])))
+		}
+	}
+	return lines
+}
+
+// renderDelta renders a delta produced by pkg/diff as a list of colourised
+// lines, one for each added, modified or removed field.
+func renderDelta(delta any) []string {
+	var lines []string
+	walkDelta("", delta, func(path, op string, oldVal, newVal any) {
+		switch op {
+		case "+":
+			lines = append(lines, term.Greenf("+ %s: %s", path, compactJSON(newVal)))
+		case "-":
+			lines = append(lines, term.Redf("- %s: %s", path, compactJSON(oldVal)))
+		case "@":
+			// long strings are diffed as text and carry a unified-diff patch.
+			lines = append(lines, term.Yellowf("~ %s: %s", path, strings.TrimSpace(fmt.Sprint(newVal))))
+		default:
+			lines = append(lines, term.Yellowf("~ %s: %s → %s", path, compactJSON(oldVal), compactJSON(newVal)))
+		}
+	})
+	return lines
+}
+
+// walkDelta walks a jsondiffpatch style delta and invokes fn for every leaf
+// change. See https://github.com/benjamine/jsondiffpatch/blob/master/docs/deltas.md
+func walkDelta(path string, delta any, fn func(path, op string, oldVal, newVal any)) {
+	switch d := delta.(type) {
+	case []any:
+		switch {
+		case len(d) == 1:
+			fn(path, "+", nil, d[0])
+
+		case len(d) == 2:
+			fn(path, "~", d[0], d[1])
+
+		case len(d) == 3 && isDeltaKind(d[2], 0):
+			fn(path, "-", d[0], nil)
+
+		case len(d) == 3 && isDeltaKind(d[2], 2):
+			fn(path, "@", nil, d[0])
+		}
+
+	case map[string]any:
+		isArray := d["_t"] == "a"
+
+		keys := make([]string, 0, len(d))
+		for k := range d {
+			if k != "_t" {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			child := joinDeltaPath(path, k, isArray)
+			if isArray {
+				// moved array items have no content change to show.
+				if arr, ok := d[k].([]any); ok && len(arr) == 3 && isDeltaKind(arr[2], 3) {
+					continue
+				}
+			}
+			walkDelta(child, d[k], fn)
+		}
+	}
+}
+
+func joinDeltaPath(parent, key string, isArray bool) string {
+	if isArray {
+		idx := strings.TrimPrefix(key, "_")
+		if _, err := strconv.Atoi(idx); err == nil {
+			return fmt.Sprintf("%s[%s]", parent, idx)
+		}
+	}
+
+	if parent == "" {
+		return key
+	}
+	return parent + "." + key
+}
+
+func isDeltaKind(v any, kind float64) bool {
+	f, ok := v.(float64)
+	return ok && f == kind
+}
+
+func compactJSON(v any) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(b)
+}
@@ -0,0 +1,71 @@
+package firehoses
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/odpf/salt/printer"
+	"github.com/odpf/salt/term"
+	"github.com/spf13/cobra"
+
+	"github.com/odpf/dex/cli/cdk"
+	"github.com/odpf/dex/generated/client/operations"
+	"github.com/odpf/dex/generated/models"
+)
+
+func lagCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lag <project> <firehoseURN>",
+		Short: "Show the consumer lag of a firehose",
+		Long: heredoc.Doc(`
+			Show the committed offset, log-end offset and lag of the consumer group of
+			a firehose for each partition of its topic.
+		`),
+		Example: heredoc.Doc(`
+			$ dex firehose lag project-x orn:entropy:firehose:project-x:my-firehose
+			$ dex firehose lag project-x orn:entropy:firehose:project-x:my-firehose -F json
+		`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("Fetching consumer lag...")
+			params := &operations.GetFirehoseConsumerLagParams{
+				ProjectSlug: args[0],
+				FirehoseUrn: args[1],
+			}
+			resp, err := cdk.NewClient(cmd).Operations.GetFirehoseConsumerLag(params)
+			spinner.Stop()
+			if err != nil {
+				return err
+			}
+
+			lag := resp.GetPayload()
+			return cdk.Display(cmd, lag, func(w io.Writer, v any) error {
+				return printConsumerLag(w, lag)
+			})
+		},
+	}
+
+	return cmd
+}
+
+func printConsumerLag(w io.Writer, lag *models.ConsumerLag) error {
+	_, _ = fmt.Fprintf(w, "Consumer group %s on %s\n", term.Bold(lag.ConsumerGroup), lag.Topic)
+	_, _ = fmt.Fprintf(w, "Total lag: %s\n\n", term.Bold(fmt.Sprint(lag.TotalLag)))
+
+	report := [][]string{{
+		term.Bold("TOPIC"), term.Bold("PARTITION"), term.Bold("COMMITTED"),
+		term.Bold("LOG-END"), term.Bold("LAG"),
+	}}
+	for _, p := range lag.Partitions {
+		committed, partitionLag := fmt.Sprint(p.CommittedOffset), fmt.Sprint(p.Lag)
+		if p.CommittedOffset < 0 {
+			committed, partitionLag = term.Grey("-"), term.Grey("-")
+		}
+		report = append(report, []string{
+			p.Topic, fmt.Sprint(p.Partition), committed, fmt.Sprint(p.LogEndOffset), partitionLag,
+		})
+	}
+	printer.Table(w, report)
+	return nil
+}
@@ -3,7 +3,10 @@ package firehoses
 import (
 	"fmt"
 	"io"
+	"strconv"
+	"strings"
 
+	"github.com/MakeNowJust/heredoc"
 	"github.com/odpf/salt/printer"
 	"github.com/odpf/salt/term"
 	"github.com/spf13/cobra"
@@ -15,24 +18,43 @@ import (
 )
 
 func listCommand() *cobra.Command {
+	var limit int
+	var group, kubeCluster, status, sinkType, topic, stream, sortBy string
+
 	cmd := &cobra.Command{
 		Use:   "list <project>",
 		Short: "List firehoses in the given project.",
 		Args:  cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ dex firehose list project-x
+			$ dex firehose list project-x --status running --sink-type bigquery
+			$ dex firehose list project-x --topic booking-log
+		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			params := operations.ListFirehosesParams{
 				ProjectSlug: args[0],
+				Group:       optionalString(group),
+				KubeCluster: optionalString(kubeCluster),
+				Status:      optionalString(strings.ToUpper(status)),
+				SinkType:    optionalString(strings.ToUpper(sinkType)),
+				TopicName:   optionalString(topic),
+				StreamName:  optionalString(stream),
+				Sort:        optionalString(sortBy),
+				Expand:      []string{"state", "configs"},
 			}
 
-			firehoses, err := listFirehoses(cmd, params)
+			firehoses, err := listFirehoses(cmd, params, limit)
 			if err != nil {
 				return errors.Errorf("failed to list: %s", err)
 			}
 
 			return cdk.Display(cmd, firehoses, func(w io.Writer, v interface{}) error {
-				report := [][]string{{term.Bold("URN"), term.Bold("NAME")}}
+				report := [][]string{{
+					term.Bold("URN"), term.Bold("NAME"), term.Bold("STATE"), term.Bold("STATUS"),
+					term.Bold("REPLICAS"), term.Bold("SINK TYPE"), term.Bold("TOPIC"), term.Bold("CLUSTER"),
+				}}
 				for _, f := range firehoses {
-					report = append(report, []string{f.Urn, f.Name})
+					report = append(report, firehoseListRow(*f))
 				}
 				_, _ = fmt.Fprintf(w, "Showing %d firehoses\n", len(firehoses))
 				printer.Table(w, report)
@@ -41,17 +63,68 @@ func listCommand() *cobra.Command {
 		},
 	}
 
+	flags := cmd.Flags()
+	flags.StringVarP(&group, "group", "g", "", "Only list firehoses owned by this group")
+	flags.StringVarP(&kubeCluster, "kube-cluster", "k", "", "Only list firehoses deployed on this kubernetes cluster")
+	flags.StringVarP(&status, "status", "s", "", "Only list firehoses with this status (running, stopped)")
+	flags.StringVar(&sinkType, "sink-type", "", "Only list firehoses with this sink type (e.g., log, http, bigquery)")
+	flags.StringVarP(&topic, "topic", "t", "", "Only list firehoses consuming from this topic")
+	flags.StringVar(&stream, "stream", "", "Only list firehoses consuming from this stream")
+	flags.StringVar(&sortBy, "sort", "", "Sort by name, created_at or updated_at (prefix '-' for descending)")
+	flags.IntVarP(&limit, "limit", "l", 0, "Maximum number of firehoses to list (0 lists all)")
+
 	return cmd
 }
 
-func listFirehoses(cmd *cobra.Command, params operations.ListFirehosesParams) ([]*models.Firehose, error) {
+// listFirehoses follows the pages of list API until 'limit' firehoses are
+// fetched. All firehoses are fetched if limit <= 0.
+func listFirehoses(cmd *cobra.Command, params operations.ListFirehosesParams, limit int) ([]*models.Firehose, error) {
 	spinner := printer.Spin(fmt.Sprintf("Fetching firehoses in project '%s'", params.ProjectSlug))
 	defer spinner.Stop()
 
 	dexAPI := cdk.NewClient(cmd)
-	res, err := dexAPI.Operations.ListFirehoses(&params)
-	if err != nil {
-		return nil, err
+	params.PageSize = cdk.PageSize(limit)
+
+	var firehoses []*models.Firehose
+	for {
+		res, err := dexAPI.Operations.ListFirehoses(&params)
+		if err != nil {
+			return nil, err
+		}
+		page := res.GetPayload()
+		firehoses = append(firehoses, page.Items...)
+
+		if !cdk.HasMorePages(page.NextPageToken, len(firehoses), limit) {
+			break
+		}
+		params.PageToken = &page.NextPageToken
+	}
+
+	if limit > 0 && len(firehoses) > limit {
+		firehoses = firehoses[:limit]
 	}
-	return res.GetPayload().Items, nil
+	return firehoses, nil
+}
+
+func firehoseListRow(f models.Firehose) []string {
+	const na = "-"
+	state, status := na, na
+	if f.State != nil {
+		state, status = f.State.State, f.State.Status
+	}
+
+	replicas, sinkType, topic := na, na, na
+	if cfg := f.Configs; cfg != nil {
+		if cfg.Replicas != nil {
+			replicas = strconv.Itoa(int(*cfg.Replicas))
+		}
+		if cfg.SinkType != nil {
+			sinkType = string(*cfg.SinkType)
+		}
+		if cfg.TopicName != nil {
+			topic = *cfg.TopicName
+		}
+	}
+
+	return []string{f.Urn, f.Name, state, status, replicas, sinkType, topic, f.KubeCluster}
 }
@@ -0,0 +1,132 @@
+package firehoses
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/odpf/salt/term"
+)
+
+const (
+	labelPod       = "pod"
+	labelContainer = "container"
+)
+
+var podColours = []func(t ...string) string{
+	term.Cyan, term.Magenta, term.Green, term.Yellow, term.Blue, term.Red,
+}
+
+// logLine is the JSON representation of a log line written in --json mode.
+type logLine struct {
+	Pod       string            `json:"pod,omitempty"`
+	Container string            `json:"container,omitempty"`
+	Labels    map[string]string `json:"labels,omitempty"`
+	Line      string            `json:"line"`
+}
+
+// logPrinter writes log chunks line-by-line with a prefix identifying the
+// pod & container of each line. Chunks from different pods can interleave,
+// so partial lines are buffered per source until they are complete.
+type logPrinter struct {
+	mu       sync.Mutex
+	out      io.Writer
+	asJSON   bool
+	noPrefix bool
+
+	colours  map[string]func(t ...string) string
+	partial  map[string][]byte
+	lastSeen time.Time
+}
+
+func newLogPrinter(out io.Writer, asJSON, noPrefix bool) *logPrinter {
+	return &logPrinter{
+		out:      out,
+		asJSON:   asJSON,
+		noPrefix: noPrefix,
+		colours:  map[string]func(t ...string) string{},
+		partial:  map[string][]byte{},
+		lastSeen: time.Now(),
+	}
+}
+
+func (lp *logPrinter) print(chunk logChunk) {
+	lp.mu.Lock()
+	defer lp.mu.Unlock()
+
+	lp.lastSeen = time.Now()
+
+	pod, container := chunk.Labels[labelPod], chunk.Labels[labelContainer]
+	source := pod + "/" + container
+
+	data := append(lp.partial[source], chunk.Data...)
+	lines := bytes.Split(data, []byte("\n"))
+
+	// the last element is either empty (data ended with a newline) or an
+	// incomplete line that is completed by a later chunk.
+	lp.partial[source] = append([]byte(nil), lines[len(lines)-1]...)
+	for _, line := range lines[:len(lines)-1] {
+		lp.writeLine(pod, container, chunk.Labels, line)
+	}
+}
+
+// flush writes out all the buffered partial lines.
+func (lp *logPrinter) flush() {
+	lp.mu.Lock()
+	defer lp.mu.Unlock()
+
+	for source, line := range lp.partial {
+		if len(line) > 0 {
+			pod, container, _ := strings.Cut(source, "/")
+			lp.writeLine(pod, container, nil, line)
+		}
+		delete(lp.partial, source)
+	}
+}
+
+func (lp *logPrinter) writeLine(pod, container string, labels map[string]string, line []byte) {
+	if lp.asJSON {
+		b, err := json.Marshal(logLine{
+			Pod:       pod,
+			Container: container,
+			Labels:    labels,
+			Line:      string(line),
+		})
+		if err == nil {
+			_, _ = fmt.Fprintln(lp.out, string(b))
+		}
+		return
+	}
+
+	if lp.noPrefix || (pod == "" && container == "") {
+		_, _ = fmt.Fprintln(lp.out, string(line))
+		return
+	}
+
+	prefix := pod
+	if container != "" {
+		prefix += "/" + container
+	}
+	_, _ = fmt.Fprintf(lp.out, "%s %s\n", lp.colourFor(pod)("["+prefix+"]"), line)
+}
+
+// colourFor returns the colour for the pod. Colours are assigned in the
+// order pods are first seen.
+func (lp *logPrinter) colourFor(pod string) func(t ...string) string {
+	colour, ok := lp.colours[pod]
+	if !ok {
+		colour = podColours[len(lp.colours)%len(podColours)]
+		lp.colours[pod] = colour
+	}
+	return colour
+}
+
+func (lp *logPrinter) idleFor() time.Duration {
+	lp.mu.Lock()
+	defer lp.mu.Unlock()
+	return time.Since(lp.lastSeen)
+}
@@ -2,36 +2,57 @@ package firehoses
 
 import (
 	"bufio"
+	"compress/gzip"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
-	"log"
+	"os"
 	"strings"
+	"time"
 
+	"github.com/MakeNowJust/heredoc"
+	"github.com/odpf/salt/term"
 	"github.com/spf13/cobra"
 
 	"github.com/odpf/dex/cli/cdk"
+	"github.com/odpf/dex/generated/client"
 	"github.com/odpf/dex/generated/client/operations"
 	"github.com/odpf/dex/pkg/errors"
 )
 
+const (
+	maxLogReconnects    = 5
+	minHealthyLogStream = 30 * time.Second
+)
+
 type logChunk struct {
 	Data   []byte            `json:"data,omitempty"`
 	Labels map[string]string `json:"labels,omitempty"`
 }
 
 func logsCommand() *cobra.Command {
-	var container, pod string
-	var follow, previous, timestamps bool
-	var tailCount, since int64
+	var container, pod, output string
+	var follow, previous, timestamps, asJSON, noPrefix bool
+	var tailCount, since, maxBytes int64
+	var grep, exclude, levels []string
 
 	cmd := &cobra.Command{
 		Use:   "logs <project> <firehoseURN>",
 		Short: "Stream logs from the given firehose processes",
 		Args:  cobra.ExactArgs(2),
+		Example: heredoc.Doc(`
+			$ dex firehose logs project-x orn:entropy:firehose:project-x:my-firehose -f
+			$ dex firehose logs project-x orn:entropy:firehose:project-x:my-firehose --grep 'Exception' --exclude 'health'
+			$ dex firehose logs project-x orn:entropy:firehose:project-x:my-firehose --level ERROR,WARN
+			$ dex firehose logs project-x orn:entropy:firehose:project-x:my-firehose --output firehose.log.gz
+		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if follow {
+			if follow && output != "" {
+				return errors.New("--follow cannot be used with --output")
+			}
+
+			if follow || output != "" {
 				_ = cmd.Flags().Set("timeout", "10m")
 			}
 
@@ -43,6 +64,9 @@ func logsCommand() *cobra.Command {
 				Follow:      &follow,
 				Previous:    &previous,
 				Timestamps:  &timestamps,
+				Grep:        grep,
+				Exclude:     exclude,
+				Level:       levels,
 			}
 
 			if container != "" {
@@ -61,19 +85,24 @@ func logsCommand() *cobra.Command {
 				params.SinceSeconds = &since
 			}
 
-			reader, writer := io.Pipe()
+			if maxBytes > 0 {
+				params.MaxBytes = &maxBytes
+			}
 
-			go func() {
-				onLog := func(chunk logChunk) {
-					fmt.Print(string(chunk.Data))
+			if output != "" {
+				if err := downloadLogs(dexAPI, params, output); err != nil {
+					return err
 				}
-				if err := streamLogs(cmd.Context(), reader, onLog); err != nil {
-					log.Printf("failed: %v", err)
-				}
-			}()
+				_, _ = fmt.Fprintf(os.Stderr, "Logs written to %s\n", output)
+				return nil
+			}
 
-			_, err := dexAPI.Operations.GetFirehoseLogs(params, writer)
-			return err
+			lp := newLogPrinter(os.Stdout, asJSON, noPrefix)
+			defer lp.flush()
+
+			// a stream capped by max-bytes ends by design. so it is not resumed.
+			reconnect := follow && maxBytes == 0
+			return followLogs(cmd.Context(), dexAPI, params, lp, reconnect)
 		},
 	}
 
@@ -85,10 +114,118 @@ func logsCommand() *cobra.Command {
 	flags.BoolVarP(&follow, "follow", "f", false, "Stream logs continuously until manual exit")
 	flags.BoolVarP(&timestamps, "timestamp", "t", false, "Show timestamps")
 	flags.BoolVarP(&previous, "previous", "P", false, "Fetch available logs for previous generation")
+	flags.StringArrayVar(&grep, "grep", nil, "Show only lines matching the regular expression (repeatable)")
+	flags.StringArrayVar(&exclude, "exclude", nil, "Hide lines matching the regular expression (repeatable)")
+	flags.StringSliceVar(&levels, "level", nil, "Show only JSON log lines with given levels (e.g., ERROR,WARN)")
+	flags.Int64Var(&maxBytes, "max-bytes", 0, "Stop after the given number of bytes of logs")
+	flags.StringVarP(&output, "output", "o", "", "Write logs to file (gzipped if the name ends with .gz)")
+	flags.BoolVar(&asJSON, "json", false, "Print each line as JSON along with its labels")
+	flags.BoolVar(&noPrefix, "no-prefix", false, "Do not prefix lines with pod/container names")
 
 	return cmd
 }
 
+// followLogs streams the logs to lp. If reconnect is set, the stream is resumed
+// from the time the last line was received whenever it drops. Lines received
+// within the same second as the drop may be repeated after resuming.
+func followLogs(ctx context.Context, dexAPI *client.DexAPI, params *operations.GetFirehoseLogsParams, lp *logPrinter, reconnect bool) error {
+	failures := 0
+	for {
+		startedAt := time.Now()
+		received, err := fetchLogs(ctx, dexAPI, params, lp.print)
+		if !reconnect || ctx.Err() != nil || !isRetryableLogErr(err) {
+			return err
+		}
+
+		if received || time.Since(startedAt) > minHealthyLogStream {
+			failures = 0
+		} else {
+			failures++
+		}
+		if failures >= maxLogReconnects {
+			return errors.Errorf("log stream lost after %d attempts: %v", failures, err)
+		}
+
+		lp.flush()
+		_, _ = fmt.Fprintln(os.Stderr, term.Yellow("Log stream disconnected. Reconnecting..."))
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(time.Duration(failures+1) * time.Second):
+		}
+
+		since := int64(lp.idleFor().Seconds()) + 1
+		params.SinceSeconds = &since
+		params.TailLines = nil
+	}
+}
+
+// fetchLogs streams the logs once and reports whether any log was received.
+func fetchLogs(ctx context.Context, dexAPI *client.DexAPI, params *operations.GetFirehoseLogsParams, onLog func(chunk logChunk)) (bool, error) {
+	reader, writer := io.Pipe()
+
+	received := false
+	streamErr := make(chan error, 1)
+	go func() {
+		err := streamLogs(ctx, reader, func(chunk logChunk) {
+			received = true
+			onLog(chunk)
+		})
+		_ = reader.CloseWithError(err)
+		streamErr <- err
+	}()
+
+	_, err := dexAPI.Operations.GetFirehoseLogs(params, writer)
+	_ = writer.CloseWithError(err)
+	if sErr := <-streamErr; err == nil {
+		err = sErr
+	}
+	return received, err
+}
+
+func isRetryableLogErr(err error) bool {
+	notFoundErr := &operations.GetFirehoseLogsNotFound{}
+	badRequestErr := &operations.GetFirehoseLogsBadRequest{}
+	return !errors.As(err, &notFoundErr) && !errors.As(err, &badRequestErr)
+}
+
+// downloadLogs writes the logs to the file at path. The logs are written as
+// received (gzipped) if path ends with '.gz', and decompressed otherwise.
+func downloadLogs(dexAPI *client.DexAPI, params *operations.GetFirehoseLogsParams, path string) error {
+	download := true
+	params.Download = &download
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if strings.HasSuffix(path, ".gz") {
+		_, err := dexAPI.Operations.GetFirehoseLogs(params, f)
+		return err
+	}
+
+	reader, writer := io.Pipe()
+	copyErr := make(chan error, 1)
+	go func() {
+		gzReader, err := gzip.NewReader(reader)
+		if err == nil {
+			_, err = io.Copy(f, gzReader)
+		}
+		_ = reader.CloseWithError(err)
+		copyErr <- err
+	}()
+
+	_, err = dexAPI.Operations.GetFirehoseLogs(params, writer)
+	_ = writer.CloseWithError(err)
+	if cErr := <-copyErr; err == nil {
+		err = cErr
+	}
+	return err
+}
+
 func streamLogs(ctx context.Context, r io.Reader, onLog func(chunk logChunk)) error {
 	sc := bufio.NewScanner(r)
 
@@ -0,0 +1,158 @@
+package firehoses
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/odpf/salt/printer"
+	"github.com/odpf/salt/term"
+	"github.com/spf13/cobra"
+
+	"github.com/odpf/dex/cli/cdk"
+	"github.com/odpf/dex/generated/client/operations"
+	"github.com/odpf/dex/generated/models"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+func migrateCommand() *cobra.Command {
+	var kubeCluster string
+	var wait waitOpts
+
+	cmd := &cobra.Command{
+		Use:   "migrate <project> <firehoseURN>",
+		Short: "Migrate a firehose to another kubernetes cluster",
+		Long: heredoc.Doc(`
+			Migrate a firehose to another kubernetes cluster.
+
+			The firehose is stopped, the offsets committed by its consumer group are
+			recorded, and it is recreated on the target cluster with the same consumer
+			group and started. If any of the steps fails, the firehose is restored on
+			the original cluster.
+		`),
+		Args: cobra.ExactArgs(2),
+		Example: heredoc.Doc(`
+			$ dex firehose migrate project-x orn:entropy:firehose:project-x:my-firehose \
+				--kube-cluster orn:entropy:kubernetes:project-x:cluster-2 --wait
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			prjSlug, urn := args[0], args[1]
+
+			spinner := printer.Spin("Starting migration")
+			params := &operations.MigrateFirehoseParams{
+				ProjectSlug: prjSlug,
+				FirehoseUrn: urn,
+				Body: operations.MigrateFirehoseBody{
+					KubeCluster: &kubeCluster,
+				},
+			}
+
+			dexAPI := cdk.NewClient(cmd)
+			resp, err := dexAPI.Operations.MigrateFirehose(params)
+			spinner.Stop()
+			if err != nil {
+				return errors.Errorf("migrate failed: %s", err)
+			}
+
+			mig := resp.GetPayload()
+			if wait.Wait {
+				mig, err = waitForMigration(cmd, prjSlug, urn, mig.ID, wait.Timeout)
+				if err != nil {
+					return err
+				}
+			}
+
+			if err := cdk.Display(cmd, mig, func(w io.Writer, v any) error {
+				return printMigration(w, mig)
+			}); err != nil {
+				return err
+			}
+
+			if wait.Wait && mig.Status != models.FirehoseMigrationStatusSUCCEEDED {
+				return errors.Errorf("migration %s ended with status %s", mig.ID, mig.Status)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&kubeCluster, "kube-cluster", "", "URN of the kubernetes cluster to migrate to")
+	_ = cmd.MarkFlagRequired("kube-cluster")
+	wait.addFlags(cmd)
+	return cmd
+}
+
+// waitForMigration polls the migration until it finishes. The steps are
+// written to stderr as they finish.
+func waitForMigration(cmd *cobra.Command, prjSlug, urn, id string, timeout time.Duration) (*models.FirehoseMigration, error) {
+	var deadline time.Time
+	if timeout > 0 {
+		deadline = time.Now().Add(timeout)
+	}
+
+	dexAPI := cdk.NewClient(cmd)
+	params := &operations.GetFirehoseMigrationParams{
+		ProjectSlug: prjSlug,
+		FirehoseUrn: urn,
+		MigrationID: id,
+	}
+
+	seen := map[string]string{}
+	for {
+		resp, err := dexAPI.Operations.GetFirehoseMigration(params)
+		if err != nil {
+			return nil, err
+		}
+
+		mig := resp.GetPayload()
+		for _, step := range mig.Steps {
+			if step.Status != seen[step.Name] && step.Status != models.FirehoseMigrationStepStatusPENDING {
+				seen[step.Name] = step.Status
+				_, _ = fmt.Fprintf(os.Stderr, "%s  %-15s %s\n",
+					term.Grey(time.Now().Format(time.Kitchen)), step.Name, colourStepStatus(step.Status))
+			}
+		}
+
+		if mig.Status != models.FirehoseMigrationStatusRUNNING {
+			return mig, nil
+		} else if !deadline.IsZero() && time.Now().Add(minPollInterval).After(deadline) {
+			return nil, errors.Errorf("timed out waiting for migration %s", id)
+		}
+
+		select {
+		case <-cmd.Context().Done():
+			return nil, cmd.Context().Err()
+
+		case <-time.After(minPollInterval):
+		}
+	}
+}
+
+func printMigration(w io.Writer, mig *models.FirehoseMigration) error {
+	_, _ = fmt.Fprintf(w, "Migration %s of %s\n", term.Bold(mig.ID), mig.Urn)
+	_, _ = fmt.Fprintf(w, "%s -> %s: %s\n", mig.FromCluster, mig.ToCluster, colourStepStatus(mig.Status))
+	if mig.Error != "" {
+		_, _ = fmt.Fprintf(w, "Error: %s\n", mig.Error)
+	}
+
+	report := [][]string{{term.Bold("STEP"), term.Bold("STATUS"), term.Bold("ERROR")}}
+	for _, step := range mig.Steps {
+		report = append(report, []string{step.Name, colourStepStatus(step.Status), step.Error})
+	}
+	printer.Table(w, report)
+	return nil
+}
+
+func colourStepStatus(status string) string {
+	switch status {
+	case models.FirehoseMigrationStepStatusSUCCEEDED:
+		return term.Green(status)
+	case models.FirehoseMigrationStepStatusRUNNING, models.FirehoseMigrationStatusROLLEDBACK:
+		return term.Yellow(status)
+	case models.FirehoseMigrationStepStatusFAILED:
+		return term.Red(status)
+	default:
+		return status
+	}
+}
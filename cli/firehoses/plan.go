@@ -0,0 +1,160 @@
+package firehoses
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/odpf/salt/term"
+	"github.com/spf13/cobra"
+
+	"github.com/odpf/dex/cli/cdk"
+	"github.com/odpf/dex/generated/models"
+	"github.com/odpf/dex/pkg/diff"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+const (
+	planActionCreate = "create"
+	planActionUpdate = "update"
+)
+
+// firehosePlan represents the changes that would be applied by apply.
+type firehosePlan struct {
+	Action   string           `json:"action"`
+	URN      string           `json:"urn,omitempty"`
+	Changed  bool             `json:"changed"`
+	Diff     json.RawMessage  `json:"diff,omitempty"`
+	Firehose *models.Firehose `json:"firehose"`
+
+	// MaskedChanges are the keys of the sensitive env vars that would be
+	// changed. These changes are not part of the diff since the values are
+	// masked.
+	MaskedChanges []string `json:"masked_changes,omitempty"`
+}
+
+// firehosePlanView contains the fields of a firehose that are compared while
+// planning. Only the fields that are actually applied by create/update are
+// part of this view.
+type firehosePlanView struct {
+	Title       string                 `json:"title,omitempty"`
+	Name        string                 `json:"name,omitempty"`
+	Description string                 `json:"description,omitempty"`
+	KubeCluster string                 `json:"kube_cluster,omitempty"`
+	Configs     *models.FirehoseConfig `json:"configs,omitempty"`
+}
+
+// planFirehose validates the definition on the server using dry-run mode.
+// If showDiff is set, the difference between the existing version and the
+// validated definition is displayed in the same format as history.
+func planFirehose(cmd *cobra.Command, prjSlug string, existing *models.Firehose, def models.Firehose, showDiff bool) error {
+	plan, err := computePlan(cmd, prjSlug, existing, def)
+	if err != nil {
+		return err
+	}
+
+	if !showDiff {
+		plan.Diff = nil
+		return cdk.Display(cmd, plan, func(w io.Writer, v any) error {
+			_, err := fmt.Fprintf(w, "%s Validation successful. No changes were applied.\n", term.SuccessIcon())
+			return err
+		})
+	}
+
+	return cdk.Display(cmd, plan, func(w io.Writer, v any) error {
+		return printPlan(w, *plan)
+	})
+}
+
+// computePlan validates the definition on the server using dry-run mode and
+// computes the difference between the existing version and the validated
+// definition. existing must be nil if the firehose does not exist.
+func computePlan(cmd *cobra.Command, prjSlug string, existing *models.Firehose, def models.Firehose) (*firehosePlan, error) {
+	var plan firehosePlan
+	var before, after firehosePlanView
+	var err error
+
+	if existing != nil {
+		plan.Action = planActionUpdate
+		plan.URN = existing.Urn
+		resp, err := sendUpdate(cmd, prjSlug, *existing, def, true)
+		if err != nil {
+			return nil, errors.Errorf("validation failed: %s", err)
+		}
+		plan.Firehose = resp.GetPayload()
+		plan.MaskedChanges = splitHeaderList(resp.XMaskedChanges)
+
+		before = firehosePlanView{
+			Title:       existing.Title,
+			Name:        existing.Name,
+			Description: existing.Description,
+			KubeCluster: existing.KubeCluster,
+			Configs:     existing.Configs,
+		}
+
+		// update applies only the description & configs.
+		after = before
+		after.Configs = plan.Firehose.Configs
+		if plan.Firehose.Description != "" {
+			after.Description = plan.Firehose.Description
+		}
+	} else {
+		plan.Action = planActionCreate
+		plan.URN = generateFirehoseURN(prjSlug, def.Name)
+		plan.Firehose, err = createFirehose(cmd, prjSlug, def, true)
+		if err != nil {
+			return nil, errors.Errorf("validation failed: %s", err)
+		}
+
+		after = firehosePlanView{
+			Title:       plan.Firehose.Title,
+			Name:        plan.Firehose.Name,
+			Description: plan.Firehose.Description,
+			KubeCluster: plan.Firehose.KubeCluster,
+			Configs:     plan.Firehose.Configs,
+		}
+	}
+
+	beforeJSON, err := json.Marshal(before)
+	if err != nil {
+		return nil, err
+	}
+	afterJSON, err := json.Marshal(after)
+	if err != nil {
+		return nil, err
+	}
+
+	delta, err := diff.JSON(beforeJSON, afterJSON)
+	if err != nil {
+		return nil, err
+	}
+	plan.Diff = json.RawMessage(delta)
+	plan.Changed = strings.TrimSpace(delta) != "{}" || len(plan.MaskedChanges) > 0
+
+	return &plan, nil
+}
+
+func printPlan(w io.Writer, plan firehosePlan) error {
+	if !plan.Changed {
+		_, err := fmt.Fprintf(w, "No changes. Firehose %s is up-to-date.\n", plan.URN)
+		return err
+	}
+
+	_, _ = fmt.Fprintf(w, "Plan: %s %s\n", term.Bold(plan.Action), plan.URN)
+	if len(plan.MaskedChanges) > 0 {
+		_, _ = fmt.Fprintf(w, "Sensitive values changed: %s\n", strings.Join(plan.MaskedChanges, ", "))
+	}
+	_, err := fmt.Fprint(w, string(plan.Diff))
+	return err
+}
+
+func splitHeaderList(header string) []string {
+	var items []string
+	for _, item := range strings.Split(header, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,215 @@
+package firehoses
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/odpf/salt/printer"
+	"github.com/odpf/salt/term"
+	"github.com/spf13/cobra"
+
+	"github.com/odpf/dex/cli/cdk"
+	"github.com/odpf/dex/generated/client/operations"
+	"github.com/odpf/dex/generated/models"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+const (
+	resultCreated   = "created"
+	resultUpdated   = "updated"
+	resultUnchanged = "unchanged"
+	resultDeleted   = "deleted"
+	resultSkipped   = "skipped"
+	resultFailed    = "failed"
+)
+
+type reconcileOpts struct {
+	OnlyCreate bool
+	DryRun     bool
+	ShowDiff   bool
+	Prune      bool
+}
+
+type reconcileResult struct {
+	Name   string `json:"name"`
+	URN    string `json:"urn"`
+	Result string `json:"result"`
+	Error  string `json:"error,omitempty"`
+}
+
+// reconcileFirehoses creates or updates all the given firehose definitions in
+// the project. If opts.Prune is set, firehoses in the project that are not part
+// of the definitions are deleted, unless any of the definitions failed to be
+// applied. In dry-run mode, the results indicate what would be done without
+// applying any changes.
+func reconcileFirehoses(cmd *cobra.Command, prjSlug string, defs []models.Firehose, opts reconcileOpts) error {
+	declared := map[string]bool{}
+	for _, def := range defs {
+		if def.Name == "" {
+			return errors.Errorf("name must be set for all firehoses (title: '%s')", def.Title)
+		}
+
+		urn := generateFirehoseURN(prjSlug, def.Name)
+		if declared[urn] {
+			return errors.Errorf("firehose '%s' is declared more than once", def.Name)
+		}
+		declared[urn] = true
+	}
+
+	existing := map[string]*models.Firehose{}
+	if !opts.OnlyCreate || opts.Prune {
+		params := operations.ListFirehosesParams{
+			ProjectSlug: prjSlug,
+			Expand:      []string{"configs"},
+		}
+		firehoses, err := listFirehoses(cmd, params, 0)
+		if err != nil {
+			return errors.Errorf("failed to list existing firehoses: %s", err)
+		}
+
+		for _, f := range firehoses {
+			existing[f.Urn] = f
+		}
+	}
+
+	var results []reconcileResult
+	for _, def := range defs {
+		urn := generateFirehoseURN(prjSlug, def.Name)
+
+		var cur *models.Firehose
+		if !opts.OnlyCreate {
+			cur = existing[urn]
+		}
+
+		results = append(results, applyDefinition(cmd, prjSlug, cur, def, opts))
+	}
+
+	// a partly applied manifest must not delete the live firehoses it was
+	// meant to replace.
+	applyFailures := countFailed(results)
+	pruneSkipped := false
+	if opts.Prune {
+		for urn, f := range existing {
+			if declared[urn] {
+				continue
+			}
+
+			res := reconcileResult{Name: f.Name, URN: urn, Result: resultDeleted}
+			if applyFailures > 0 {
+				res.Result = resultSkipped
+				res.Error = "not deleted since some firehoses failed to apply"
+				pruneSkipped = true
+			} else if !opts.DryRun {
+				if err := deleteFirehose(cmd, prjSlug, urn); err != nil {
+					res.Result = resultFailed
+					res.Error = err.Error()
+				}
+			}
+			results = append(results, res)
+		}
+	}
+
+	if err := cdk.Display(cmd, results, func(w io.Writer, v any) error {
+		return printReconcileResults(w, results, opts.DryRun)
+	}); err != nil {
+		return err
+	}
+
+	if pruneSkipped {
+		return errors.Errorf("%d of %d firehoses failed to apply, skipped pruning", applyFailures, len(defs))
+	} else if failures := countFailed(results); failures > 0 {
+		return errors.Errorf("%d of %d firehoses failed", failures, len(results))
+	}
+	return nil
+}
+
+func countFailed(results []reconcileResult) int {
+	failures := 0
+	for _, res := range results {
+		if res.Result == resultFailed {
+			failures++
+		}
+	}
+	return failures
+}
+
+func applyDefinition(cmd *cobra.Command, prjSlug string, existing *models.Firehose, def models.Firehose, opts reconcileOpts) reconcileResult {
+	res := reconcileResult{
+		Name: def.Name,
+		URN:  generateFirehoseURN(prjSlug, def.Name),
+	}
+
+	fail := func(err error) reconcileResult {
+		res.Result = resultFailed
+		res.Error = err.Error()
+		return res
+	}
+
+	plan, err := computePlan(cmd, prjSlug, existing, def)
+	if err != nil {
+		return fail(err)
+	}
+
+	switch {
+	case !plan.Changed:
+		res.Result = resultUnchanged
+		return res
+
+	case existing != nil:
+		res.Result = resultUpdated
+
+	default:
+		res.Result = resultCreated
+	}
+
+	if opts.ShowDiff {
+		_ = printPlan(os.Stderr, *plan)
+	}
+
+	if opts.DryRun {
+		return res
+	}
+
+	var applied *models.Firehose
+	if existing != nil {
+		applied, err = updateFirehose(cmd, prjSlug, *existing, def, false)
+	} else {
+		applied, err = createFirehose(cmd, prjSlug, def, false)
+	}
+	if err != nil {
+		return fail(err)
+	}
+
+	res.URN = applied.Urn
+	return res
+}
+
+func printReconcileResults(w io.Writer, results []reconcileResult, dryRun bool) error {
+	counts := map[string]int{}
+	report := [][]string{{term.Bold("NAME"), term.Bold("URN"), term.Bold("RESULT"), term.Bold("ERROR")}}
+	for _, res := range results {
+		counts[res.Result]++
+
+		result := res.Result
+		switch res.Result {
+		case resultCreated:
+			result = term.Green(result)
+		case resultUpdated, resultSkipped:
+			result = term.Yellow(result)
+		case resultDeleted, resultFailed:
+			result = term.Red(result)
+		}
+		report = append(report, []string{res.Name, res.URN, result, res.Error})
+	}
+
+	if dryRun {
+		_, _ = fmt.Fprintln(w, "Dry-run mode. No changes were applied.")
+	}
+	printer.Table(w, report)
+
+	_, err := fmt.Fprintf(w, "\n%d created, %d updated, %d unchanged, %d deleted, %d skipped, %d failed\n",
+		counts[resultCreated], counts[resultUpdated], counts[resultUnchanged],
+		counts[resultDeleted], counts[resultSkipped], counts[resultFailed])
+	return err
+}
@@ -1,8 +1,6 @@
 package firehoses
 
 import (
-	"fmt"
-	"io"
 	"strings"
 
 	"github.com/go-openapi/strfmt"
@@ -16,6 +14,7 @@ import (
 
 func resetOffsetCommand() *cobra.Command {
 	var resetTo, datetime string
+	var wait waitOpts
 
 	cmd := &cobra.Command{
 		Use:   "reset-offset <project> <firehoseURN>",
@@ -58,14 +57,13 @@ func resetOffsetCommand() *cobra.Command {
 				return err
 			}
 
-			return cdk.Display(cmd, modifiedFirehose, func(w io.Writer, v interface{}) error {
-				_, err := fmt.Fprintln(w, "Reset offset request accepted. Use view command to check status.")
-				return err
-			})
+			spinner.Stop()
+			return finishAction(cmd, args[0], args[1], wait, modifiedFirehose, "Reset offset")
 		},
 	}
 
 	cmd.Flags().StringVar(&resetTo, "to", "datetime", "Reset target (earliest, latest, datetime).")
 	cmd.Flags().StringVarP(&datetime, "datetime", "D", "", "Target timestamp in ISO8601 or Unix Epoch format.")
+	wait.addFlags(cmd)
 	return cmd
 }
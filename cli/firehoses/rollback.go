@@ -0,0 +1,119 @@
+package firehoses
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/go-openapi/strfmt"
+	"github.com/odpf/salt/printer"
+	"github.com/odpf/salt/prompt"
+	"github.com/odpf/salt/term"
+	"github.com/spf13/cobra"
+
+	"github.com/odpf/dex/cli/cdk"
+	"github.com/odpf/dex/generated/client/operations"
+	"github.com/odpf/dex/generated/models"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+func rollbackCommand() *cobra.Command {
+	var revision int
+	var at string
+	var skipConfirm bool
+	var wait waitOpts
+
+	cmd := &cobra.Command{
+		Use:   "rollback <project> <firehoseURN>",
+		Short: "Rollback a firehose to an earlier revision",
+		Long: heredoc.Doc(`
+			Rollback a firehose to the description and configs of an earlier revision.
+
+			The revision is selected by its number as shown by the history command or
+			by a timestamp, in which case the latest revision at or before it is used.
+			The changes are shown before the rollback is confirmed.
+		`),
+		Args: cobra.ExactArgs(2),
+		Example: heredoc.Doc(`
+			$ dex firehose rollback project-x orn:entropy:firehose:project-x:my-firehose --revision 3
+			$ dex firehose rollback project-x orn:entropy:firehose:project-x:my-firehose --at 2022-10-10T10:00:00Z --yes
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			prjSlug, urn := args[0], args[1]
+
+			body := operations.RollbackFirehoseBody{Revision: int64(revision)}
+			if at != "" {
+				t, err := time.Parse(time.RFC3339, at)
+				if err != nil {
+					return errors.Errorf("--at must be a valid RFC3339 timestamp: %s", err)
+				}
+				body.Timestamp = strfmt.DateTime(t)
+			}
+			if (revision > 0) == (at != "") {
+				return errors.New("exactly one of --revision or --at must be set")
+			}
+
+			preview, err := rollbackFirehose(cmd, prjSlug, urn, body, true)
+			if err != nil {
+				return errors.Errorf("rollback failed: %s", err)
+			}
+
+			if !skipConfirm {
+				printRollback(cmd.ErrOrStderr(), preview)
+
+				msg := fmt.Sprintf("Rollback firehose '%s' to revision %d?", urn, preview.Revision)
+				confirmed, err := prompt.New().Confirm(msg, false)
+				if err != nil {
+					return err
+				} else if !confirmed {
+					_, _ = fmt.Fprintln(cmd.OutOrStdout(), "Aborted. Firehose was not changed.")
+					return nil
+				}
+			}
+
+			res, err := rollbackFirehose(cmd, prjSlug, urn, body, false)
+			if err != nil {
+				return errors.Errorf("rollback failed: %s", err)
+			}
+			return finishAction(cmd, prjSlug, urn, wait, res, "Rollback")
+		},
+	}
+
+	cmd.Flags().IntVar(&revision, "revision", 0, "Number of the revision to rollback to")
+	cmd.Flags().StringVar(&at, "at", "", "Rollback to the latest revision at or before this time (RFC3339)")
+	cmd.Flags().BoolVarP(&skipConfirm, "yes", "y", false, "Skip the preview and confirmation prompt")
+	wait.addFlags(cmd)
+	return cmd
+}
+
+func rollbackFirehose(cmd *cobra.Command, prjSlug, urn string, body operations.RollbackFirehoseBody, dryRun bool) (*models.FirehoseRollback, error) {
+	spinner := printer.Spin("")
+	defer spinner.Stop()
+
+	params := &operations.RollbackFirehoseParams{
+		ProjectSlug: prjSlug,
+		FirehoseUrn: urn,
+		Body:        body,
+		DryRun:      &dryRun,
+	}
+
+	dexAPI := cdk.NewClient(cmd)
+	resp, err := dexAPI.Operations.RollbackFirehose(params)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetPayload(), nil
+}
+
+func printRollback(w io.Writer, rb *models.FirehoseRollback) {
+	_, _ = fmt.Fprintf(w, "Rollback to %s\n", term.Bold(fmt.Sprintf("revision %d", rb.Revision)))
+
+	lines := renderDelta(rb.Diff)
+	if len(lines) == 0 {
+		_, _ = fmt.Fprintln(w, "  No changes to the description or configs.")
+	}
+	for _, line := range lines {
+		_, _ = fmt.Fprintf(w, "  %s\n", line)
+	}
+}
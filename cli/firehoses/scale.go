@@ -1,9 +1,6 @@
 package firehoses
 
 import (
-	"fmt"
-	"io"
-
 	"github.com/odpf/salt/printer"
 	"github.com/spf13/cobra"
 
@@ -15,6 +12,7 @@ import (
 
 func scaleCommand() *cobra.Command {
 	var replicas int
+	var wait waitOpts
 
 	cmd := &cobra.Command{
 		Use:   "scale <project> <firehoseURN>",
@@ -26,14 +24,12 @@ func scaleCommand() *cobra.Command {
 				return errors.Errorf("scale operation failed: %s", err)
 			}
 
-			return cdk.Display(cmd, modifiedFirehose, func(w io.Writer, v interface{}) error {
-				_, err := fmt.Fprintln(w, "Scale request accepted. Use view command to check status.")
-				return err
-			})
+			return finishAction(cmd, args[0], args[1], wait, modifiedFirehose, "Scale")
 		},
 	}
 
 	cmd.Flags().IntVarP(&replicas, "replicas", "r", 1, "Number of replicas to run")
+	wait.addFlags(cmd)
 	return cmd
 }
 
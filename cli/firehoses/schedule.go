@@ -0,0 +1,298 @@
+package firehoses
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/go-openapi/strfmt"
+	"github.com/odpf/salt/printer"
+	"github.com/odpf/salt/term"
+	"github.com/spf13/cobra"
+
+	"github.com/odpf/dex/cli/cdk"
+	"github.com/odpf/dex/generated/client/operations"
+	"github.com/odpf/dex/generated/models"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+type scheduleOpts struct {
+	Cron     string
+	Action   string
+	Replicas int
+	Disabled bool
+}
+
+func (opts *scheduleOpts) addFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&opts.Cron, "cron", "", "Cron expression of the schedule (e.g., '0 22 * * *')")
+	cmd.Flags().StringVar(&opts.Action, "action", "", "Action to apply (start, stop or scale)")
+	cmd.Flags().IntVarP(&opts.Replicas, "replicas", "r", 0, "Number of replicas for the scale action")
+	cmd.Flags().BoolVar(&opts.Disabled, "disabled", false, "Create or update the schedule in disabled state")
+	_ = cmd.MarkFlagRequired("cron")
+	_ = cmd.MarkFlagRequired("action")
+}
+
+func (opts scheduleOpts) params() (enabled *bool, replicas int64) {
+	isEnabled := !opts.Disabled
+	return &isEnabled, int64(opts.Replicas)
+}
+
+func scheduleCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule <command>",
+		Short: "Manage scheduled actions of a firehose",
+		Long: heredoc.Doc(`
+			Manage the actions applied on a firehose at the times given by cron expressions.
+
+			Each run is recorded in the history of the firehose with a reason that refers
+			to the schedule. Times are in UTC unless the expression has a CRON_TZ prefix.
+		`),
+		Example: heredoc.Doc(`
+			$ dex firehose schedule create project-x orn:entropy:firehose:project-x:my-firehose --cron "0 22 * * *" --action scale -r 1
+			$ dex firehose schedule create project-x orn:entropy:firehose:project-x:my-firehose --cron "0 0 * * 6" --action stop
+			$ dex firehose schedule list project-x orn:entropy:firehose:project-x:my-firehose
+		`),
+	}
+
+	cmd.AddCommand(
+		scheduleListCommand(),
+		scheduleViewCommand(),
+		scheduleCreateCommand(),
+		scheduleUpdateCommand(),
+		scheduleDeleteCommand(),
+	)
+	return cmd
+}
+
+func scheduleListCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list <project> <firehoseURN>",
+		Short: "List schedules of a firehose",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("Fetching schedules...")
+			params := &operations.ListFirehoseSchedulesParams{
+				ProjectSlug: args[0],
+				FirehoseUrn: args[1],
+			}
+			resp, err := cdk.NewClient(cmd).Operations.ListFirehoseSchedules(params)
+			spinner.Stop()
+			if err != nil {
+				return err
+			}
+
+			schedules := resp.GetPayload().Items
+			return cdk.Display(cmd, schedules, func(w io.Writer, v any) error {
+				return printSchedules(w, schedules)
+			})
+		},
+	}
+}
+
+func scheduleViewCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "view <project> <firehoseURN> <scheduleID>",
+		Short: "View a schedule of a firehose with its recent runs",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("Fetching schedule...")
+			params := &operations.GetFirehoseScheduleParams{
+				ProjectSlug: args[0],
+				FirehoseUrn: args[1],
+				ScheduleID:  args[2],
+			}
+			resp, err := cdk.NewClient(cmd).Operations.GetFirehoseSchedule(params)
+			spinner.Stop()
+			if err != nil {
+				return err
+			}
+
+			s := resp.GetPayload()
+			return cdk.Display(cmd, s, func(w io.Writer, v any) error {
+				return printSchedule(w, s)
+			})
+		},
+	}
+}
+
+func scheduleCreateCommand() *cobra.Command {
+	var opts scheduleOpts
+
+	cmd := &cobra.Command{
+		Use:   "create <project> <firehoseURN>",
+		Short: "Create a schedule for a firehose",
+		Args:  cobra.ExactArgs(2),
+		Example: heredoc.Doc(`
+			$ dex firehose schedule create project-x orn:entropy:firehose:project-x:my-firehose --cron "0 22 * * *" --action scale -r 1
+			$ dex firehose schedule create project-x orn:entropy:firehose:project-x:my-firehose --cron "CRON_TZ=Asia/Jakarta 0 6 * * 1-5" --action start
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			enabled, replicas := opts.params()
+			body := operations.CreateFirehoseScheduleBody{
+				Cron:    &opts.Cron,
+				Action:  &opts.Action,
+				Enabled: enabled,
+			}
+			if replicas > 0 {
+				body.Params = &operations.CreateFirehoseScheduleParamsBodyParams{Replicas: replicas}
+			}
+
+			spinner := printer.Spin("Creating schedule...")
+			params := &operations.CreateFirehoseScheduleParams{
+				ProjectSlug: args[0],
+				FirehoseUrn: args[1],
+				Body:        body,
+			}
+			resp, err := cdk.NewClient(cmd).Operations.CreateFirehoseSchedule(params)
+			spinner.Stop()
+			if err != nil {
+				return errors.Errorf("create schedule failed: %s", err)
+			}
+
+			s := resp.GetPayload()
+			return cdk.Display(cmd, s, func(w io.Writer, v any) error {
+				_, _ = fmt.Fprintf(w, "%s Schedule %s created.\n", term.SuccessIcon(), term.Bold(s.ID))
+				return printSchedule(w, s)
+			})
+		},
+	}
+
+	opts.addFlags(cmd)
+	return cmd
+}
+
+func scheduleUpdateCommand() *cobra.Command {
+	var opts scheduleOpts
+
+	cmd := &cobra.Command{
+		Use:   "update <project> <firehoseURN> <scheduleID>",
+		Short: "Update a schedule of a firehose",
+		Long:  "Replace the cron expression, action and params of a schedule, and enable or disable it.",
+		Args:  cobra.ExactArgs(3),
+		Example: heredoc.Doc(`
+			$ dex firehose schedule update project-x orn:entropy:firehose:project-x:my-firehose cdm4k2 --cron "0 23 * * *" --action stop
+			$ dex firehose schedule update project-x orn:entropy:firehose:project-x:my-firehose cdm4k2 --cron "0 23 * * *" --action stop --disabled
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			enabled, replicas := opts.params()
+			body := operations.UpdateFirehoseScheduleBody{
+				Cron:    &opts.Cron,
+				Action:  &opts.Action,
+				Enabled: enabled,
+			}
+			if replicas > 0 {
+				body.Params = &operations.UpdateFirehoseScheduleParamsBodyParams{Replicas: replicas}
+			}
+
+			spinner := printer.Spin("Updating schedule...")
+			params := &operations.UpdateFirehoseScheduleParams{
+				ProjectSlug: args[0],
+				FirehoseUrn: args[1],
+				ScheduleID:  args[2],
+				Body:        body,
+			}
+			resp, err := cdk.NewClient(cmd).Operations.UpdateFirehoseSchedule(params)
+			spinner.Stop()
+			if err != nil {
+				return errors.Errorf("update schedule failed: %s", err)
+			}
+
+			s := resp.GetPayload()
+			return cdk.Display(cmd, s, func(w io.Writer, v any) error {
+				return printSchedule(w, s)
+			})
+		},
+	}
+
+	opts.addFlags(cmd)
+	return cmd
+}
+
+func scheduleDeleteCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <project> <firehoseURN> <scheduleID>",
+		Short: "Delete a schedule of a firehose",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("Deleting schedule...")
+			params := &operations.DeleteFirehoseScheduleParams{
+				ProjectSlug: args[0],
+				FirehoseUrn: args[1],
+				ScheduleID:  args[2],
+			}
+			_, err := cdk.NewClient(cmd).Operations.DeleteFirehoseSchedule(params)
+			spinner.Stop()
+			if err != nil {
+				return errors.Errorf("delete schedule failed: %s", err)
+			}
+
+			res := map[string]string{"id": args[2], "result": resultDeleted}
+			return cdk.Display(cmd, res, func(w io.Writer, v any) error {
+				_, err := fmt.Fprintf(w, "%s Schedule %s deleted.\n", term.SuccessIcon(), args[2])
+				return err
+			})
+		},
+	}
+}
+
+func printSchedules(w io.Writer, schedules []*models.Schedule) error {
+	if len(schedules) == 0 {
+		_, err := fmt.Fprintln(w, "No schedules found.")
+		return err
+	}
+
+	report := [][]string{{
+		term.Bold("ID"), term.Bold("CRON"), term.Bold("ACTION"),
+		term.Bold("ENABLED"), term.Bold("NEXT RUN"), term.Bold("LAST RUN"),
+	}}
+	for _, s := range schedules {
+		lastRun := "-"
+		if len(s.Runs) > 0 {
+			lastRun = fmt.Sprintf("%s %s", formatDateTime(s.Runs[0].ScheduledAt), colourStepStatus(s.Runs[0].Status))
+		}
+		report = append(report, []string{
+			s.ID, s.Cron, describeScheduleAction(s), fmt.Sprint(s.Enabled), formatDateTime(s.NextRunAt), lastRun,
+		})
+	}
+	printer.Table(w, report)
+	return nil
+}
+
+func printSchedule(w io.Writer, s *models.Schedule) error {
+	_, _ = fmt.Fprintf(w, "Schedule %s of %s\n", term.Bold(s.ID), s.Urn)
+	_, _ = fmt.Fprintf(w, "Cron:     %s\n", s.Cron)
+	_, _ = fmt.Fprintf(w, "Action:   %s\n", describeScheduleAction(s))
+	_, _ = fmt.Fprintf(w, "Enabled:  %t\n", s.Enabled)
+	_, _ = fmt.Fprintf(w, "Next run: %s\n", formatDateTime(s.NextRunAt))
+
+	if len(s.Runs) == 0 {
+		return nil
+	}
+
+	report := [][]string{{term.Bold("SCHEDULED AT"), term.Bold("STATUS"), term.Bold("OPERATION"), term.Bold("ERROR")}}
+	for _, run := range s.Runs {
+		report = append(report, []string{
+			formatDateTime(run.ScheduledAt), colourStepStatus(run.Status), run.OperationID, run.Error,
+		})
+	}
+	_, _ = fmt.Fprintln(w)
+	printer.Table(w, report)
+	return nil
+}
+
+func describeScheduleAction(s *models.Schedule) string {
+	params, _ := s.Params.(map[string]any)
+	if replicas, ok := params["replicas"]; ok {
+		return fmt.Sprintf("%s (replicas=%v)", s.Action, replicas)
+	}
+	return s.Action
+}
+
+func formatDateTime(t strfmt.DateTime) string {
+	dt := time.Time(t)
+	if dt.IsZero() {
+		return "-"
+	}
+	return dt.Local().Format(time.RFC3339)
+}
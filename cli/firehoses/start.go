@@ -2,9 +2,6 @@
 package firehoses
 
 import (
-	"fmt"
-	"io"
-
 	"github.com/odpf/salt/printer"
 	"github.com/spf13/cobra"
 
@@ -13,6 +10,8 @@ import (
 )
 
 func startCommand() *cobra.Command {
+	var wait waitOpts
+
 	cmd := &cobra.Command{
 		Use:   "start <project> <firehoseURN>",
 		Short: "Start the firehose if it's currently stopped.",
@@ -33,12 +32,10 @@ func startCommand() *cobra.Command {
 				return err
 			}
 			spinner.Stop()
-
-			return cdk.Display(cmd, modifiedFirehose, func(w io.Writer, v interface{}) error {
-				_, err := fmt.Fprintln(w, "Start request accepted. Use view command to check status.")
-				return err
-			})
+			return finishAction(cmd, args[0], args[1], wait, modifiedFirehose, "Start")
 		},
 	}
+
+	wait.addFlags(cmd)
 	return cmd
 }
@@ -1,9 +1,6 @@
 package firehoses
 
 import (
-	"fmt"
-	"io"
-
 	"github.com/odpf/salt/printer"
 	"github.com/spf13/cobra"
 
@@ -12,6 +9,8 @@ import (
 )
 
 func stopCommand() *cobra.Command {
+	var wait waitOpts
+
 	cmd := &cobra.Command{
 		Use:   "stop <project> <firehoseURN>",
 		Short: "Stop the firehose if it's currently running.",
@@ -32,11 +31,11 @@ func stopCommand() *cobra.Command {
 				return err
 			}
 
-			return cdk.Display(cmd, modifiedFirehose, func(w io.Writer, v interface{}) error {
-				_, err := fmt.Fprintln(w, "Stop request accepted. Use view command to check status.")
-				return err
-			})
+			spinner.Stop()
+			return finishAction(cmd, args[0], args[1], wait, modifiedFirehose, "Stop")
 		},
 	}
+
+	wait.addFlags(cmd)
 	return cmd
 }
@@ -2,9 +2,6 @@
 package firehoses
 
 import (
-	"fmt"
-	"io"
-
 	"github.com/odpf/salt/printer"
 	"github.com/spf13/cobra"
 
@@ -13,6 +10,8 @@ import (
 )
 
 func upgradeCommand() *cobra.Command {
+	var wait waitOpts
+
 	cmd := &cobra.Command{
 		Use:   "upgrade <project> <firehoseURN>",
 		Short: "Upgrade the firehose to the latest version supported",
@@ -33,11 +32,11 @@ func upgradeCommand() *cobra.Command {
 				return err
 			}
 
-			return cdk.Display(cmd, modifiedFirehose, func(w io.Writer, v interface{}) error {
-				_, err := fmt.Fprintln(w, "Upgrade request accepted. Use view command to check status.")
-				return err
-			})
+			spinner.Stop()
+			return finishAction(cmd, args[0], args[1], wait, modifiedFirehose, "Upgrade")
 		},
 	}
+
+	wait.addFlags(cmd)
 	return cmd
 }
@@ -10,13 +10,15 @@ import (
 )
 
 func viewCommand() *cobra.Command {
+	var reveal bool
+
 	cmd := &cobra.Command{
 		Use:   "view <project> <name>",
 		Short: "View a firehose",
 		Long:  "Display information about a firehose",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			firehose, err := getFirehose(cmd, args[0], args[1])
+			firehose, err := fetchFirehose(cmd, args[0], args[1], reveal)
 			if err != nil {
 				return err
 			}
@@ -24,16 +26,22 @@ func viewCommand() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().BoolVar(&reveal, "reveal", false, "Show actual values of sensitive env vars (requires manage permission)")
 	return cmd
 }
 
 func getFirehose(cmd *cobra.Command, prjSlug, firehoseID string) (*models.Firehose, error) {
+	return fetchFirehose(cmd, prjSlug, firehoseID, false)
+}
+
+func fetchFirehose(cmd *cobra.Command, prjSlug, firehoseID string, reveal bool) (*models.Firehose, error) {
 	sp := printer.Spin("Fetching firehose...")
 	defer sp.Stop()
 
 	params := &operations.GetFirehoseParams{
 		ProjectSlug: prjSlug,
 		FirehoseUrn: firehoseID,
+		Reveal:      &reveal,
 	}
 
 	cl := cdk.NewClient(cmd)
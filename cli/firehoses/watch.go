@@ -0,0 +1,191 @@
+package firehoses
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/odpf/salt/term"
+	"github.com/spf13/cobra"
+
+	"github.com/odpf/dex/cli/cdk"
+	"github.com/odpf/dex/generated/client/operations"
+	"github.com/odpf/dex/generated/models"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+const (
+	statusPending   = "STATUS_PENDING"
+	statusCompleted = "STATUS_COMPLETED"
+	statusError     = "STATUS_ERROR"
+	statusDeleted   = "STATUS_DELETED"
+
+	defaultWaitTimeout = 10 * time.Minute
+	minPollInterval    = 1 * time.Second
+	maxPollInterval    = 15 * time.Second
+)
+
+type waitOpts struct {
+	Wait    bool
+	Timeout time.Duration
+}
+
+func (opts *waitOpts) addFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&opts.Wait, "wait", false, "Wait until the firehose reaches a terminal status")
+	cmd.Flags().DurationVar(&opts.Timeout, "wait-timeout", defaultWaitTimeout, "Maximum time to wait (0 to wait indefinitely)")
+}
+
+func watchCommand() *cobra.Command {
+	var timeout time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "watch <project> <firehoseURN>",
+		Short: "Watch status transitions of a firehose",
+		Long: heredoc.Doc(`
+			Watch status transitions of a firehose until it reaches a terminal status.
+
+			Exits with non-zero status if the firehose ends in an error status or if
+			the timeout is reached.
+		`),
+		Args: cobra.ExactArgs(2),
+		Example: heredoc.Doc(`
+			$ dex firehose watch project-x orn:entropy:firehose:project-x:my-firehose
+			$ dex firehose watch project-x orn:entropy:firehose:project-x:my-firehose --wait-timeout 2m
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			firehose, err := waitForFirehose(cmd, args[0], args[1], timeout)
+			if err != nil {
+				return err
+			}
+			return displaySettled(cmd, firehose)
+		},
+	}
+
+	cmd.Flags().DurationVar(&timeout, "wait-timeout", defaultWaitTimeout, "Maximum time to wait (0 to wait indefinitely)")
+	return cmd
+}
+
+// finishAction displays the result of a lifecycle action. If wait is enabled,
+// the firehose is watched until it reaches a terminal status and the final
+// version is displayed instead.
+func finishAction(cmd *cobra.Command, prjSlug, urn string, opts waitOpts, accepted any, action string) error {
+	if !opts.Wait {
+		return cdk.Display(cmd, accepted, func(w io.Writer, v interface{}) error {
+			_, err := fmt.Fprintf(w, "%s request accepted. Use view command to check status.\n", action)
+			return err
+		})
+	}
+
+	firehose, err := waitForFirehose(cmd, prjSlug, urn, opts.Timeout)
+	if err != nil {
+		return err
+	}
+	return displaySettled(cmd, firehose)
+}
+
+// waitForFirehose polls the firehose with exponential backoff until it reaches
+// a terminal status. Status transitions are written to stderr as they are seen.
+// An error is returned if the firehose ends in an error status or the timeout
+// is reached. A firehose that no longer exists is treated as deleted.
+func waitForFirehose(cmd *cobra.Command, prjSlug, urn string, timeout time.Duration) (*models.Firehose, error) {
+	var deadline time.Time
+	if timeout > 0 {
+		deadline = time.Now().Add(timeout)
+	}
+
+	dexAPI := cdk.NewClient(cmd)
+	params := &operations.GetFirehoseParams{
+		ProjectSlug: prjSlug,
+		FirehoseUrn: urn,
+	}
+
+	interval := minPollInterval
+	lastStatus := ""
+	var lastErr error
+	for {
+		resp, err := dexAPI.Operations.GetFirehose(params)
+		if err != nil {
+			notFoundErr := &operations.GetFirehoseNotFound{}
+			if errors.As(err, &notFoundErr) {
+				printTransition(os.Stderr, statusDeleted, "")
+				return &models.Firehose{Urn: urn, State: &models.FirehoseState{Status: statusDeleted}}, nil
+			}
+			lastErr = err
+		} else {
+			lastErr = nil
+			firehose := resp.GetPayload()
+
+			status, state := firehoseStatus(firehose)
+			if status != lastStatus {
+				printTransition(os.Stderr, status, state)
+				lastStatus = status
+				interval = minPollInterval
+			}
+
+			switch status {
+			case statusCompleted, statusDeleted:
+				return firehose, nil
+
+			case statusError:
+				return nil, errors.Errorf("firehose %s ended in %s status", urn, statusError)
+			}
+		}
+
+		if !deadline.IsZero() && time.Now().Add(interval).After(deadline) {
+			if lastErr != nil {
+				return nil, errors.Errorf("timed out waiting for firehose: %s", lastErr)
+			}
+			return nil, errors.Errorf("timed out waiting for firehose (last status: %s)", lastStatus)
+		}
+
+		select {
+		case <-cmd.Context().Done():
+			return nil, cmd.Context().Err()
+
+		case <-time.After(interval):
+			interval = interval * 3 / 2
+			if interval > maxPollInterval {
+				interval = maxPollInterval
+			}
+		}
+	}
+}
+
+func firehoseStatus(firehose *models.Firehose) (status, state string) {
+	if firehose.State == nil {
+		return "", ""
+	}
+	return firehose.State.Status, firehose.State.State
+}
+
+func printTransition(w io.Writer, status, state string) {
+	line := fmt.Sprintf("%s  %s", term.Grey(time.Now().Format(time.Kitchen)), colourStatus(status))
+	if state != "" {
+		line += fmt.Sprintf(" (state: %s)", state)
+	}
+	_, _ = fmt.Fprintln(w, line)
+}
+
+func colourStatus(status string) string {
+	switch status {
+	case statusCompleted:
+		return term.Green(status)
+	case statusPending:
+		return term.Yellow(status)
+	case statusError, statusDeleted:
+		return term.Red(status)
+	default:
+		return status
+	}
+}
+
+func displaySettled(cmd *cobra.Command, firehose *models.Firehose) error {
+	return cdk.Display(cmd, firehose, func(w io.Writer, v any) error {
+		status, _ := firehoseStatus(firehose)
+		_, err := fmt.Fprintf(w, "%s Firehose %s settled with status %s.\n",
+			term.SuccessIcon(), firehose.Urn, colourStatus(status))
+		return err
+	})
+}
@@ -11,9 +11,13 @@ import (
 
 	"github.com/odpf/dex/cli/cdk"
 	"github.com/odpf/dex/generated/client/operations"
+	"github.com/odpf/dex/generated/models"
 )
 
 func listCommand() *cobra.Command {
+	var limit int
+	var sortBy string
+
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List projects.",
@@ -23,14 +27,32 @@ func listCommand() *cobra.Command {
 
 			client := cdk.NewClient(cmd)
 
-			params := operations.ListProjectsParams{}
+			params := operations.ListProjectsParams{
+				PageSize: cdk.PageSize(limit),
+			}
+			if sortBy != "" {
+				params.Sort = &sortBy
+			}
 			params.SetTimeout(10 * time.Second)
 
-			res, err := client.Operations.ListProjects(&params)
-			if err != nil {
-				return err
+			var projects []*models.Project
+			for {
+				res, err := client.Operations.ListProjects(&params)
+				if err != nil {
+					return err
+				}
+				page := res.GetPayload()
+				projects = append(projects, page.Items...)
+
+				if !cdk.HasMorePages(page.NextPageToken, len(projects), limit) {
+					break
+				}
+				params.PageToken = &page.NextPageToken
+			}
+
+			if limit > 0 && len(projects) > limit {
+				projects = projects[:limit]
 			}
-			projects := res.GetPayload().Items
 			spinner.Stop()
 
 			return cdk.Display(cmd, projects, func(w io.Writer, v interface{}) error {
@@ -47,5 +69,8 @@ func listCommand() *cobra.Command {
 			})
 		},
 	}
+
+	cmd.Flags().StringVar(&sortBy, "sort", "", "Sort by name, created_at or updated_at (prefix '-' for descending)")
+	cmd.Flags().IntVarP(&limit, "limit", "l", 0, "Maximum number of projects to list (0 lists all)")
 	return cmd
 }
@@ -6,6 +6,16 @@ import (
 	"github.com/odpf/salt/config"
 	"github.com/spf13/cobra"
 
+	"github.com/odpf/dex/internal/server/audit"
+	"github.com/odpf/dex/internal/server/authz"
+	"github.com/odpf/dex/internal/server/autoscale"
+	"github.com/odpf/dex/internal/server/kafka"
+	"github.com/odpf/dex/internal/server/mask"
+	"github.com/odpf/dex/internal/server/operation"
+	"github.com/odpf/dex/internal/server/reqctx"
+	"github.com/odpf/dex/internal/server/schedule"
+	"github.com/odpf/dex/internal/server/secret"
+	templatev1 "github.com/odpf/dex/internal/server/v1/template"
 	"github.com/odpf/dex/pkg/errors"
 	"github.com/odpf/dex/pkg/logger"
 	"github.com/odpf/dex/pkg/telemetry"
@@ -13,12 +23,22 @@ import (
 
 // serverConfig contains the application configuration.
 type serverConfig struct {
-	Log       logger.LogConfig `mapstructure:"log"`
-	Service   serveConfig      `mapstructure:"service"`
-	Shield    shieldConfig     `mapstructure:"shield"`
-	Entropy   entropyConfig    `mapstructure:"entropy"`
-	Siren     sirenConfig      `mapstructure:"siren"`
-	Telemetry telemetry.Config `mapstructure:"telemetry"`
+	Log        logger.LogConfig  `mapstructure:"log"`
+	Service    serveConfig       `mapstructure:"service"`
+	Shield     shieldConfig      `mapstructure:"shield"`
+	Entropy    entropyConfig     `mapstructure:"entropy"`
+	Siren      sirenConfig       `mapstructure:"siren"`
+	Auth       reqctx.AuthConfig `mapstructure:"auth"`
+	Authz      authz.Config      `mapstructure:"authz"`
+	Audit      audit.Config      `mapstructure:"audit"`
+	Templates  templatev1.Config `mapstructure:"templates"`
+	Secrets    secret.Config     `mapstructure:"secrets"`
+	Masking    mask.Config       `mapstructure:"masking"`
+	Kafka      kafka.Config      `mapstructure:"kafka"`
+	Operations operation.Config  `mapstructure:"operations"`
+	Schedules  schedule.Config   `mapstructure:"schedules"`
+	Autoscale  autoscale.Config  `mapstructure:"autoscale"`
+	Telemetry  telemetry.Config  `mapstructure:"telemetry"`
 }
 
 type shieldConfig struct {
@@ -92,5 +92,15 @@ func runServer(baseCtx context.Context, nrApp *newrelic.Application, zapLog *zap
 		shieldv1beta1.NewShieldServiceClient(shieldConn),
 		entropyv1beta1.NewResourceServiceClient(entropyConn),
 		sirenv1beta1.NewSirenServiceClient(sirenConn),
+		cfg.Auth,
+		cfg.Authz,
+		cfg.Audit,
+		cfg.Templates,
+		cfg.Secrets,
+		cfg.Masking,
+		cfg.Kafka,
+		cfg.Operations,
+		cfg.Schedules,
+		cfg.Autoscale,
 	)
 }
@@ -0,0 +1,225 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewCloneFirehoseParams creates a new CloneFirehoseParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewCloneFirehoseParams() *CloneFirehoseParams {
+	return &CloneFirehoseParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewCloneFirehoseParamsWithTimeout creates a new CloneFirehoseParams object
+// with the ability to set a timeout on a request.
+func NewCloneFirehoseParamsWithTimeout(timeout time.Duration) *CloneFirehoseParams {
+	return &CloneFirehoseParams{
+		timeout: timeout,
+	}
+}
+
+// NewCloneFirehoseParamsWithContext creates a new CloneFirehoseParams object
+// with the ability to set a context for a request.
+func NewCloneFirehoseParamsWithContext(ctx context.Context) *CloneFirehoseParams {
+	return &CloneFirehoseParams{
+		Context: ctx,
+	}
+}
+
+// NewCloneFirehoseParamsWithHTTPClient creates a new CloneFirehoseParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewCloneFirehoseParamsWithHTTPClient(client *http.Client) *CloneFirehoseParams {
+	return &CloneFirehoseParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+CloneFirehoseParams contains all the parameters to send to the API endpoint
+
+	for the clone firehose operation.
+
+	Typically these are written to a http.Request.
+*/
+type CloneFirehoseParams struct {
+
+	// Body.
+	Body CloneFirehoseBody
+
+	/* DryRun.
+
+	   Only validate the request and return the clone as it would be created.
+	*/
+	DryRun *bool
+
+	/* FirehoseUrn.
+
+	   URN of the firehose to clone.
+	*/
+	FirehoseUrn string
+
+	/* ProjectSlug.
+
+	   Identifier for the project.
+	*/
+	ProjectSlug string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the clone firehose params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CloneFirehoseParams) WithDefaults() *CloneFirehoseParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the clone firehose params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CloneFirehoseParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the clone firehose params
+func (o *CloneFirehoseParams) WithTimeout(timeout time.Duration) *CloneFirehoseParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the clone firehose params
+func (o *CloneFirehoseParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the clone firehose params
+func (o *CloneFirehoseParams) WithContext(ctx context.Context) *CloneFirehoseParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the clone firehose params
+func (o *CloneFirehoseParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the clone firehose params
+func (o *CloneFirehoseParams) WithHTTPClient(client *http.Client) *CloneFirehoseParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the clone firehose params
+func (o *CloneFirehoseParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the clone firehose params
+func (o *CloneFirehoseParams) WithBody(body CloneFirehoseBody) *CloneFirehoseParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the clone firehose params
+func (o *CloneFirehoseParams) SetBody(body CloneFirehoseBody) {
+	o.Body = body
+}
+
+// WithDryRun adds the dryRun to the clone firehose params
+func (o *CloneFirehoseParams) WithDryRun(dryRun *bool) *CloneFirehoseParams {
+	o.SetDryRun(dryRun)
+	return o
+}
+
+// SetDryRun adds the dryRun to the clone firehose params
+func (o *CloneFirehoseParams) SetDryRun(dryRun *bool) {
+	o.DryRun = dryRun
+}
+
+// WithFirehoseUrn adds the firehoseUrn to the clone firehose params
+func (o *CloneFirehoseParams) WithFirehoseUrn(firehoseUrn string) *CloneFirehoseParams {
+	o.SetFirehoseUrn(firehoseUrn)
+	return o
+}
+
+// SetFirehoseUrn adds the firehoseUrn to the clone firehose params
+func (o *CloneFirehoseParams) SetFirehoseUrn(firehoseUrn string) {
+	o.FirehoseUrn = firehoseUrn
+}
+
+// WithProjectSlug adds the projectSlug to the clone firehose params
+func (o *CloneFirehoseParams) WithProjectSlug(projectSlug string) *CloneFirehoseParams {
+	o.SetProjectSlug(projectSlug)
+	return o
+}
+
+// SetProjectSlug adds the projectSlug to the clone firehose params
+func (o *CloneFirehoseParams) SetProjectSlug(projectSlug string) {
+	o.ProjectSlug = projectSlug
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *CloneFirehoseParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if err := r.SetBodyParam(o.Body); err != nil {
+		return err
+	}
+
+	if o.DryRun != nil {
+
+		// query param dry_run
+		var qrDryRun bool
+
+		if o.DryRun != nil {
+			qrDryRun = *o.DryRun
+		}
+		qDryRun := swag.FormatBool(qrDryRun)
+		if qDryRun != "" {
+
+			if err := r.SetQueryParam("dry_run", qDryRun); err != nil {
+				return err
+			}
+		}
+	}
+
+	// path param firehoseUrn
+	if err := r.SetPathParam("firehoseUrn", o.FirehoseUrn); err != nil {
+		return err
+	}
+
+	// path param projectSlug
+	if err := r.SetPathParam("projectSlug", o.ProjectSlug); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,515 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/odpf/dex/generated/models"
+)
+
+// CloneFirehoseReader is a Reader for the CloneFirehose structure.
+type CloneFirehoseReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CloneFirehoseReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewCloneFirehoseOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 201:
+		result := NewCloneFirehoseCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewCloneFirehoseBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewCloneFirehoseNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewCloneFirehoseConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewCloneFirehoseInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewCloneFirehoseOK creates a CloneFirehoseOK with default headers values
+func NewCloneFirehoseOK() *CloneFirehoseOK {
+	return &CloneFirehoseOK{}
+}
+
+/*
+CloneFirehoseOK describes a response with status code 200, with default header values.
+
+Request is valid. Returned only for dry-run requests.
+*/
+type CloneFirehoseOK struct {
+	Payload *models.Firehose
+}
+
+// IsSuccess returns true when this clone firehose o k response has a 2xx status code
+func (o *CloneFirehoseOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this clone firehose o k response has a 3xx status code
+func (o *CloneFirehoseOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this clone firehose o k response has a 4xx status code
+func (o *CloneFirehoseOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this clone firehose o k response has a 5xx status code
+func (o *CloneFirehoseOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this clone firehose o k response a status code equal to that given
+func (o *CloneFirehoseOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *CloneFirehoseOK) Error() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/clone][%d] cloneFirehoseOK  %+v", 200, o.Payload)
+}
+
+func (o *CloneFirehoseOK) String() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/clone][%d] cloneFirehoseOK  %+v", 200, o.Payload)
+}
+
+func (o *CloneFirehoseOK) GetPayload() *models.Firehose {
+	return o.Payload
+}
+
+func (o *CloneFirehoseOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Firehose)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCloneFirehoseCreated creates a CloneFirehoseCreated with default headers values
+func NewCloneFirehoseCreated() *CloneFirehoseCreated {
+	return &CloneFirehoseCreated{}
+}
+
+/*
+CloneFirehoseCreated describes a response with status code 201, with default header values.
+
+Successfully created the clone.
+*/
+type CloneFirehoseCreated struct {
+
+	/* Id of the operation tracking the changes. Not set for dry-run requests.
+	 */
+	XOperationID string
+
+	Payload *models.Firehose
+}
+
+// IsSuccess returns true when this clone firehose created response has a 2xx status code
+func (o *CloneFirehoseCreated) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this clone firehose created response has a 3xx status code
+func (o *CloneFirehoseCreated) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this clone firehose created response has a 4xx status code
+func (o *CloneFirehoseCreated) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this clone firehose created response has a 5xx status code
+func (o *CloneFirehoseCreated) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this clone firehose created response a status code equal to that given
+func (o *CloneFirehoseCreated) IsCode(code int) bool {
+	return code == 201
+}
+
+func (o *CloneFirehoseCreated) Error() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/clone][%d] cloneFirehoseCreated  %+v", 201, o.Payload)
+}
+
+func (o *CloneFirehoseCreated) String() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/clone][%d] cloneFirehoseCreated  %+v", 201, o.Payload)
+}
+
+func (o *CloneFirehoseCreated) GetPayload() *models.Firehose {
+	return o.Payload
+}
+
+func (o *CloneFirehoseCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header X-Operation-Id
+	hdrXOperationID := response.GetHeader("X-Operation-Id")
+
+	if hdrXOperationID != "" {
+		o.XOperationID = hdrXOperationID
+	}
+
+	o.Payload = new(models.Firehose)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCloneFirehoseBadRequest creates a CloneFirehoseBadRequest with default headers values
+func NewCloneFirehoseBadRequest() *CloneFirehoseBadRequest {
+	return &CloneFirehoseBadRequest{}
+}
+
+/*
+CloneFirehoseBadRequest describes a response with status code 400, with default header values.
+
+Request was invalid.
+*/
+type CloneFirehoseBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this clone firehose bad request response has a 2xx status code
+func (o *CloneFirehoseBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this clone firehose bad request response has a 3xx status code
+func (o *CloneFirehoseBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this clone firehose bad request response has a 4xx status code
+func (o *CloneFirehoseBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this clone firehose bad request response has a 5xx status code
+func (o *CloneFirehoseBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this clone firehose bad request response a status code equal to that given
+func (o *CloneFirehoseBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *CloneFirehoseBadRequest) Error() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/clone][%d] cloneFirehoseBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *CloneFirehoseBadRequest) String() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/clone][%d] cloneFirehoseBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *CloneFirehoseBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *CloneFirehoseBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCloneFirehoseNotFound creates a CloneFirehoseNotFound with default headers values
+func NewCloneFirehoseNotFound() *CloneFirehoseNotFound {
+	return &CloneFirehoseNotFound{}
+}
+
+/*
+CloneFirehoseNotFound describes a response with status code 404, with default header values.
+
+Firehose or target project was not found.
+*/
+type CloneFirehoseNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this clone firehose not found response has a 2xx status code
+func (o *CloneFirehoseNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this clone firehose not found response has a 3xx status code
+func (o *CloneFirehoseNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this clone firehose not found response has a 4xx status code
+func (o *CloneFirehoseNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this clone firehose not found response has a 5xx status code
+func (o *CloneFirehoseNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this clone firehose not found response a status code equal to that given
+func (o *CloneFirehoseNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *CloneFirehoseNotFound) Error() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/clone][%d] cloneFirehoseNotFound  %+v", 404, o.Payload)
+}
+
+func (o *CloneFirehoseNotFound) String() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/clone][%d] cloneFirehoseNotFound  %+v", 404, o.Payload)
+}
+
+func (o *CloneFirehoseNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *CloneFirehoseNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCloneFirehoseConflict creates a CloneFirehoseConflict with default headers values
+func NewCloneFirehoseConflict() *CloneFirehoseConflict {
+	return &CloneFirehoseConflict{}
+}
+
+/*
+CloneFirehoseConflict describes a response with status code 409, with default header values.
+
+A firehose with the same name already exists in the target project.
+*/
+type CloneFirehoseConflict struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this clone firehose conflict response has a 2xx status code
+func (o *CloneFirehoseConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this clone firehose conflict response has a 3xx status code
+func (o *CloneFirehoseConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this clone firehose conflict response has a 4xx status code
+func (o *CloneFirehoseConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this clone firehose conflict response has a 5xx status code
+func (o *CloneFirehoseConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this clone firehose conflict response a status code equal to that given
+func (o *CloneFirehoseConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+func (o *CloneFirehoseConflict) Error() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/clone][%d] cloneFirehoseConflict  %+v", 409, o.Payload)
+}
+
+func (o *CloneFirehoseConflict) String() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/clone][%d] cloneFirehoseConflict  %+v", 409, o.Payload)
+}
+
+func (o *CloneFirehoseConflict) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *CloneFirehoseConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCloneFirehoseInternalServerError creates a CloneFirehoseInternalServerError with default headers values
+func NewCloneFirehoseInternalServerError() *CloneFirehoseInternalServerError {
+	return &CloneFirehoseInternalServerError{}
+}
+
+/*
+CloneFirehoseInternalServerError describes a response with status code 500, with default header values.
+
+internal error
+*/
+type CloneFirehoseInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this clone firehose internal server error response has a 2xx status code
+func (o *CloneFirehoseInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this clone firehose internal server error response has a 3xx status code
+func (o *CloneFirehoseInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this clone firehose internal server error response has a 4xx status code
+func (o *CloneFirehoseInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this clone firehose internal server error response has a 5xx status code
+func (o *CloneFirehoseInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this clone firehose internal server error response a status code equal to that given
+func (o *CloneFirehoseInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *CloneFirehoseInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/clone][%d] cloneFirehoseInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *CloneFirehoseInternalServerError) String() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/clone][%d] cloneFirehoseInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *CloneFirehoseInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *CloneFirehoseInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*
+CloneFirehoseBody clone firehose body
+swagger:model CloneFirehoseBody
+*/
+type CloneFirehoseBody struct {
+
+	// Kafka consumer group of the clone. Defaults to '<project>-<name>' so that the
+	// clone does not share the offsets of the source.
+	//
+	ConsumerGroupID string `json:"consumer_group_id,omitempty"`
+
+	// Env vars to add or override on top of the env vars of the source.
+	EnvVars map[string]string `json:"env_vars,omitempty"`
+
+	// URN of the kubernetes cluster to deploy the clone to. Defaults to the cluster of the source.
+	KubeCluster string `json:"kube_cluster,omitempty"`
+
+	// Name of the clone. Defaults to the name of the source.
+	Name string `json:"name,omitempty"`
+
+	// Slug of the project to create the clone in. Defaults to the project of the source.
+	Project string `json:"project,omitempty"`
+
+	// Create the clone in stopped state.
+	Stopped bool `json:"stopped,omitempty"`
+
+	// Title of the clone. Defaults to the title of the source.
+	Title string `json:"title,omitempty"`
+}
+
+// Validate validates this clone firehose body
+func (o *CloneFirehoseBody) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this clone firehose body based on context it is used
+func (o *CloneFirehoseBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *CloneFirehoseBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *CloneFirehoseBody) UnmarshalBinary(b []byte) error {
+	var res CloneFirehoseBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
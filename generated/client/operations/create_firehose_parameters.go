@@ -14,6 +14,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 
 	"github.com/odpf/dex/generated/models"
 )
@@ -66,12 +67,34 @@ type CreateFirehoseParams struct {
 	// Body.
 	Body *models.Firehose
 
+	/* DryRun.
+
+	     Only validate the request and return the firehose as it would be created.
+	No changes are applied.
+
+	*/
+	DryRun *bool
+
 	/* ProjectSlug.
 
 	   Unique identifier of the project.
 	*/
 	ProjectSlug string
 
+	/* Template.
+
+	     Name of the firehose template to render the configs from. Configs set in
+	the body take precedence over the ones in the template.
+
+	*/
+	Template *string
+
+	/* Var.
+
+	   Values for the template variables in 'name=value' format.
+	*/
+	Var []string
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -136,6 +159,17 @@ func (o *CreateFirehoseParams) SetBody(body *models.Firehose) {
 	o.Body = body
 }
 
+// WithDryRun adds the dryRun to the create firehose params
+func (o *CreateFirehoseParams) WithDryRun(dryRun *bool) *CreateFirehoseParams {
+	o.SetDryRun(dryRun)
+	return o
+}
+
+// SetDryRun adds the dryRun to the create firehose params
+func (o *CreateFirehoseParams) SetDryRun(dryRun *bool) {
+	o.DryRun = dryRun
+}
+
 // WithProjectSlug adds the projectSlug to the create firehose params
 func (o *CreateFirehoseParams) WithProjectSlug(projectSlug string) *CreateFirehoseParams {
 	o.SetProjectSlug(projectSlug)
@@ -147,6 +181,28 @@ func (o *CreateFirehoseParams) SetProjectSlug(projectSlug string) {
 	o.ProjectSlug = projectSlug
 }
 
+// WithTemplate adds the template to the create firehose params
+func (o *CreateFirehoseParams) WithTemplate(template *string) *CreateFirehoseParams {
+	o.SetTemplate(template)
+	return o
+}
+
+// SetTemplate adds the template to the create firehose params
+func (o *CreateFirehoseParams) SetTemplate(template *string) {
+	o.Template = template
+}
+
+// WithVar adds the varVar to the create firehose params
+func (o *CreateFirehoseParams) WithVar(varVar []string) *CreateFirehoseParams {
+	o.SetVar(varVar)
+	return o
+}
+
+// SetVar adds the var to the create firehose params
+func (o *CreateFirehoseParams) SetVar(varVar []string) {
+	o.Var = varVar
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *CreateFirehoseParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -160,13 +216,75 @@ func (o *CreateFirehoseParams) WriteToRequest(r runtime.ClientRequest, reg strfm
 		}
 	}
 
+	if o.DryRun != nil {
+
+		// query param dry_run
+		var qrDryRun bool
+
+		if o.DryRun != nil {
+			qrDryRun = *o.DryRun
+		}
+		qDryRun := swag.FormatBool(qrDryRun)
+		if qDryRun != "" {
+
+			if err := r.SetQueryParam("dry_run", qDryRun); err != nil {
+				return err
+			}
+		}
+	}
+
 	// path param projectSlug
 	if err := r.SetPathParam("projectSlug", o.ProjectSlug); err != nil {
 		return err
 	}
 
+	if o.Template != nil {
+
+		// query param template
+		var qrTemplate string
+
+		if o.Template != nil {
+			qrTemplate = *o.Template
+		}
+		qTemplate := qrTemplate
+		if qTemplate != "" {
+
+			if err := r.SetQueryParam("template", qTemplate); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Var != nil {
+
+		// binding items for var
+		joinedVar := o.bindParamVar(reg)
+
+		// query array param var
+		if err := r.SetQueryParam("var", joinedVar...); err != nil {
+			return err
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
+
+// bindParamCreateFirehose binds the parameter var
+func (o *CreateFirehoseParams) bindParamVar(formats strfmt.Registry) []string {
+	varIR := o.Var
+
+	var varIC []string
+	for _, varIIR := range varIR { // explode []string
+
+		varIIV := varIIR // string as string
+		varIC = append(varIC, varIIV)
+	}
+
+	// items.CollectionFormat: "multi"
+	varIS := swag.JoinByFormat(varIC, "multi")
+
+	return varIS
+}
@@ -23,6 +23,12 @@ type CreateFirehoseReader struct {
 // ReadResponse reads a server response into the received o.
 func (o *CreateFirehoseReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
 	switch response.Code() {
+	case 200:
+		result := NewCreateFirehoseOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
 	case 201:
 		result := NewCreateFirehoseCreated()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -52,6 +58,69 @@ func (o *CreateFirehoseReader) ReadResponse(response runtime.ClientResponse, con
 	}
 }
 
+// NewCreateFirehoseOK creates a CreateFirehoseOK with default headers values
+func NewCreateFirehoseOK() *CreateFirehoseOK {
+	return &CreateFirehoseOK{}
+}
+
+/*
+CreateFirehoseOK describes a response with status code 200, with default header values.
+
+Request is valid. Returned only for dry-run requests.
+*/
+type CreateFirehoseOK struct {
+	Payload *models.Firehose
+}
+
+// IsSuccess returns true when this create firehose o k response has a 2xx status code
+func (o *CreateFirehoseOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this create firehose o k response has a 3xx status code
+func (o *CreateFirehoseOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create firehose o k response has a 4xx status code
+func (o *CreateFirehoseOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this create firehose o k response has a 5xx status code
+func (o *CreateFirehoseOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create firehose o k response a status code equal to that given
+func (o *CreateFirehoseOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *CreateFirehoseOK) Error() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses][%d] createFirehoseOK  %+v", 200, o.Payload)
+}
+
+func (o *CreateFirehoseOK) String() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses][%d] createFirehoseOK  %+v", 200, o.Payload)
+}
+
+func (o *CreateFirehoseOK) GetPayload() *models.Firehose {
+	return o.Payload
+}
+
+func (o *CreateFirehoseOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Firehose)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewCreateFirehoseCreated creates a CreateFirehoseCreated with default headers values
 func NewCreateFirehoseCreated() *CreateFirehoseCreated {
 	return &CreateFirehoseCreated{}
@@ -63,6 +132,11 @@ CreateFirehoseCreated describes a response with status code 201, with default he
 Successfully created
 */
 type CreateFirehoseCreated struct {
+
+	/* Id of the operation tracking the changes. Not set for dry-run requests.
+	 */
+	XOperationID string
+
 	Payload *models.Firehose
 }
 
@@ -105,6 +179,13 @@ func (o *CreateFirehoseCreated) GetPayload() *models.Firehose {
 
 func (o *CreateFirehoseCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header X-Operation-Id
+	hdrXOperationID := response.GetHeader("X-Operation-Id")
+
+	if hdrXOperationID != "" {
+		o.XOperationID = hdrXOperationID
+	}
+
 	o.Payload = new(models.Firehose)
 
 	// response payload
@@ -0,0 +1,190 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewCreateFirehoseScheduleParams creates a new CreateFirehoseScheduleParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewCreateFirehoseScheduleParams() *CreateFirehoseScheduleParams {
+	return &CreateFirehoseScheduleParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewCreateFirehoseScheduleParamsWithTimeout creates a new CreateFirehoseScheduleParams object
+// with the ability to set a timeout on a request.
+func NewCreateFirehoseScheduleParamsWithTimeout(timeout time.Duration) *CreateFirehoseScheduleParams {
+	return &CreateFirehoseScheduleParams{
+		timeout: timeout,
+	}
+}
+
+// NewCreateFirehoseScheduleParamsWithContext creates a new CreateFirehoseScheduleParams object
+// with the ability to set a context for a request.
+func NewCreateFirehoseScheduleParamsWithContext(ctx context.Context) *CreateFirehoseScheduleParams {
+	return &CreateFirehoseScheduleParams{
+		Context: ctx,
+	}
+}
+
+// NewCreateFirehoseScheduleParamsWithHTTPClient creates a new CreateFirehoseScheduleParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewCreateFirehoseScheduleParamsWithHTTPClient(client *http.Client) *CreateFirehoseScheduleParams {
+	return &CreateFirehoseScheduleParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+CreateFirehoseScheduleParams contains all the parameters to send to the API endpoint
+
+	for the create firehose schedule operation.
+
+	Typically these are written to a http.Request.
+*/
+type CreateFirehoseScheduleParams struct {
+
+	// Body.
+	Body CreateFirehoseScheduleBody
+
+	/* FirehoseUrn.
+
+	   URN of the firehose.
+	*/
+	FirehoseUrn string
+
+	/* ProjectSlug.
+
+	   Identifier for the project.
+	*/
+	ProjectSlug string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the create firehose schedule params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CreateFirehoseScheduleParams) WithDefaults() *CreateFirehoseScheduleParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the create firehose schedule params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CreateFirehoseScheduleParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the create firehose schedule params
+func (o *CreateFirehoseScheduleParams) WithTimeout(timeout time.Duration) *CreateFirehoseScheduleParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the create firehose schedule params
+func (o *CreateFirehoseScheduleParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the create firehose schedule params
+func (o *CreateFirehoseScheduleParams) WithContext(ctx context.Context) *CreateFirehoseScheduleParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the create firehose schedule params
+func (o *CreateFirehoseScheduleParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the create firehose schedule params
+func (o *CreateFirehoseScheduleParams) WithHTTPClient(client *http.Client) *CreateFirehoseScheduleParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the create firehose schedule params
+func (o *CreateFirehoseScheduleParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the create firehose schedule params
+func (o *CreateFirehoseScheduleParams) WithBody(body CreateFirehoseScheduleBody) *CreateFirehoseScheduleParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the create firehose schedule params
+func (o *CreateFirehoseScheduleParams) SetBody(body CreateFirehoseScheduleBody) {
+	o.Body = body
+}
+
+// WithFirehoseUrn adds the firehoseUrn to the create firehose schedule params
+func (o *CreateFirehoseScheduleParams) WithFirehoseUrn(firehoseUrn string) *CreateFirehoseScheduleParams {
+	o.SetFirehoseUrn(firehoseUrn)
+	return o
+}
+
+// SetFirehoseUrn adds the firehoseUrn to the create firehose schedule params
+func (o *CreateFirehoseScheduleParams) SetFirehoseUrn(firehoseUrn string) {
+	o.FirehoseUrn = firehoseUrn
+}
+
+// WithProjectSlug adds the projectSlug to the create firehose schedule params
+func (o *CreateFirehoseScheduleParams) WithProjectSlug(projectSlug string) *CreateFirehoseScheduleParams {
+	o.SetProjectSlug(projectSlug)
+	return o
+}
+
+// SetProjectSlug adds the projectSlug to the create firehose schedule params
+func (o *CreateFirehoseScheduleParams) SetProjectSlug(projectSlug string) {
+	o.ProjectSlug = projectSlug
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *CreateFirehoseScheduleParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if err := r.SetBodyParam(o.Body); err != nil {
+		return err
+	}
+
+	// path param firehoseUrn
+	if err := r.SetPathParam("firehoseUrn", o.FirehoseUrn); err != nil {
+		return err
+	}
+
+	// path param projectSlug
+	if err := r.SetPathParam("projectSlug", o.ProjectSlug); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,517 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+
+	"github.com/odpf/dex/generated/models"
+)
+
+// CreateFirehoseScheduleReader is a Reader for the CreateFirehoseSchedule structure.
+type CreateFirehoseScheduleReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CreateFirehoseScheduleReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 201:
+		result := NewCreateFirehoseScheduleCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewCreateFirehoseScheduleBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewCreateFirehoseScheduleNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewCreateFirehoseScheduleInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewCreateFirehoseScheduleCreated creates a CreateFirehoseScheduleCreated with default headers values
+func NewCreateFirehoseScheduleCreated() *CreateFirehoseScheduleCreated {
+	return &CreateFirehoseScheduleCreated{}
+}
+
+/*
+CreateFirehoseScheduleCreated describes a response with status code 201, with default header values.
+
+Successfully created the schedule.
+*/
+type CreateFirehoseScheduleCreated struct {
+	Payload *models.Schedule
+}
+
+// IsSuccess returns true when this create firehose schedule created response has a 2xx status code
+func (o *CreateFirehoseScheduleCreated) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this create firehose schedule created response has a 3xx status code
+func (o *CreateFirehoseScheduleCreated) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create firehose schedule created response has a 4xx status code
+func (o *CreateFirehoseScheduleCreated) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this create firehose schedule created response has a 5xx status code
+func (o *CreateFirehoseScheduleCreated) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create firehose schedule created response a status code equal to that given
+func (o *CreateFirehoseScheduleCreated) IsCode(code int) bool {
+	return code == 201
+}
+
+func (o *CreateFirehoseScheduleCreated) Error() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/schedules][%d] createFirehoseScheduleCreated  %+v", 201, o.Payload)
+}
+
+func (o *CreateFirehoseScheduleCreated) String() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/schedules][%d] createFirehoseScheduleCreated  %+v", 201, o.Payload)
+}
+
+func (o *CreateFirehoseScheduleCreated) GetPayload() *models.Schedule {
+	return o.Payload
+}
+
+func (o *CreateFirehoseScheduleCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Schedule)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateFirehoseScheduleBadRequest creates a CreateFirehoseScheduleBadRequest with default headers values
+func NewCreateFirehoseScheduleBadRequest() *CreateFirehoseScheduleBadRequest {
+	return &CreateFirehoseScheduleBadRequest{}
+}
+
+/*
+CreateFirehoseScheduleBadRequest describes a response with status code 400, with default header values.
+
+Request was invalid.
+*/
+type CreateFirehoseScheduleBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this create firehose schedule bad request response has a 2xx status code
+func (o *CreateFirehoseScheduleBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this create firehose schedule bad request response has a 3xx status code
+func (o *CreateFirehoseScheduleBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create firehose schedule bad request response has a 4xx status code
+func (o *CreateFirehoseScheduleBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this create firehose schedule bad request response has a 5xx status code
+func (o *CreateFirehoseScheduleBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create firehose schedule bad request response a status code equal to that given
+func (o *CreateFirehoseScheduleBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *CreateFirehoseScheduleBadRequest) Error() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/schedules][%d] createFirehoseScheduleBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *CreateFirehoseScheduleBadRequest) String() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/schedules][%d] createFirehoseScheduleBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *CreateFirehoseScheduleBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *CreateFirehoseScheduleBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateFirehoseScheduleNotFound creates a CreateFirehoseScheduleNotFound with default headers values
+func NewCreateFirehoseScheduleNotFound() *CreateFirehoseScheduleNotFound {
+	return &CreateFirehoseScheduleNotFound{}
+}
+
+/*
+CreateFirehoseScheduleNotFound describes a response with status code 404, with default header values.
+
+Firehose with given URN was not found
+*/
+type CreateFirehoseScheduleNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this create firehose schedule not found response has a 2xx status code
+func (o *CreateFirehoseScheduleNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this create firehose schedule not found response has a 3xx status code
+func (o *CreateFirehoseScheduleNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create firehose schedule not found response has a 4xx status code
+func (o *CreateFirehoseScheduleNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this create firehose schedule not found response has a 5xx status code
+func (o *CreateFirehoseScheduleNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create firehose schedule not found response a status code equal to that given
+func (o *CreateFirehoseScheduleNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *CreateFirehoseScheduleNotFound) Error() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/schedules][%d] createFirehoseScheduleNotFound  %+v", 404, o.Payload)
+}
+
+func (o *CreateFirehoseScheduleNotFound) String() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/schedules][%d] createFirehoseScheduleNotFound  %+v", 404, o.Payload)
+}
+
+func (o *CreateFirehoseScheduleNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *CreateFirehoseScheduleNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateFirehoseScheduleInternalServerError creates a CreateFirehoseScheduleInternalServerError with default headers values
+func NewCreateFirehoseScheduleInternalServerError() *CreateFirehoseScheduleInternalServerError {
+	return &CreateFirehoseScheduleInternalServerError{}
+}
+
+/*
+CreateFirehoseScheduleInternalServerError describes a response with status code 500, with default header values.
+
+internal error
+*/
+type CreateFirehoseScheduleInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this create firehose schedule internal server error response has a 2xx status code
+func (o *CreateFirehoseScheduleInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this create firehose schedule internal server error response has a 3xx status code
+func (o *CreateFirehoseScheduleInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create firehose schedule internal server error response has a 4xx status code
+func (o *CreateFirehoseScheduleInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this create firehose schedule internal server error response has a 5xx status code
+func (o *CreateFirehoseScheduleInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this create firehose schedule internal server error response a status code equal to that given
+func (o *CreateFirehoseScheduleInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *CreateFirehoseScheduleInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/schedules][%d] createFirehoseScheduleInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *CreateFirehoseScheduleInternalServerError) String() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/schedules][%d] createFirehoseScheduleInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *CreateFirehoseScheduleInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *CreateFirehoseScheduleInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*
+CreateFirehoseScheduleBody create firehose schedule body
+swagger:model CreateFirehoseScheduleBody
+*/
+type CreateFirehoseScheduleBody struct {
+
+	// action
+	// Required: true
+	// Enum: [start stop scale]
+	Action *string `json:"action"`
+
+	// Standard 5-field cron expression (e.g., '0 22 * * 1-5'). Descriptors such as
+	// '@daily' and a 'CRON_TZ=<zone>' prefix are supported. Times are in UTC by default.
+	//
+	// Example: 0 22 * * *
+	// Required: true
+	Cron *string `json:"cron"`
+
+	// Whether the schedule is active. Defaults to true.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// params
+	Params *CreateFirehoseScheduleParamsBodyParams `json:"params,omitempty"`
+}
+
+// Validate validates this create firehose schedule body
+func (o *CreateFirehoseScheduleBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateAction(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateCron(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateParams(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var createFirehoseScheduleBodyTypeActionPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["start","stop","scale"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		createFirehoseScheduleBodyTypeActionPropEnum = append(createFirehoseScheduleBodyTypeActionPropEnum, v)
+	}
+}
+
+const (
+
+	// CreateFirehoseScheduleBodyActionStart captures enum value "start"
+	CreateFirehoseScheduleBodyActionStart string = "start"
+
+	// CreateFirehoseScheduleBodyActionStop captures enum value "stop"
+	CreateFirehoseScheduleBodyActionStop string = "stop"
+
+	// CreateFirehoseScheduleBodyActionScale captures enum value "scale"
+	CreateFirehoseScheduleBodyActionScale string = "scale"
+)
+
+// prop value enum
+func (o *CreateFirehoseScheduleBody) validateActionEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, createFirehoseScheduleBodyTypeActionPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *CreateFirehoseScheduleBody) validateAction(formats strfmt.Registry) error {
+
+	if err := validate.Required("body"+"."+"action", "body", o.Action); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := o.validateActionEnum("body"+"."+"action", "body", *o.Action); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *CreateFirehoseScheduleBody) validateCron(formats strfmt.Registry) error {
+
+	if err := validate.Required("body"+"."+"cron", "body", o.Cron); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *CreateFirehoseScheduleBody) validateParams(formats strfmt.Registry) error {
+	if swag.IsZero(o.Params) { // not required
+		return nil
+	}
+
+	if o.Params != nil {
+		if err := o.Params.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("body" + "." + "params")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("body" + "." + "params")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this create firehose schedule body based on the context it is used
+func (o *CreateFirehoseScheduleBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateParams(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *CreateFirehoseScheduleBody) contextValidateParams(ctx context.Context, formats strfmt.Registry) error {
+
+	if o.Params != nil {
+		if err := o.Params.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("body" + "." + "params")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("body" + "." + "params")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *CreateFirehoseScheduleBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *CreateFirehoseScheduleBody) UnmarshalBinary(b []byte) error {
+	var res CreateFirehoseScheduleBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+CreateFirehoseScheduleParamsBodyParams Params of the action. 'scale' requires 'replicas'.
+swagger:model CreateFirehoseScheduleParamsBodyParams
+*/
+type CreateFirehoseScheduleParamsBodyParams struct {
+
+	// replicas
+	Replicas int64 `json:"replicas,omitempty"`
+}
+
+// Validate validates this create firehose schedule params body params
+func (o *CreateFirehoseScheduleParamsBodyParams) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this create firehose schedule params body params based on context it is used
+func (o *CreateFirehoseScheduleParamsBodyParams) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *CreateFirehoseScheduleParamsBodyParams) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *CreateFirehoseScheduleParamsBodyParams) UnmarshalBinary(b []byte) error {
+	var res CreateFirehoseScheduleParamsBodyParams
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,172 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/odpf/dex/generated/models"
+)
+
+// NewCreateFirehoseTemplateParams creates a new CreateFirehoseTemplateParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewCreateFirehoseTemplateParams() *CreateFirehoseTemplateParams {
+	return &CreateFirehoseTemplateParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewCreateFirehoseTemplateParamsWithTimeout creates a new CreateFirehoseTemplateParams object
+// with the ability to set a timeout on a request.
+func NewCreateFirehoseTemplateParamsWithTimeout(timeout time.Duration) *CreateFirehoseTemplateParams {
+	return &CreateFirehoseTemplateParams{
+		timeout: timeout,
+	}
+}
+
+// NewCreateFirehoseTemplateParamsWithContext creates a new CreateFirehoseTemplateParams object
+// with the ability to set a context for a request.
+func NewCreateFirehoseTemplateParamsWithContext(ctx context.Context) *CreateFirehoseTemplateParams {
+	return &CreateFirehoseTemplateParams{
+		Context: ctx,
+	}
+}
+
+// NewCreateFirehoseTemplateParamsWithHTTPClient creates a new CreateFirehoseTemplateParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewCreateFirehoseTemplateParamsWithHTTPClient(client *http.Client) *CreateFirehoseTemplateParams {
+	return &CreateFirehoseTemplateParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+CreateFirehoseTemplateParams contains all the parameters to send to the API endpoint
+
+	for the create firehose template operation.
+
+	Typically these are written to a http.Request.
+*/
+type CreateFirehoseTemplateParams struct {
+
+	// Body.
+	Body *models.FirehoseTemplate
+
+	/* ProjectSlug.
+
+	   Unique slug name of the project.
+	*/
+	ProjectSlug string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the create firehose template params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CreateFirehoseTemplateParams) WithDefaults() *CreateFirehoseTemplateParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the create firehose template params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CreateFirehoseTemplateParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the create firehose template params
+func (o *CreateFirehoseTemplateParams) WithTimeout(timeout time.Duration) *CreateFirehoseTemplateParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the create firehose template params
+func (o *CreateFirehoseTemplateParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the create firehose template params
+func (o *CreateFirehoseTemplateParams) WithContext(ctx context.Context) *CreateFirehoseTemplateParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the create firehose template params
+func (o *CreateFirehoseTemplateParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the create firehose template params
+func (o *CreateFirehoseTemplateParams) WithHTTPClient(client *http.Client) *CreateFirehoseTemplateParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the create firehose template params
+func (o *CreateFirehoseTemplateParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the create firehose template params
+func (o *CreateFirehoseTemplateParams) WithBody(body *models.FirehoseTemplate) *CreateFirehoseTemplateParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the create firehose template params
+func (o *CreateFirehoseTemplateParams) SetBody(body *models.FirehoseTemplate) {
+	o.Body = body
+}
+
+// WithProjectSlug adds the projectSlug to the create firehose template params
+func (o *CreateFirehoseTemplateParams) WithProjectSlug(projectSlug string) *CreateFirehoseTemplateParams {
+	o.SetProjectSlug(projectSlug)
+	return o
+}
+
+// SetProjectSlug adds the projectSlug to the create firehose template params
+func (o *CreateFirehoseTemplateParams) SetProjectSlug(projectSlug string) {
+	o.ProjectSlug = projectSlug
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *CreateFirehoseTemplateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param projectSlug
+	if err := r.SetPathParam("projectSlug", o.ProjectSlug); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,305 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/odpf/dex/generated/models"
+)
+
+// CreateFirehoseTemplateReader is a Reader for the CreateFirehoseTemplate structure.
+type CreateFirehoseTemplateReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CreateFirehoseTemplateReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 201:
+		result := NewCreateFirehoseTemplateCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewCreateFirehoseTemplateBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewCreateFirehoseTemplateConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewCreateFirehoseTemplateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewCreateFirehoseTemplateCreated creates a CreateFirehoseTemplateCreated with default headers values
+func NewCreateFirehoseTemplateCreated() *CreateFirehoseTemplateCreated {
+	return &CreateFirehoseTemplateCreated{}
+}
+
+/*
+CreateFirehoseTemplateCreated describes a response with status code 201, with default header values.
+
+Successfully created.
+*/
+type CreateFirehoseTemplateCreated struct {
+	Payload *models.FirehoseTemplate
+}
+
+// IsSuccess returns true when this create firehose template created response has a 2xx status code
+func (o *CreateFirehoseTemplateCreated) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this create firehose template created response has a 3xx status code
+func (o *CreateFirehoseTemplateCreated) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create firehose template created response has a 4xx status code
+func (o *CreateFirehoseTemplateCreated) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this create firehose template created response has a 5xx status code
+func (o *CreateFirehoseTemplateCreated) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create firehose template created response a status code equal to that given
+func (o *CreateFirehoseTemplateCreated) IsCode(code int) bool {
+	return code == 201
+}
+
+func (o *CreateFirehoseTemplateCreated) Error() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoseTemplates][%d] createFirehoseTemplateCreated  %+v", 201, o.Payload)
+}
+
+func (o *CreateFirehoseTemplateCreated) String() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoseTemplates][%d] createFirehoseTemplateCreated  %+v", 201, o.Payload)
+}
+
+func (o *CreateFirehoseTemplateCreated) GetPayload() *models.FirehoseTemplate {
+	return o.Payload
+}
+
+func (o *CreateFirehoseTemplateCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.FirehoseTemplate)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateFirehoseTemplateBadRequest creates a CreateFirehoseTemplateBadRequest with default headers values
+func NewCreateFirehoseTemplateBadRequest() *CreateFirehoseTemplateBadRequest {
+	return &CreateFirehoseTemplateBadRequest{}
+}
+
+/*
+CreateFirehoseTemplateBadRequest describes a response with status code 400, with default header values.
+
+Request was invalid.
+*/
+type CreateFirehoseTemplateBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this create firehose template bad request response has a 2xx status code
+func (o *CreateFirehoseTemplateBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this create firehose template bad request response has a 3xx status code
+func (o *CreateFirehoseTemplateBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create firehose template bad request response has a 4xx status code
+func (o *CreateFirehoseTemplateBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this create firehose template bad request response has a 5xx status code
+func (o *CreateFirehoseTemplateBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create firehose template bad request response a status code equal to that given
+func (o *CreateFirehoseTemplateBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *CreateFirehoseTemplateBadRequest) Error() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoseTemplates][%d] createFirehoseTemplateBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *CreateFirehoseTemplateBadRequest) String() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoseTemplates][%d] createFirehoseTemplateBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *CreateFirehoseTemplateBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *CreateFirehoseTemplateBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateFirehoseTemplateConflict creates a CreateFirehoseTemplateConflict with default headers values
+func NewCreateFirehoseTemplateConflict() *CreateFirehoseTemplateConflict {
+	return &CreateFirehoseTemplateConflict{}
+}
+
+/*
+CreateFirehoseTemplateConflict describes a response with status code 409, with default header values.
+
+A template with same name already exists.
+*/
+type CreateFirehoseTemplateConflict struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this create firehose template conflict response has a 2xx status code
+func (o *CreateFirehoseTemplateConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this create firehose template conflict response has a 3xx status code
+func (o *CreateFirehoseTemplateConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create firehose template conflict response has a 4xx status code
+func (o *CreateFirehoseTemplateConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this create firehose template conflict response has a 5xx status code
+func (o *CreateFirehoseTemplateConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create firehose template conflict response a status code equal to that given
+func (o *CreateFirehoseTemplateConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+func (o *CreateFirehoseTemplateConflict) Error() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoseTemplates][%d] createFirehoseTemplateConflict  %+v", 409, o.Payload)
+}
+
+func (o *CreateFirehoseTemplateConflict) String() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoseTemplates][%d] createFirehoseTemplateConflict  %+v", 409, o.Payload)
+}
+
+func (o *CreateFirehoseTemplateConflict) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *CreateFirehoseTemplateConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateFirehoseTemplateInternalServerError creates a CreateFirehoseTemplateInternalServerError with default headers values
+func NewCreateFirehoseTemplateInternalServerError() *CreateFirehoseTemplateInternalServerError {
+	return &CreateFirehoseTemplateInternalServerError{}
+}
+
+/*
+CreateFirehoseTemplateInternalServerError describes a response with status code 500, with default header values.
+
+internal error
+*/
+type CreateFirehoseTemplateInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this create firehose template internal server error response has a 2xx status code
+func (o *CreateFirehoseTemplateInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this create firehose template internal server error response has a 3xx status code
+func (o *CreateFirehoseTemplateInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create firehose template internal server error response has a 4xx status code
+func (o *CreateFirehoseTemplateInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this create firehose template internal server error response has a 5xx status code
+func (o *CreateFirehoseTemplateInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this create firehose template internal server error response a status code equal to that given
+func (o *CreateFirehoseTemplateInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *CreateFirehoseTemplateInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoseTemplates][%d] createFirehoseTemplateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *CreateFirehoseTemplateInternalServerError) String() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoseTemplates][%d] createFirehoseTemplateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *CreateFirehoseTemplateInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *CreateFirehoseTemplateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
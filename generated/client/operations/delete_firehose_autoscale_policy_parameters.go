@@ -0,0 +1,173 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewDeleteFirehoseAutoscalePolicyParams creates a new DeleteFirehoseAutoscalePolicyParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewDeleteFirehoseAutoscalePolicyParams() *DeleteFirehoseAutoscalePolicyParams {
+	return &DeleteFirehoseAutoscalePolicyParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewDeleteFirehoseAutoscalePolicyParamsWithTimeout creates a new DeleteFirehoseAutoscalePolicyParams object
+// with the ability to set a timeout on a request.
+func NewDeleteFirehoseAutoscalePolicyParamsWithTimeout(timeout time.Duration) *DeleteFirehoseAutoscalePolicyParams {
+	return &DeleteFirehoseAutoscalePolicyParams{
+		timeout: timeout,
+	}
+}
+
+// NewDeleteFirehoseAutoscalePolicyParamsWithContext creates a new DeleteFirehoseAutoscalePolicyParams object
+// with the ability to set a context for a request.
+func NewDeleteFirehoseAutoscalePolicyParamsWithContext(ctx context.Context) *DeleteFirehoseAutoscalePolicyParams {
+	return &DeleteFirehoseAutoscalePolicyParams{
+		Context: ctx,
+	}
+}
+
+// NewDeleteFirehoseAutoscalePolicyParamsWithHTTPClient creates a new DeleteFirehoseAutoscalePolicyParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewDeleteFirehoseAutoscalePolicyParamsWithHTTPClient(client *http.Client) *DeleteFirehoseAutoscalePolicyParams {
+	return &DeleteFirehoseAutoscalePolicyParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+DeleteFirehoseAutoscalePolicyParams contains all the parameters to send to the API endpoint
+
+	for the delete firehose autoscale policy operation.
+
+	Typically these are written to a http.Request.
+*/
+type DeleteFirehoseAutoscalePolicyParams struct {
+
+	/* FirehoseUrn.
+
+	   URN of the firehose.
+	*/
+	FirehoseUrn string
+
+	/* ProjectSlug.
+
+	   Identifier for the project.
+	*/
+	ProjectSlug string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the delete firehose autoscale policy params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DeleteFirehoseAutoscalePolicyParams) WithDefaults() *DeleteFirehoseAutoscalePolicyParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the delete firehose autoscale policy params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DeleteFirehoseAutoscalePolicyParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the delete firehose autoscale policy params
+func (o *DeleteFirehoseAutoscalePolicyParams) WithTimeout(timeout time.Duration) *DeleteFirehoseAutoscalePolicyParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the delete firehose autoscale policy params
+func (o *DeleteFirehoseAutoscalePolicyParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the delete firehose autoscale policy params
+func (o *DeleteFirehoseAutoscalePolicyParams) WithContext(ctx context.Context) *DeleteFirehoseAutoscalePolicyParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the delete firehose autoscale policy params
+func (o *DeleteFirehoseAutoscalePolicyParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the delete firehose autoscale policy params
+func (o *DeleteFirehoseAutoscalePolicyParams) WithHTTPClient(client *http.Client) *DeleteFirehoseAutoscalePolicyParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the delete firehose autoscale policy params
+func (o *DeleteFirehoseAutoscalePolicyParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithFirehoseUrn adds the firehoseUrn to the delete firehose autoscale policy params
+func (o *DeleteFirehoseAutoscalePolicyParams) WithFirehoseUrn(firehoseUrn string) *DeleteFirehoseAutoscalePolicyParams {
+	o.SetFirehoseUrn(firehoseUrn)
+	return o
+}
+
+// SetFirehoseUrn adds the firehoseUrn to the delete firehose autoscale policy params
+func (o *DeleteFirehoseAutoscalePolicyParams) SetFirehoseUrn(firehoseUrn string) {
+	o.FirehoseUrn = firehoseUrn
+}
+
+// WithProjectSlug adds the projectSlug to the delete firehose autoscale policy params
+func (o *DeleteFirehoseAutoscalePolicyParams) WithProjectSlug(projectSlug string) *DeleteFirehoseAutoscalePolicyParams {
+	o.SetProjectSlug(projectSlug)
+	return o
+}
+
+// SetProjectSlug adds the projectSlug to the delete firehose autoscale policy params
+func (o *DeleteFirehoseAutoscalePolicyParams) SetProjectSlug(projectSlug string) {
+	o.ProjectSlug = projectSlug
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *DeleteFirehoseAutoscalePolicyParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param firehoseUrn
+	if err := r.SetPathParam("firehoseUrn", o.FirehoseUrn); err != nil {
+		return err
+	}
+
+	// path param projectSlug
+	if err := r.SetPathParam("projectSlug", o.ProjectSlug); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,224 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/odpf/dex/generated/models"
+)
+
+// DeleteFirehoseAutoscalePolicyReader is a Reader for the DeleteFirehoseAutoscalePolicy structure.
+type DeleteFirehoseAutoscalePolicyReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DeleteFirehoseAutoscalePolicyReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewDeleteFirehoseAutoscalePolicyNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewDeleteFirehoseAutoscalePolicyNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewDeleteFirehoseAutoscalePolicyInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewDeleteFirehoseAutoscalePolicyNoContent creates a DeleteFirehoseAutoscalePolicyNoContent with default headers values
+func NewDeleteFirehoseAutoscalePolicyNoContent() *DeleteFirehoseAutoscalePolicyNoContent {
+	return &DeleteFirehoseAutoscalePolicyNoContent{}
+}
+
+/*
+DeleteFirehoseAutoscalePolicyNoContent describes a response with status code 204, with default header values.
+
+Successfully deleted the autoscale policy.
+*/
+type DeleteFirehoseAutoscalePolicyNoContent struct {
+}
+
+// IsSuccess returns true when this delete firehose autoscale policy no content response has a 2xx status code
+func (o *DeleteFirehoseAutoscalePolicyNoContent) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this delete firehose autoscale policy no content response has a 3xx status code
+func (o *DeleteFirehoseAutoscalePolicyNoContent) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete firehose autoscale policy no content response has a 4xx status code
+func (o *DeleteFirehoseAutoscalePolicyNoContent) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this delete firehose autoscale policy no content response has a 5xx status code
+func (o *DeleteFirehoseAutoscalePolicyNoContent) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete firehose autoscale policy no content response a status code equal to that given
+func (o *DeleteFirehoseAutoscalePolicyNoContent) IsCode(code int) bool {
+	return code == 204
+}
+
+func (o *DeleteFirehoseAutoscalePolicyNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /projects/{projectSlug}/firehoses/{firehoseUrn}/autoscalePolicy][%d] deleteFirehoseAutoscalePolicyNoContent ", 204)
+}
+
+func (o *DeleteFirehoseAutoscalePolicyNoContent) String() string {
+	return fmt.Sprintf("[DELETE /projects/{projectSlug}/firehoses/{firehoseUrn}/autoscalePolicy][%d] deleteFirehoseAutoscalePolicyNoContent ", 204)
+}
+
+func (o *DeleteFirehoseAutoscalePolicyNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewDeleteFirehoseAutoscalePolicyNotFound creates a DeleteFirehoseAutoscalePolicyNotFound with default headers values
+func NewDeleteFirehoseAutoscalePolicyNotFound() *DeleteFirehoseAutoscalePolicyNotFound {
+	return &DeleteFirehoseAutoscalePolicyNotFound{}
+}
+
+/*
+DeleteFirehoseAutoscalePolicyNotFound describes a response with status code 404, with default header values.
+
+Firehose has no autoscale policy
+*/
+type DeleteFirehoseAutoscalePolicyNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this delete firehose autoscale policy not found response has a 2xx status code
+func (o *DeleteFirehoseAutoscalePolicyNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this delete firehose autoscale policy not found response has a 3xx status code
+func (o *DeleteFirehoseAutoscalePolicyNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete firehose autoscale policy not found response has a 4xx status code
+func (o *DeleteFirehoseAutoscalePolicyNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this delete firehose autoscale policy not found response has a 5xx status code
+func (o *DeleteFirehoseAutoscalePolicyNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete firehose autoscale policy not found response a status code equal to that given
+func (o *DeleteFirehoseAutoscalePolicyNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *DeleteFirehoseAutoscalePolicyNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /projects/{projectSlug}/firehoses/{firehoseUrn}/autoscalePolicy][%d] deleteFirehoseAutoscalePolicyNotFound  %+v", 404, o.Payload)
+}
+
+func (o *DeleteFirehoseAutoscalePolicyNotFound) String() string {
+	return fmt.Sprintf("[DELETE /projects/{projectSlug}/firehoses/{firehoseUrn}/autoscalePolicy][%d] deleteFirehoseAutoscalePolicyNotFound  %+v", 404, o.Payload)
+}
+
+func (o *DeleteFirehoseAutoscalePolicyNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *DeleteFirehoseAutoscalePolicyNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteFirehoseAutoscalePolicyInternalServerError creates a DeleteFirehoseAutoscalePolicyInternalServerError with default headers values
+func NewDeleteFirehoseAutoscalePolicyInternalServerError() *DeleteFirehoseAutoscalePolicyInternalServerError {
+	return &DeleteFirehoseAutoscalePolicyInternalServerError{}
+}
+
+/*
+DeleteFirehoseAutoscalePolicyInternalServerError describes a response with status code 500, with default header values.
+
+internal error
+*/
+type DeleteFirehoseAutoscalePolicyInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this delete firehose autoscale policy internal server error response has a 2xx status code
+func (o *DeleteFirehoseAutoscalePolicyInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this delete firehose autoscale policy internal server error response has a 3xx status code
+func (o *DeleteFirehoseAutoscalePolicyInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete firehose autoscale policy internal server error response has a 4xx status code
+func (o *DeleteFirehoseAutoscalePolicyInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this delete firehose autoscale policy internal server error response has a 5xx status code
+func (o *DeleteFirehoseAutoscalePolicyInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this delete firehose autoscale policy internal server error response a status code equal to that given
+func (o *DeleteFirehoseAutoscalePolicyInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *DeleteFirehoseAutoscalePolicyInternalServerError) Error() string {
+	return fmt.Sprintf("[DELETE /projects/{projectSlug}/firehoses/{firehoseUrn}/autoscalePolicy][%d] deleteFirehoseAutoscalePolicyInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *DeleteFirehoseAutoscalePolicyInternalServerError) String() string {
+	return fmt.Sprintf("[DELETE /projects/{projectSlug}/firehoses/{firehoseUrn}/autoscalePolicy][%d] deleteFirehoseAutoscalePolicyInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *DeleteFirehoseAutoscalePolicyInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *DeleteFirehoseAutoscalePolicyInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,173 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewDeleteFirehoseParams creates a new DeleteFirehoseParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewDeleteFirehoseParams() *DeleteFirehoseParams {
+	return &DeleteFirehoseParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewDeleteFirehoseParamsWithTimeout creates a new DeleteFirehoseParams object
+// with the ability to set a timeout on a request.
+func NewDeleteFirehoseParamsWithTimeout(timeout time.Duration) *DeleteFirehoseParams {
+	return &DeleteFirehoseParams{
+		timeout: timeout,
+	}
+}
+
+// NewDeleteFirehoseParamsWithContext creates a new DeleteFirehoseParams object
+// with the ability to set a context for a request.
+func NewDeleteFirehoseParamsWithContext(ctx context.Context) *DeleteFirehoseParams {
+	return &DeleteFirehoseParams{
+		Context: ctx,
+	}
+}
+
+// NewDeleteFirehoseParamsWithHTTPClient creates a new DeleteFirehoseParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewDeleteFirehoseParamsWithHTTPClient(client *http.Client) *DeleteFirehoseParams {
+	return &DeleteFirehoseParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+DeleteFirehoseParams contains all the parameters to send to the API endpoint
+
+	for the delete firehose operation.
+
+	Typically these are written to a http.Request.
+*/
+type DeleteFirehoseParams struct {
+
+	/* FirehoseUrn.
+
+	   URN of the firehose.
+	*/
+	FirehoseUrn string
+
+	/* ProjectSlug.
+
+	   Unique identifier of the project.
+	*/
+	ProjectSlug string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the delete firehose params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DeleteFirehoseParams) WithDefaults() *DeleteFirehoseParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the delete firehose params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DeleteFirehoseParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the delete firehose params
+func (o *DeleteFirehoseParams) WithTimeout(timeout time.Duration) *DeleteFirehoseParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the delete firehose params
+func (o *DeleteFirehoseParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the delete firehose params
+func (o *DeleteFirehoseParams) WithContext(ctx context.Context) *DeleteFirehoseParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the delete firehose params
+func (o *DeleteFirehoseParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the delete firehose params
+func (o *DeleteFirehoseParams) WithHTTPClient(client *http.Client) *DeleteFirehoseParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the delete firehose params
+func (o *DeleteFirehoseParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithFirehoseUrn adds the firehoseUrn to the delete firehose params
+func (o *DeleteFirehoseParams) WithFirehoseUrn(firehoseUrn string) *DeleteFirehoseParams {
+	o.SetFirehoseUrn(firehoseUrn)
+	return o
+}
+
+// SetFirehoseUrn adds the firehoseUrn to the delete firehose params
+func (o *DeleteFirehoseParams) SetFirehoseUrn(firehoseUrn string) {
+	o.FirehoseUrn = firehoseUrn
+}
+
+// WithProjectSlug adds the projectSlug to the delete firehose params
+func (o *DeleteFirehoseParams) WithProjectSlug(projectSlug string) *DeleteFirehoseParams {
+	o.SetProjectSlug(projectSlug)
+	return o
+}
+
+// SetProjectSlug adds the projectSlug to the delete firehose params
+func (o *DeleteFirehoseParams) SetProjectSlug(projectSlug string) {
+	o.ProjectSlug = projectSlug
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *DeleteFirehoseParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param firehoseUrn
+	if err := r.SetPathParam("firehoseUrn", o.FirehoseUrn); err != nil {
+		return err
+	}
+
+	// path param projectSlug
+	if err := r.SetPathParam("projectSlug", o.ProjectSlug); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
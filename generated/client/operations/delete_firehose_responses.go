@@ -0,0 +1,235 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/odpf/dex/generated/models"
+)
+
+// DeleteFirehoseReader is a Reader for the DeleteFirehose structure.
+type DeleteFirehoseReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DeleteFirehoseReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewDeleteFirehoseNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewDeleteFirehoseNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewDeleteFirehoseInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewDeleteFirehoseNoContent creates a DeleteFirehoseNoContent with default headers values
+func NewDeleteFirehoseNoContent() *DeleteFirehoseNoContent {
+	return &DeleteFirehoseNoContent{}
+}
+
+/*
+DeleteFirehoseNoContent describes a response with status code 204, with default header values.
+
+Successfully deleted.
+*/
+type DeleteFirehoseNoContent struct {
+
+	/* Id of the operation tracking the changes. Not set for dry-run requests.
+	 */
+	XOperationID string
+}
+
+// IsSuccess returns true when this delete firehose no content response has a 2xx status code
+func (o *DeleteFirehoseNoContent) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this delete firehose no content response has a 3xx status code
+func (o *DeleteFirehoseNoContent) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete firehose no content response has a 4xx status code
+func (o *DeleteFirehoseNoContent) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this delete firehose no content response has a 5xx status code
+func (o *DeleteFirehoseNoContent) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete firehose no content response a status code equal to that given
+func (o *DeleteFirehoseNoContent) IsCode(code int) bool {
+	return code == 204
+}
+
+func (o *DeleteFirehoseNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /projects/{projectSlug}/firehoses/{firehoseUrn}][%d] deleteFirehoseNoContent ", 204)
+}
+
+func (o *DeleteFirehoseNoContent) String() string {
+	return fmt.Sprintf("[DELETE /projects/{projectSlug}/firehoses/{firehoseUrn}][%d] deleteFirehoseNoContent ", 204)
+}
+
+func (o *DeleteFirehoseNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header X-Operation-Id
+	hdrXOperationID := response.GetHeader("X-Operation-Id")
+
+	if hdrXOperationID != "" {
+		o.XOperationID = hdrXOperationID
+	}
+
+	return nil
+}
+
+// NewDeleteFirehoseNotFound creates a DeleteFirehoseNotFound with default headers values
+func NewDeleteFirehoseNotFound() *DeleteFirehoseNotFound {
+	return &DeleteFirehoseNotFound{}
+}
+
+/*
+DeleteFirehoseNotFound describes a response with status code 404, with default header values.
+
+Firehose with given URN was not found
+*/
+type DeleteFirehoseNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this delete firehose not found response has a 2xx status code
+func (o *DeleteFirehoseNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this delete firehose not found response has a 3xx status code
+func (o *DeleteFirehoseNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete firehose not found response has a 4xx status code
+func (o *DeleteFirehoseNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this delete firehose not found response has a 5xx status code
+func (o *DeleteFirehoseNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete firehose not found response a status code equal to that given
+func (o *DeleteFirehoseNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *DeleteFirehoseNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /projects/{projectSlug}/firehoses/{firehoseUrn}][%d] deleteFirehoseNotFound  %+v", 404, o.Payload)
+}
+
+func (o *DeleteFirehoseNotFound) String() string {
+	return fmt.Sprintf("[DELETE /projects/{projectSlug}/firehoses/{firehoseUrn}][%d] deleteFirehoseNotFound  %+v", 404, o.Payload)
+}
+
+func (o *DeleteFirehoseNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *DeleteFirehoseNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteFirehoseInternalServerError creates a DeleteFirehoseInternalServerError with default headers values
+func NewDeleteFirehoseInternalServerError() *DeleteFirehoseInternalServerError {
+	return &DeleteFirehoseInternalServerError{}
+}
+
+/*
+DeleteFirehoseInternalServerError describes a response with status code 500, with default header values.
+
+internal error
+*/
+type DeleteFirehoseInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this delete firehose internal server error response has a 2xx status code
+func (o *DeleteFirehoseInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this delete firehose internal server error response has a 3xx status code
+func (o *DeleteFirehoseInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete firehose internal server error response has a 4xx status code
+func (o *DeleteFirehoseInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this delete firehose internal server error response has a 5xx status code
+func (o *DeleteFirehoseInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this delete firehose internal server error response a status code equal to that given
+func (o *DeleteFirehoseInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *DeleteFirehoseInternalServerError) Error() string {
+	return fmt.Sprintf("[DELETE /projects/{projectSlug}/firehoses/{firehoseUrn}][%d] deleteFirehoseInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *DeleteFirehoseInternalServerError) String() string {
+	return fmt.Sprintf("[DELETE /projects/{projectSlug}/firehoses/{firehoseUrn}][%d] deleteFirehoseInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *DeleteFirehoseInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *DeleteFirehoseInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,195 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewDeleteFirehoseScheduleParams creates a new DeleteFirehoseScheduleParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewDeleteFirehoseScheduleParams() *DeleteFirehoseScheduleParams {
+	return &DeleteFirehoseScheduleParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewDeleteFirehoseScheduleParamsWithTimeout creates a new DeleteFirehoseScheduleParams object
+// with the ability to set a timeout on a request.
+func NewDeleteFirehoseScheduleParamsWithTimeout(timeout time.Duration) *DeleteFirehoseScheduleParams {
+	return &DeleteFirehoseScheduleParams{
+		timeout: timeout,
+	}
+}
+
+// NewDeleteFirehoseScheduleParamsWithContext creates a new DeleteFirehoseScheduleParams object
+// with the ability to set a context for a request.
+func NewDeleteFirehoseScheduleParamsWithContext(ctx context.Context) *DeleteFirehoseScheduleParams {
+	return &DeleteFirehoseScheduleParams{
+		Context: ctx,
+	}
+}
+
+// NewDeleteFirehoseScheduleParamsWithHTTPClient creates a new DeleteFirehoseScheduleParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewDeleteFirehoseScheduleParamsWithHTTPClient(client *http.Client) *DeleteFirehoseScheduleParams {
+	return &DeleteFirehoseScheduleParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+DeleteFirehoseScheduleParams contains all the parameters to send to the API endpoint
+
+	for the delete firehose schedule operation.
+
+	Typically these are written to a http.Request.
+*/
+type DeleteFirehoseScheduleParams struct {
+
+	/* FirehoseUrn.
+
+	   URN of the firehose.
+	*/
+	FirehoseUrn string
+
+	/* ProjectSlug.
+
+	   Identifier for the project.
+	*/
+	ProjectSlug string
+
+	/* ScheduleID.
+
+	   Identifier of the schedule.
+	*/
+	ScheduleID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the delete firehose schedule params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DeleteFirehoseScheduleParams) WithDefaults() *DeleteFirehoseScheduleParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the delete firehose schedule params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DeleteFirehoseScheduleParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the delete firehose schedule params
+func (o *DeleteFirehoseScheduleParams) WithTimeout(timeout time.Duration) *DeleteFirehoseScheduleParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the delete firehose schedule params
+func (o *DeleteFirehoseScheduleParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the delete firehose schedule params
+func (o *DeleteFirehoseScheduleParams) WithContext(ctx context.Context) *DeleteFirehoseScheduleParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the delete firehose schedule params
+func (o *DeleteFirehoseScheduleParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the delete firehose schedule params
+func (o *DeleteFirehoseScheduleParams) WithHTTPClient(client *http.Client) *DeleteFirehoseScheduleParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the delete firehose schedule params
+func (o *DeleteFirehoseScheduleParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithFirehoseUrn adds the firehoseUrn to the delete firehose schedule params
+func (o *DeleteFirehoseScheduleParams) WithFirehoseUrn(firehoseUrn string) *DeleteFirehoseScheduleParams {
+	o.SetFirehoseUrn(firehoseUrn)
+	return o
+}
+
+// SetFirehoseUrn adds the firehoseUrn to the delete firehose schedule params
+func (o *DeleteFirehoseScheduleParams) SetFirehoseUrn(firehoseUrn string) {
+	o.FirehoseUrn = firehoseUrn
+}
+
+// WithProjectSlug adds the projectSlug to the delete firehose schedule params
+func (o *DeleteFirehoseScheduleParams) WithProjectSlug(projectSlug string) *DeleteFirehoseScheduleParams {
+	o.SetProjectSlug(projectSlug)
+	return o
+}
+
+// SetProjectSlug adds the projectSlug to the delete firehose schedule params
+func (o *DeleteFirehoseScheduleParams) SetProjectSlug(projectSlug string) {
+	o.ProjectSlug = projectSlug
+}
+
+// WithScheduleID adds the scheduleID to the delete firehose schedule params
+func (o *DeleteFirehoseScheduleParams) WithScheduleID(scheduleID string) *DeleteFirehoseScheduleParams {
+	o.SetScheduleID(scheduleID)
+	return o
+}
+
+// SetScheduleID adds the scheduleId to the delete firehose schedule params
+func (o *DeleteFirehoseScheduleParams) SetScheduleID(scheduleID string) {
+	o.ScheduleID = scheduleID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *DeleteFirehoseScheduleParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param firehoseUrn
+	if err := r.SetPathParam("firehoseUrn", o.FirehoseUrn); err != nil {
+		return err
+	}
+
+	// path param projectSlug
+	if err := r.SetPathParam("projectSlug", o.ProjectSlug); err != nil {
+		return err
+	}
+
+	// path param scheduleId
+	if err := r.SetPathParam("scheduleId", o.ScheduleID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,224 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/odpf/dex/generated/models"
+)
+
+// DeleteFirehoseScheduleReader is a Reader for the DeleteFirehoseSchedule structure.
+type DeleteFirehoseScheduleReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DeleteFirehoseScheduleReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewDeleteFirehoseScheduleNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewDeleteFirehoseScheduleNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewDeleteFirehoseScheduleInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewDeleteFirehoseScheduleNoContent creates a DeleteFirehoseScheduleNoContent with default headers values
+func NewDeleteFirehoseScheduleNoContent() *DeleteFirehoseScheduleNoContent {
+	return &DeleteFirehoseScheduleNoContent{}
+}
+
+/*
+DeleteFirehoseScheduleNoContent describes a response with status code 204, with default header values.
+
+Successfully deleted the schedule.
+*/
+type DeleteFirehoseScheduleNoContent struct {
+}
+
+// IsSuccess returns true when this delete firehose schedule no content response has a 2xx status code
+func (o *DeleteFirehoseScheduleNoContent) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this delete firehose schedule no content response has a 3xx status code
+func (o *DeleteFirehoseScheduleNoContent) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete firehose schedule no content response has a 4xx status code
+func (o *DeleteFirehoseScheduleNoContent) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this delete firehose schedule no content response has a 5xx status code
+func (o *DeleteFirehoseScheduleNoContent) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete firehose schedule no content response a status code equal to that given
+func (o *DeleteFirehoseScheduleNoContent) IsCode(code int) bool {
+	return code == 204
+}
+
+func (o *DeleteFirehoseScheduleNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /projects/{projectSlug}/firehoses/{firehoseUrn}/schedules/{scheduleId}][%d] deleteFirehoseScheduleNoContent ", 204)
+}
+
+func (o *DeleteFirehoseScheduleNoContent) String() string {
+	return fmt.Sprintf("[DELETE /projects/{projectSlug}/firehoses/{firehoseUrn}/schedules/{scheduleId}][%d] deleteFirehoseScheduleNoContent ", 204)
+}
+
+func (o *DeleteFirehoseScheduleNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewDeleteFirehoseScheduleNotFound creates a DeleteFirehoseScheduleNotFound with default headers values
+func NewDeleteFirehoseScheduleNotFound() *DeleteFirehoseScheduleNotFound {
+	return &DeleteFirehoseScheduleNotFound{}
+}
+
+/*
+DeleteFirehoseScheduleNotFound describes a response with status code 404, with default header values.
+
+Schedule was not found
+*/
+type DeleteFirehoseScheduleNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this delete firehose schedule not found response has a 2xx status code
+func (o *DeleteFirehoseScheduleNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this delete firehose schedule not found response has a 3xx status code
+func (o *DeleteFirehoseScheduleNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete firehose schedule not found response has a 4xx status code
+func (o *DeleteFirehoseScheduleNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this delete firehose schedule not found response has a 5xx status code
+func (o *DeleteFirehoseScheduleNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete firehose schedule not found response a status code equal to that given
+func (o *DeleteFirehoseScheduleNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *DeleteFirehoseScheduleNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /projects/{projectSlug}/firehoses/{firehoseUrn}/schedules/{scheduleId}][%d] deleteFirehoseScheduleNotFound  %+v", 404, o.Payload)
+}
+
+func (o *DeleteFirehoseScheduleNotFound) String() string {
+	return fmt.Sprintf("[DELETE /projects/{projectSlug}/firehoses/{firehoseUrn}/schedules/{scheduleId}][%d] deleteFirehoseScheduleNotFound  %+v", 404, o.Payload)
+}
+
+func (o *DeleteFirehoseScheduleNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *DeleteFirehoseScheduleNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteFirehoseScheduleInternalServerError creates a DeleteFirehoseScheduleInternalServerError with default headers values
+func NewDeleteFirehoseScheduleInternalServerError() *DeleteFirehoseScheduleInternalServerError {
+	return &DeleteFirehoseScheduleInternalServerError{}
+}
+
+/*
+DeleteFirehoseScheduleInternalServerError describes a response with status code 500, with default header values.
+
+internal error
+*/
+type DeleteFirehoseScheduleInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this delete firehose schedule internal server error response has a 2xx status code
+func (o *DeleteFirehoseScheduleInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this delete firehose schedule internal server error response has a 3xx status code
+func (o *DeleteFirehoseScheduleInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete firehose schedule internal server error response has a 4xx status code
+func (o *DeleteFirehoseScheduleInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this delete firehose schedule internal server error response has a 5xx status code
+func (o *DeleteFirehoseScheduleInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this delete firehose schedule internal server error response a status code equal to that given
+func (o *DeleteFirehoseScheduleInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *DeleteFirehoseScheduleInternalServerError) Error() string {
+	return fmt.Sprintf("[DELETE /projects/{projectSlug}/firehoses/{firehoseUrn}/schedules/{scheduleId}][%d] deleteFirehoseScheduleInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *DeleteFirehoseScheduleInternalServerError) String() string {
+	return fmt.Sprintf("[DELETE /projects/{projectSlug}/firehoses/{firehoseUrn}/schedules/{scheduleId}][%d] deleteFirehoseScheduleInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *DeleteFirehoseScheduleInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *DeleteFirehoseScheduleInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,173 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewDeleteFirehoseTemplateParams creates a new DeleteFirehoseTemplateParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewDeleteFirehoseTemplateParams() *DeleteFirehoseTemplateParams {
+	return &DeleteFirehoseTemplateParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewDeleteFirehoseTemplateParamsWithTimeout creates a new DeleteFirehoseTemplateParams object
+// with the ability to set a timeout on a request.
+func NewDeleteFirehoseTemplateParamsWithTimeout(timeout time.Duration) *DeleteFirehoseTemplateParams {
+	return &DeleteFirehoseTemplateParams{
+		timeout: timeout,
+	}
+}
+
+// NewDeleteFirehoseTemplateParamsWithContext creates a new DeleteFirehoseTemplateParams object
+// with the ability to set a context for a request.
+func NewDeleteFirehoseTemplateParamsWithContext(ctx context.Context) *DeleteFirehoseTemplateParams {
+	return &DeleteFirehoseTemplateParams{
+		Context: ctx,
+	}
+}
+
+// NewDeleteFirehoseTemplateParamsWithHTTPClient creates a new DeleteFirehoseTemplateParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewDeleteFirehoseTemplateParamsWithHTTPClient(client *http.Client) *DeleteFirehoseTemplateParams {
+	return &DeleteFirehoseTemplateParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+DeleteFirehoseTemplateParams contains all the parameters to send to the API endpoint
+
+	for the delete firehose template operation.
+
+	Typically these are written to a http.Request.
+*/
+type DeleteFirehoseTemplateParams struct {
+
+	/* ProjectSlug.
+
+	   Unique slug name of the project.
+	*/
+	ProjectSlug string
+
+	/* TemplateName.
+
+	   Name of the template.
+	*/
+	TemplateName string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the delete firehose template params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DeleteFirehoseTemplateParams) WithDefaults() *DeleteFirehoseTemplateParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the delete firehose template params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DeleteFirehoseTemplateParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the delete firehose template params
+func (o *DeleteFirehoseTemplateParams) WithTimeout(timeout time.Duration) *DeleteFirehoseTemplateParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the delete firehose template params
+func (o *DeleteFirehoseTemplateParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the delete firehose template params
+func (o *DeleteFirehoseTemplateParams) WithContext(ctx context.Context) *DeleteFirehoseTemplateParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the delete firehose template params
+func (o *DeleteFirehoseTemplateParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the delete firehose template params
+func (o *DeleteFirehoseTemplateParams) WithHTTPClient(client *http.Client) *DeleteFirehoseTemplateParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the delete firehose template params
+func (o *DeleteFirehoseTemplateParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithProjectSlug adds the projectSlug to the delete firehose template params
+func (o *DeleteFirehoseTemplateParams) WithProjectSlug(projectSlug string) *DeleteFirehoseTemplateParams {
+	o.SetProjectSlug(projectSlug)
+	return o
+}
+
+// SetProjectSlug adds the projectSlug to the delete firehose template params
+func (o *DeleteFirehoseTemplateParams) SetProjectSlug(projectSlug string) {
+	o.ProjectSlug = projectSlug
+}
+
+// WithTemplateName adds the templateName to the delete firehose template params
+func (o *DeleteFirehoseTemplateParams) WithTemplateName(templateName string) *DeleteFirehoseTemplateParams {
+	o.SetTemplateName(templateName)
+	return o
+}
+
+// SetTemplateName adds the templateName to the delete firehose template params
+func (o *DeleteFirehoseTemplateParams) SetTemplateName(templateName string) {
+	o.TemplateName = templateName
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *DeleteFirehoseTemplateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param projectSlug
+	if err := r.SetPathParam("projectSlug", o.ProjectSlug); err != nil {
+		return err
+	}
+
+	// path param templateName
+	if err := r.SetPathParam("templateName", o.TemplateName); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,224 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/odpf/dex/generated/models"
+)
+
+// DeleteFirehoseTemplateReader is a Reader for the DeleteFirehoseTemplate structure.
+type DeleteFirehoseTemplateReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DeleteFirehoseTemplateReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewDeleteFirehoseTemplateNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewDeleteFirehoseTemplateNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewDeleteFirehoseTemplateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewDeleteFirehoseTemplateNoContent creates a DeleteFirehoseTemplateNoContent with default headers values
+func NewDeleteFirehoseTemplateNoContent() *DeleteFirehoseTemplateNoContent {
+	return &DeleteFirehoseTemplateNoContent{}
+}
+
+/*
+DeleteFirehoseTemplateNoContent describes a response with status code 204, with default header values.
+
+Successfully deleted.
+*/
+type DeleteFirehoseTemplateNoContent struct {
+}
+
+// IsSuccess returns true when this delete firehose template no content response has a 2xx status code
+func (o *DeleteFirehoseTemplateNoContent) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this delete firehose template no content response has a 3xx status code
+func (o *DeleteFirehoseTemplateNoContent) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete firehose template no content response has a 4xx status code
+func (o *DeleteFirehoseTemplateNoContent) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this delete firehose template no content response has a 5xx status code
+func (o *DeleteFirehoseTemplateNoContent) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete firehose template no content response a status code equal to that given
+func (o *DeleteFirehoseTemplateNoContent) IsCode(code int) bool {
+	return code == 204
+}
+
+func (o *DeleteFirehoseTemplateNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /projects/{projectSlug}/firehoseTemplates/{templateName}][%d] deleteFirehoseTemplateNoContent ", 204)
+}
+
+func (o *DeleteFirehoseTemplateNoContent) String() string {
+	return fmt.Sprintf("[DELETE /projects/{projectSlug}/firehoseTemplates/{templateName}][%d] deleteFirehoseTemplateNoContent ", 204)
+}
+
+func (o *DeleteFirehoseTemplateNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewDeleteFirehoseTemplateNotFound creates a DeleteFirehoseTemplateNotFound with default headers values
+func NewDeleteFirehoseTemplateNotFound() *DeleteFirehoseTemplateNotFound {
+	return &DeleteFirehoseTemplateNotFound{}
+}
+
+/*
+DeleteFirehoseTemplateNotFound describes a response with status code 404, with default header values.
+
+Template with given name was not found.
+*/
+type DeleteFirehoseTemplateNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this delete firehose template not found response has a 2xx status code
+func (o *DeleteFirehoseTemplateNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this delete firehose template not found response has a 3xx status code
+func (o *DeleteFirehoseTemplateNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete firehose template not found response has a 4xx status code
+func (o *DeleteFirehoseTemplateNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this delete firehose template not found response has a 5xx status code
+func (o *DeleteFirehoseTemplateNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete firehose template not found response a status code equal to that given
+func (o *DeleteFirehoseTemplateNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *DeleteFirehoseTemplateNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /projects/{projectSlug}/firehoseTemplates/{templateName}][%d] deleteFirehoseTemplateNotFound  %+v", 404, o.Payload)
+}
+
+func (o *DeleteFirehoseTemplateNotFound) String() string {
+	return fmt.Sprintf("[DELETE /projects/{projectSlug}/firehoseTemplates/{templateName}][%d] deleteFirehoseTemplateNotFound  %+v", 404, o.Payload)
+}
+
+func (o *DeleteFirehoseTemplateNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *DeleteFirehoseTemplateNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteFirehoseTemplateInternalServerError creates a DeleteFirehoseTemplateInternalServerError with default headers values
+func NewDeleteFirehoseTemplateInternalServerError() *DeleteFirehoseTemplateInternalServerError {
+	return &DeleteFirehoseTemplateInternalServerError{}
+}
+
+/*
+DeleteFirehoseTemplateInternalServerError describes a response with status code 500, with default header values.
+
+internal error
+*/
+type DeleteFirehoseTemplateInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this delete firehose template internal server error response has a 2xx status code
+func (o *DeleteFirehoseTemplateInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this delete firehose template internal server error response has a 3xx status code
+func (o *DeleteFirehoseTemplateInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete firehose template internal server error response has a 4xx status code
+func (o *DeleteFirehoseTemplateInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this delete firehose template internal server error response has a 5xx status code
+func (o *DeleteFirehoseTemplateInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this delete firehose template internal server error response a status code equal to that given
+func (o *DeleteFirehoseTemplateInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *DeleteFirehoseTemplateInternalServerError) Error() string {
+	return fmt.Sprintf("[DELETE /projects/{projectSlug}/firehoseTemplates/{templateName}][%d] deleteFirehoseTemplateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *DeleteFirehoseTemplateInternalServerError) String() string {
+	return fmt.Sprintf("[DELETE /projects/{projectSlug}/firehoseTemplates/{templateName}][%d] deleteFirehoseTemplateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *DeleteFirehoseTemplateInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *DeleteFirehoseTemplateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
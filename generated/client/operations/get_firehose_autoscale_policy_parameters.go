@@ -0,0 +1,173 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetFirehoseAutoscalePolicyParams creates a new GetFirehoseAutoscalePolicyParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetFirehoseAutoscalePolicyParams() *GetFirehoseAutoscalePolicyParams {
+	return &GetFirehoseAutoscalePolicyParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetFirehoseAutoscalePolicyParamsWithTimeout creates a new GetFirehoseAutoscalePolicyParams object
+// with the ability to set a timeout on a request.
+func NewGetFirehoseAutoscalePolicyParamsWithTimeout(timeout time.Duration) *GetFirehoseAutoscalePolicyParams {
+	return &GetFirehoseAutoscalePolicyParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetFirehoseAutoscalePolicyParamsWithContext creates a new GetFirehoseAutoscalePolicyParams object
+// with the ability to set a context for a request.
+func NewGetFirehoseAutoscalePolicyParamsWithContext(ctx context.Context) *GetFirehoseAutoscalePolicyParams {
+	return &GetFirehoseAutoscalePolicyParams{
+		Context: ctx,
+	}
+}
+
+// NewGetFirehoseAutoscalePolicyParamsWithHTTPClient creates a new GetFirehoseAutoscalePolicyParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetFirehoseAutoscalePolicyParamsWithHTTPClient(client *http.Client) *GetFirehoseAutoscalePolicyParams {
+	return &GetFirehoseAutoscalePolicyParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetFirehoseAutoscalePolicyParams contains all the parameters to send to the API endpoint
+
+	for the get firehose autoscale policy operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetFirehoseAutoscalePolicyParams struct {
+
+	/* FirehoseUrn.
+
+	   URN of the firehose.
+	*/
+	FirehoseUrn string
+
+	/* ProjectSlug.
+
+	   Identifier for the project.
+	*/
+	ProjectSlug string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get firehose autoscale policy params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetFirehoseAutoscalePolicyParams) WithDefaults() *GetFirehoseAutoscalePolicyParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get firehose autoscale policy params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetFirehoseAutoscalePolicyParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get firehose autoscale policy params
+func (o *GetFirehoseAutoscalePolicyParams) WithTimeout(timeout time.Duration) *GetFirehoseAutoscalePolicyParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get firehose autoscale policy params
+func (o *GetFirehoseAutoscalePolicyParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get firehose autoscale policy params
+func (o *GetFirehoseAutoscalePolicyParams) WithContext(ctx context.Context) *GetFirehoseAutoscalePolicyParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get firehose autoscale policy params
+func (o *GetFirehoseAutoscalePolicyParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get firehose autoscale policy params
+func (o *GetFirehoseAutoscalePolicyParams) WithHTTPClient(client *http.Client) *GetFirehoseAutoscalePolicyParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get firehose autoscale policy params
+func (o *GetFirehoseAutoscalePolicyParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithFirehoseUrn adds the firehoseUrn to the get firehose autoscale policy params
+func (o *GetFirehoseAutoscalePolicyParams) WithFirehoseUrn(firehoseUrn string) *GetFirehoseAutoscalePolicyParams {
+	o.SetFirehoseUrn(firehoseUrn)
+	return o
+}
+
+// SetFirehoseUrn adds the firehoseUrn to the get firehose autoscale policy params
+func (o *GetFirehoseAutoscalePolicyParams) SetFirehoseUrn(firehoseUrn string) {
+	o.FirehoseUrn = firehoseUrn
+}
+
+// WithProjectSlug adds the projectSlug to the get firehose autoscale policy params
+func (o *GetFirehoseAutoscalePolicyParams) WithProjectSlug(projectSlug string) *GetFirehoseAutoscalePolicyParams {
+	o.SetProjectSlug(projectSlug)
+	return o
+}
+
+// SetProjectSlug adds the projectSlug to the get firehose autoscale policy params
+func (o *GetFirehoseAutoscalePolicyParams) SetProjectSlug(projectSlug string) {
+	o.ProjectSlug = projectSlug
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetFirehoseAutoscalePolicyParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param firehoseUrn
+	if err := r.SetPathParam("firehoseUrn", o.FirehoseUrn); err != nil {
+		return err
+	}
+
+	// path param projectSlug
+	if err := r.SetPathParam("projectSlug", o.ProjectSlug); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
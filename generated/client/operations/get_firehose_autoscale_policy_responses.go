@@ -0,0 +1,236 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/odpf/dex/generated/models"
+)
+
+// GetFirehoseAutoscalePolicyReader is a Reader for the GetFirehoseAutoscalePolicy structure.
+type GetFirehoseAutoscalePolicyReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetFirehoseAutoscalePolicyReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetFirehoseAutoscalePolicyOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewGetFirehoseAutoscalePolicyNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewGetFirehoseAutoscalePolicyInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewGetFirehoseAutoscalePolicyOK creates a GetFirehoseAutoscalePolicyOK with default headers values
+func NewGetFirehoseAutoscalePolicyOK() *GetFirehoseAutoscalePolicyOK {
+	return &GetFirehoseAutoscalePolicyOK{}
+}
+
+/*
+GetFirehoseAutoscalePolicyOK describes a response with status code 200, with default header values.
+
+Found the autoscale policy.
+*/
+type GetFirehoseAutoscalePolicyOK struct {
+	Payload *models.AutoscalePolicy
+}
+
+// IsSuccess returns true when this get firehose autoscale policy o k response has a 2xx status code
+func (o *GetFirehoseAutoscalePolicyOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get firehose autoscale policy o k response has a 3xx status code
+func (o *GetFirehoseAutoscalePolicyOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get firehose autoscale policy o k response has a 4xx status code
+func (o *GetFirehoseAutoscalePolicyOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get firehose autoscale policy o k response has a 5xx status code
+func (o *GetFirehoseAutoscalePolicyOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get firehose autoscale policy o k response a status code equal to that given
+func (o *GetFirehoseAutoscalePolicyOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *GetFirehoseAutoscalePolicyOK) Error() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/autoscalePolicy][%d] getFirehoseAutoscalePolicyOK  %+v", 200, o.Payload)
+}
+
+func (o *GetFirehoseAutoscalePolicyOK) String() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/autoscalePolicy][%d] getFirehoseAutoscalePolicyOK  %+v", 200, o.Payload)
+}
+
+func (o *GetFirehoseAutoscalePolicyOK) GetPayload() *models.AutoscalePolicy {
+	return o.Payload
+}
+
+func (o *GetFirehoseAutoscalePolicyOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.AutoscalePolicy)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetFirehoseAutoscalePolicyNotFound creates a GetFirehoseAutoscalePolicyNotFound with default headers values
+func NewGetFirehoseAutoscalePolicyNotFound() *GetFirehoseAutoscalePolicyNotFound {
+	return &GetFirehoseAutoscalePolicyNotFound{}
+}
+
+/*
+GetFirehoseAutoscalePolicyNotFound describes a response with status code 404, with default header values.
+
+Firehose has no autoscale policy
+*/
+type GetFirehoseAutoscalePolicyNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this get firehose autoscale policy not found response has a 2xx status code
+func (o *GetFirehoseAutoscalePolicyNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get firehose autoscale policy not found response has a 3xx status code
+func (o *GetFirehoseAutoscalePolicyNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get firehose autoscale policy not found response has a 4xx status code
+func (o *GetFirehoseAutoscalePolicyNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get firehose autoscale policy not found response has a 5xx status code
+func (o *GetFirehoseAutoscalePolicyNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get firehose autoscale policy not found response a status code equal to that given
+func (o *GetFirehoseAutoscalePolicyNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *GetFirehoseAutoscalePolicyNotFound) Error() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/autoscalePolicy][%d] getFirehoseAutoscalePolicyNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetFirehoseAutoscalePolicyNotFound) String() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/autoscalePolicy][%d] getFirehoseAutoscalePolicyNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetFirehoseAutoscalePolicyNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetFirehoseAutoscalePolicyNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetFirehoseAutoscalePolicyInternalServerError creates a GetFirehoseAutoscalePolicyInternalServerError with default headers values
+func NewGetFirehoseAutoscalePolicyInternalServerError() *GetFirehoseAutoscalePolicyInternalServerError {
+	return &GetFirehoseAutoscalePolicyInternalServerError{}
+}
+
+/*
+GetFirehoseAutoscalePolicyInternalServerError describes a response with status code 500, with default header values.
+
+internal error
+*/
+type GetFirehoseAutoscalePolicyInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this get firehose autoscale policy internal server error response has a 2xx status code
+func (o *GetFirehoseAutoscalePolicyInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get firehose autoscale policy internal server error response has a 3xx status code
+func (o *GetFirehoseAutoscalePolicyInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get firehose autoscale policy internal server error response has a 4xx status code
+func (o *GetFirehoseAutoscalePolicyInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get firehose autoscale policy internal server error response has a 5xx status code
+func (o *GetFirehoseAutoscalePolicyInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this get firehose autoscale policy internal server error response a status code equal to that given
+func (o *GetFirehoseAutoscalePolicyInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *GetFirehoseAutoscalePolicyInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/autoscalePolicy][%d] getFirehoseAutoscalePolicyInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetFirehoseAutoscalePolicyInternalServerError) String() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/autoscalePolicy][%d] getFirehoseAutoscalePolicyInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetFirehoseAutoscalePolicyInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetFirehoseAutoscalePolicyInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
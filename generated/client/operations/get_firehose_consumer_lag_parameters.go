@@ -0,0 +1,173 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetFirehoseConsumerLagParams creates a new GetFirehoseConsumerLagParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetFirehoseConsumerLagParams() *GetFirehoseConsumerLagParams {
+	return &GetFirehoseConsumerLagParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetFirehoseConsumerLagParamsWithTimeout creates a new GetFirehoseConsumerLagParams object
+// with the ability to set a timeout on a request.
+func NewGetFirehoseConsumerLagParamsWithTimeout(timeout time.Duration) *GetFirehoseConsumerLagParams {
+	return &GetFirehoseConsumerLagParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetFirehoseConsumerLagParamsWithContext creates a new GetFirehoseConsumerLagParams object
+// with the ability to set a context for a request.
+func NewGetFirehoseConsumerLagParamsWithContext(ctx context.Context) *GetFirehoseConsumerLagParams {
+	return &GetFirehoseConsumerLagParams{
+		Context: ctx,
+	}
+}
+
+// NewGetFirehoseConsumerLagParamsWithHTTPClient creates a new GetFirehoseConsumerLagParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetFirehoseConsumerLagParamsWithHTTPClient(client *http.Client) *GetFirehoseConsumerLagParams {
+	return &GetFirehoseConsumerLagParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetFirehoseConsumerLagParams contains all the parameters to send to the API endpoint
+
+	for the get firehose consumer lag operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetFirehoseConsumerLagParams struct {
+
+	/* FirehoseUrn.
+
+	   URN of the firehose.
+	*/
+	FirehoseUrn string
+
+	/* ProjectSlug.
+
+	   Identifier for the project.
+	*/
+	ProjectSlug string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get firehose consumer lag params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetFirehoseConsumerLagParams) WithDefaults() *GetFirehoseConsumerLagParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get firehose consumer lag params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetFirehoseConsumerLagParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get firehose consumer lag params
+func (o *GetFirehoseConsumerLagParams) WithTimeout(timeout time.Duration) *GetFirehoseConsumerLagParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get firehose consumer lag params
+func (o *GetFirehoseConsumerLagParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get firehose consumer lag params
+func (o *GetFirehoseConsumerLagParams) WithContext(ctx context.Context) *GetFirehoseConsumerLagParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get firehose consumer lag params
+func (o *GetFirehoseConsumerLagParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get firehose consumer lag params
+func (o *GetFirehoseConsumerLagParams) WithHTTPClient(client *http.Client) *GetFirehoseConsumerLagParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get firehose consumer lag params
+func (o *GetFirehoseConsumerLagParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithFirehoseUrn adds the firehoseUrn to the get firehose consumer lag params
+func (o *GetFirehoseConsumerLagParams) WithFirehoseUrn(firehoseUrn string) *GetFirehoseConsumerLagParams {
+	o.SetFirehoseUrn(firehoseUrn)
+	return o
+}
+
+// SetFirehoseUrn adds the firehoseUrn to the get firehose consumer lag params
+func (o *GetFirehoseConsumerLagParams) SetFirehoseUrn(firehoseUrn string) {
+	o.FirehoseUrn = firehoseUrn
+}
+
+// WithProjectSlug adds the projectSlug to the get firehose consumer lag params
+func (o *GetFirehoseConsumerLagParams) WithProjectSlug(projectSlug string) *GetFirehoseConsumerLagParams {
+	o.SetProjectSlug(projectSlug)
+	return o
+}
+
+// SetProjectSlug adds the projectSlug to the get firehose consumer lag params
+func (o *GetFirehoseConsumerLagParams) SetProjectSlug(projectSlug string) {
+	o.ProjectSlug = projectSlug
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetFirehoseConsumerLagParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param firehoseUrn
+	if err := r.SetPathParam("firehoseUrn", o.FirehoseUrn); err != nil {
+		return err
+	}
+
+	// path param projectSlug
+	if err := r.SetPathParam("projectSlug", o.ProjectSlug); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,305 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/odpf/dex/generated/models"
+)
+
+// GetFirehoseConsumerLagReader is a Reader for the GetFirehoseConsumerLag structure.
+type GetFirehoseConsumerLagReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetFirehoseConsumerLagReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetFirehoseConsumerLagOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewGetFirehoseConsumerLagBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewGetFirehoseConsumerLagNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewGetFirehoseConsumerLagInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewGetFirehoseConsumerLagOK creates a GetFirehoseConsumerLagOK with default headers values
+func NewGetFirehoseConsumerLagOK() *GetFirehoseConsumerLagOK {
+	return &GetFirehoseConsumerLagOK{}
+}
+
+/*
+GetFirehoseConsumerLagOK describes a response with status code 200, with default header values.
+
+Found the consumer lag.
+*/
+type GetFirehoseConsumerLagOK struct {
+	Payload *models.ConsumerLag
+}
+
+// IsSuccess returns true when this get firehose consumer lag o k response has a 2xx status code
+func (o *GetFirehoseConsumerLagOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get firehose consumer lag o k response has a 3xx status code
+func (o *GetFirehoseConsumerLagOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get firehose consumer lag o k response has a 4xx status code
+func (o *GetFirehoseConsumerLagOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get firehose consumer lag o k response has a 5xx status code
+func (o *GetFirehoseConsumerLagOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get firehose consumer lag o k response a status code equal to that given
+func (o *GetFirehoseConsumerLagOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *GetFirehoseConsumerLagOK) Error() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/consumerLag][%d] getFirehoseConsumerLagOK  %+v", 200, o.Payload)
+}
+
+func (o *GetFirehoseConsumerLagOK) String() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/consumerLag][%d] getFirehoseConsumerLagOK  %+v", 200, o.Payload)
+}
+
+func (o *GetFirehoseConsumerLagOK) GetPayload() *models.ConsumerLag {
+	return o.Payload
+}
+
+func (o *GetFirehoseConsumerLagOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ConsumerLag)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetFirehoseConsumerLagBadRequest creates a GetFirehoseConsumerLagBadRequest with default headers values
+func NewGetFirehoseConsumerLagBadRequest() *GetFirehoseConsumerLagBadRequest {
+	return &GetFirehoseConsumerLagBadRequest{}
+}
+
+/*
+GetFirehoseConsumerLagBadRequest describes a response with status code 400, with default header values.
+
+Firehose has no consumer group or topic, or its brokers are not of a configured cluster.
+*/
+type GetFirehoseConsumerLagBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this get firehose consumer lag bad request response has a 2xx status code
+func (o *GetFirehoseConsumerLagBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get firehose consumer lag bad request response has a 3xx status code
+func (o *GetFirehoseConsumerLagBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get firehose consumer lag bad request response has a 4xx status code
+func (o *GetFirehoseConsumerLagBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get firehose consumer lag bad request response has a 5xx status code
+func (o *GetFirehoseConsumerLagBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get firehose consumer lag bad request response a status code equal to that given
+func (o *GetFirehoseConsumerLagBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *GetFirehoseConsumerLagBadRequest) Error() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/consumerLag][%d] getFirehoseConsumerLagBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetFirehoseConsumerLagBadRequest) String() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/consumerLag][%d] getFirehoseConsumerLagBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetFirehoseConsumerLagBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetFirehoseConsumerLagBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetFirehoseConsumerLagNotFound creates a GetFirehoseConsumerLagNotFound with default headers values
+func NewGetFirehoseConsumerLagNotFound() *GetFirehoseConsumerLagNotFound {
+	return &GetFirehoseConsumerLagNotFound{}
+}
+
+/*
+GetFirehoseConsumerLagNotFound describes a response with status code 404, with default header values.
+
+Firehose with given URN or its topic was not found
+*/
+type GetFirehoseConsumerLagNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this get firehose consumer lag not found response has a 2xx status code
+func (o *GetFirehoseConsumerLagNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get firehose consumer lag not found response has a 3xx status code
+func (o *GetFirehoseConsumerLagNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get firehose consumer lag not found response has a 4xx status code
+func (o *GetFirehoseConsumerLagNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get firehose consumer lag not found response has a 5xx status code
+func (o *GetFirehoseConsumerLagNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get firehose consumer lag not found response a status code equal to that given
+func (o *GetFirehoseConsumerLagNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *GetFirehoseConsumerLagNotFound) Error() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/consumerLag][%d] getFirehoseConsumerLagNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetFirehoseConsumerLagNotFound) String() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/consumerLag][%d] getFirehoseConsumerLagNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetFirehoseConsumerLagNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetFirehoseConsumerLagNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetFirehoseConsumerLagInternalServerError creates a GetFirehoseConsumerLagInternalServerError with default headers values
+func NewGetFirehoseConsumerLagInternalServerError() *GetFirehoseConsumerLagInternalServerError {
+	return &GetFirehoseConsumerLagInternalServerError{}
+}
+
+/*
+GetFirehoseConsumerLagInternalServerError describes a response with status code 500, with default header values.
+
+internal error
+*/
+type GetFirehoseConsumerLagInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this get firehose consumer lag internal server error response has a 2xx status code
+func (o *GetFirehoseConsumerLagInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get firehose consumer lag internal server error response has a 3xx status code
+func (o *GetFirehoseConsumerLagInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get firehose consumer lag internal server error response has a 4xx status code
+func (o *GetFirehoseConsumerLagInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get firehose consumer lag internal server error response has a 5xx status code
+func (o *GetFirehoseConsumerLagInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this get firehose consumer lag internal server error response a status code equal to that given
+func (o *GetFirehoseConsumerLagInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *GetFirehoseConsumerLagInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/consumerLag][%d] getFirehoseConsumerLagInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetFirehoseConsumerLagInternalServerError) String() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/consumerLag][%d] getFirehoseConsumerLagInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetFirehoseConsumerLagInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetFirehoseConsumerLagInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,173 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetFirehoseEventsParams creates a new GetFirehoseEventsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetFirehoseEventsParams() *GetFirehoseEventsParams {
+	return &GetFirehoseEventsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetFirehoseEventsParamsWithTimeout creates a new GetFirehoseEventsParams object
+// with the ability to set a timeout on a request.
+func NewGetFirehoseEventsParamsWithTimeout(timeout time.Duration) *GetFirehoseEventsParams {
+	return &GetFirehoseEventsParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetFirehoseEventsParamsWithContext creates a new GetFirehoseEventsParams object
+// with the ability to set a context for a request.
+func NewGetFirehoseEventsParamsWithContext(ctx context.Context) *GetFirehoseEventsParams {
+	return &GetFirehoseEventsParams{
+		Context: ctx,
+	}
+}
+
+// NewGetFirehoseEventsParamsWithHTTPClient creates a new GetFirehoseEventsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetFirehoseEventsParamsWithHTTPClient(client *http.Client) *GetFirehoseEventsParams {
+	return &GetFirehoseEventsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetFirehoseEventsParams contains all the parameters to send to the API endpoint
+
+	for the get firehose events operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetFirehoseEventsParams struct {
+
+	/* FirehoseUrn.
+
+	   URN of the firehose.
+	*/
+	FirehoseUrn string
+
+	/* ProjectSlug.
+
+	   Unique identifier of the project.
+	*/
+	ProjectSlug string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get firehose events params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetFirehoseEventsParams) WithDefaults() *GetFirehoseEventsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get firehose events params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetFirehoseEventsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get firehose events params
+func (o *GetFirehoseEventsParams) WithTimeout(timeout time.Duration) *GetFirehoseEventsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get firehose events params
+func (o *GetFirehoseEventsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get firehose events params
+func (o *GetFirehoseEventsParams) WithContext(ctx context.Context) *GetFirehoseEventsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get firehose events params
+func (o *GetFirehoseEventsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get firehose events params
+func (o *GetFirehoseEventsParams) WithHTTPClient(client *http.Client) *GetFirehoseEventsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get firehose events params
+func (o *GetFirehoseEventsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithFirehoseUrn adds the firehoseUrn to the get firehose events params
+func (o *GetFirehoseEventsParams) WithFirehoseUrn(firehoseUrn string) *GetFirehoseEventsParams {
+	o.SetFirehoseUrn(firehoseUrn)
+	return o
+}
+
+// SetFirehoseUrn adds the firehoseUrn to the get firehose events params
+func (o *GetFirehoseEventsParams) SetFirehoseUrn(firehoseUrn string) {
+	o.FirehoseUrn = firehoseUrn
+}
+
+// WithProjectSlug adds the projectSlug to the get firehose events params
+func (o *GetFirehoseEventsParams) WithProjectSlug(projectSlug string) *GetFirehoseEventsParams {
+	o.SetProjectSlug(projectSlug)
+	return o
+}
+
+// SetProjectSlug adds the projectSlug to the get firehose events params
+func (o *GetFirehoseEventsParams) SetProjectSlug(projectSlug string) {
+	o.ProjectSlug = projectSlug
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetFirehoseEventsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param firehoseUrn
+	if err := r.SetPathParam("firehoseUrn", o.FirehoseUrn); err != nil {
+		return err
+	}
+
+	// path param projectSlug
+	if err := r.SetPathParam("projectSlug", o.ProjectSlug); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
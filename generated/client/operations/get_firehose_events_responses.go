@@ -0,0 +1,238 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/odpf/dex/generated/models"
+)
+
+// GetFirehoseEventsReader is a Reader for the GetFirehoseEvents structure.
+type GetFirehoseEventsReader struct {
+	formats strfmt.Registry
+	writer  io.Writer
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetFirehoseEventsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetFirehoseEventsOK(o.writer)
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewGetFirehoseEventsNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewGetFirehoseEventsInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewGetFirehoseEventsOK creates a GetFirehoseEventsOK with default headers values
+func NewGetFirehoseEventsOK(writer io.Writer) *GetFirehoseEventsOK {
+	return &GetFirehoseEventsOK{
+
+		Payload: writer,
+	}
+}
+
+/*
+GetFirehoseEventsOK describes a response with status code 200, with default header values.
+
+Stream of events for the given firehose URN.
+*/
+type GetFirehoseEventsOK struct {
+	Payload io.Writer
+}
+
+// IsSuccess returns true when this get firehose events o k response has a 2xx status code
+func (o *GetFirehoseEventsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get firehose events o k response has a 3xx status code
+func (o *GetFirehoseEventsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get firehose events o k response has a 4xx status code
+func (o *GetFirehoseEventsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get firehose events o k response has a 5xx status code
+func (o *GetFirehoseEventsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get firehose events o k response a status code equal to that given
+func (o *GetFirehoseEventsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *GetFirehoseEventsOK) Error() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/events][%d] getFirehoseEventsOK  %+v", 200, o.Payload)
+}
+
+func (o *GetFirehoseEventsOK) String() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/events][%d] getFirehoseEventsOK  %+v", 200, o.Payload)
+}
+
+func (o *GetFirehoseEventsOK) GetPayload() io.Writer {
+	return o.Payload
+}
+
+func (o *GetFirehoseEventsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetFirehoseEventsNotFound creates a GetFirehoseEventsNotFound with default headers values
+func NewGetFirehoseEventsNotFound() *GetFirehoseEventsNotFound {
+	return &GetFirehoseEventsNotFound{}
+}
+
+/*
+GetFirehoseEventsNotFound describes a response with status code 404, with default header values.
+
+Firehose with given URN was not found
+*/
+type GetFirehoseEventsNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this get firehose events not found response has a 2xx status code
+func (o *GetFirehoseEventsNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get firehose events not found response has a 3xx status code
+func (o *GetFirehoseEventsNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get firehose events not found response has a 4xx status code
+func (o *GetFirehoseEventsNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get firehose events not found response has a 5xx status code
+func (o *GetFirehoseEventsNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get firehose events not found response a status code equal to that given
+func (o *GetFirehoseEventsNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *GetFirehoseEventsNotFound) Error() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/events][%d] getFirehoseEventsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetFirehoseEventsNotFound) String() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/events][%d] getFirehoseEventsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetFirehoseEventsNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetFirehoseEventsNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetFirehoseEventsInternalServerError creates a GetFirehoseEventsInternalServerError with default headers values
+func NewGetFirehoseEventsInternalServerError() *GetFirehoseEventsInternalServerError {
+	return &GetFirehoseEventsInternalServerError{}
+}
+
+/*
+GetFirehoseEventsInternalServerError describes a response with status code 500, with default header values.
+
+internal error
+*/
+type GetFirehoseEventsInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this get firehose events internal server error response has a 2xx status code
+func (o *GetFirehoseEventsInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get firehose events internal server error response has a 3xx status code
+func (o *GetFirehoseEventsInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get firehose events internal server error response has a 4xx status code
+func (o *GetFirehoseEventsInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get firehose events internal server error response has a 5xx status code
+func (o *GetFirehoseEventsInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this get firehose events internal server error response a status code equal to that given
+func (o *GetFirehoseEventsInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *GetFirehoseEventsInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/events][%d] getFirehoseEventsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetFirehoseEventsInternalServerError) String() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/events][%d] getFirehoseEventsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetFirehoseEventsInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetFirehoseEventsInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
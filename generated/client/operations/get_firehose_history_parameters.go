@@ -14,6 +14,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewGetFirehoseHistoryParams creates a new GetFirehoseHistoryParams object,
@@ -61,18 +62,70 @@ GetFirehoseHistoryParams contains all the parameters to send to the API endpoint
 */
 type GetFirehoseHistoryParams struct {
 
+	/* Actor.
+
+	   Only return the revisions made by the user with this email or id.
+	*/
+	Actor *string
+
 	/* FirehoseUrn.
 
 	   URN of the firehose.
 	*/
 	FirehoseUrn string
 
+	/* Format.
+
+	     Format of the diffs. 'delta' is the jsondiffpatch delta format, 'unified' is a
+	unified diff of the specs and 'jsonpatch' is a JSON Patch (RFC 6902).
+
+
+	     Default: "delta"
+	*/
+	Format *string
+
+	/* Limit.
+
+	   Return only the most recent revisions matching the filters.
+	*/
+	Limit *int64
+
 	/* ProjectSlug.
 
 	   Unique slug name of the project.
 	*/
 	ProjectSlug string
 
+	/* Reason.
+
+	   Only return the revisions whose reason contains this text (case-insensitive).
+	*/
+	Reason *string
+
+	/* Reveal.
+
+	     Return the actual values of the sensitive env vars instead of masking them.
+	Requires manage permission on the project.
+
+	*/
+	Reveal *bool
+
+	/* Since.
+
+	   Only return the revisions created at or after this time.
+
+	   Format: date-time
+	*/
+	Since *strfmt.DateTime
+
+	/* Until.
+
+	   Only return the revisions created at or before this time.
+
+	   Format: date-time
+	*/
+	Until *strfmt.DateTime
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -90,7 +143,18 @@ func (o *GetFirehoseHistoryParams) WithDefaults() *GetFirehoseHistoryParams {
 //
 // All values with no default are reset to their zero value.
 func (o *GetFirehoseHistoryParams) SetDefaults() {
-	// no default values defined for this parameter
+	var (
+		formatDefault = string("delta")
+	)
+
+	val := GetFirehoseHistoryParams{
+		Format: &formatDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
 }
 
 // WithTimeout adds the timeout to the get firehose history params
@@ -126,6 +190,17 @@ func (o *GetFirehoseHistoryParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithActor adds the actor to the get firehose history params
+func (o *GetFirehoseHistoryParams) WithActor(actor *string) *GetFirehoseHistoryParams {
+	o.SetActor(actor)
+	return o
+}
+
+// SetActor adds the actor to the get firehose history params
+func (o *GetFirehoseHistoryParams) SetActor(actor *string) {
+	o.Actor = actor
+}
+
 // WithFirehoseUrn adds the firehoseUrn to the get firehose history params
 func (o *GetFirehoseHistoryParams) WithFirehoseUrn(firehoseUrn string) *GetFirehoseHistoryParams {
 	o.SetFirehoseUrn(firehoseUrn)
@@ -137,6 +212,28 @@ func (o *GetFirehoseHistoryParams) SetFirehoseUrn(firehoseUrn string) {
 	o.FirehoseUrn = firehoseUrn
 }
 
+// WithFormat adds the format to the get firehose history params
+func (o *GetFirehoseHistoryParams) WithFormat(format *string) *GetFirehoseHistoryParams {
+	o.SetFormat(format)
+	return o
+}
+
+// SetFormat adds the format to the get firehose history params
+func (o *GetFirehoseHistoryParams) SetFormat(format *string) {
+	o.Format = format
+}
+
+// WithLimit adds the limit to the get firehose history params
+func (o *GetFirehoseHistoryParams) WithLimit(limit *int64) *GetFirehoseHistoryParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the get firehose history params
+func (o *GetFirehoseHistoryParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
 // WithProjectSlug adds the projectSlug to the get firehose history params
 func (o *GetFirehoseHistoryParams) WithProjectSlug(projectSlug string) *GetFirehoseHistoryParams {
 	o.SetProjectSlug(projectSlug)
@@ -148,6 +245,50 @@ func (o *GetFirehoseHistoryParams) SetProjectSlug(projectSlug string) {
 	o.ProjectSlug = projectSlug
 }
 
+// WithReason adds the reason to the get firehose history params
+func (o *GetFirehoseHistoryParams) WithReason(reason *string) *GetFirehoseHistoryParams {
+	o.SetReason(reason)
+	return o
+}
+
+// SetReason adds the reason to the get firehose history params
+func (o *GetFirehoseHistoryParams) SetReason(reason *string) {
+	o.Reason = reason
+}
+
+// WithReveal adds the reveal to the get firehose history params
+func (o *GetFirehoseHistoryParams) WithReveal(reveal *bool) *GetFirehoseHistoryParams {
+	o.SetReveal(reveal)
+	return o
+}
+
+// SetReveal adds the reveal to the get firehose history params
+func (o *GetFirehoseHistoryParams) SetReveal(reveal *bool) {
+	o.Reveal = reveal
+}
+
+// WithSince adds the since to the get firehose history params
+func (o *GetFirehoseHistoryParams) WithSince(since *strfmt.DateTime) *GetFirehoseHistoryParams {
+	o.SetSince(since)
+	return o
+}
+
+// SetSince adds the since to the get firehose history params
+func (o *GetFirehoseHistoryParams) SetSince(since *strfmt.DateTime) {
+	o.Since = since
+}
+
+// WithUntil adds the until to the get firehose history params
+func (o *GetFirehoseHistoryParams) WithUntil(until *strfmt.DateTime) *GetFirehoseHistoryParams {
+	o.SetUntil(until)
+	return o
+}
+
+// SetUntil adds the until to the get firehose history params
+func (o *GetFirehoseHistoryParams) SetUntil(until *strfmt.DateTime) {
+	o.Until = until
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *GetFirehoseHistoryParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -156,16 +297,135 @@ func (o *GetFirehoseHistoryParams) WriteToRequest(r runtime.ClientRequest, reg s
 	}
 	var res []error
 
+	if o.Actor != nil {
+
+		// query param actor
+		var qrActor string
+
+		if o.Actor != nil {
+			qrActor = *o.Actor
+		}
+		qActor := qrActor
+		if qActor != "" {
+
+			if err := r.SetQueryParam("actor", qActor); err != nil {
+				return err
+			}
+		}
+	}
+
 	// path param firehoseUrn
 	if err := r.SetPathParam("firehoseUrn", o.FirehoseUrn); err != nil {
 		return err
 	}
 
+	if o.Format != nil {
+
+		// query param format
+		var qrFormat string
+
+		if o.Format != nil {
+			qrFormat = *o.Format
+		}
+		qFormat := qrFormat
+		if qFormat != "" {
+
+			if err := r.SetQueryParam("format", qFormat); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
 	// path param projectSlug
 	if err := r.SetPathParam("projectSlug", o.ProjectSlug); err != nil {
 		return err
 	}
 
+	if o.Reason != nil {
+
+		// query param reason
+		var qrReason string
+
+		if o.Reason != nil {
+			qrReason = *o.Reason
+		}
+		qReason := qrReason
+		if qReason != "" {
+
+			if err := r.SetQueryParam("reason", qReason); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Reveal != nil {
+
+		// query param reveal
+		var qrReveal bool
+
+		if o.Reveal != nil {
+			qrReveal = *o.Reveal
+		}
+		qReveal := swag.FormatBool(qrReveal)
+		if qReveal != "" {
+
+			if err := r.SetQueryParam("reveal", qReveal); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Since != nil {
+
+		// query param since
+		var qrSince strfmt.DateTime
+
+		if o.Since != nil {
+			qrSince = *o.Since
+		}
+		qSince := qrSince.String()
+		if qSince != "" {
+
+			if err := r.SetQueryParam("since", qSince); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Until != nil {
+
+		// query param until
+		var qrUntil strfmt.DateTime
+
+		if o.Until != nil {
+			qrUntil = *o.Until
+		}
+		qUntil := qrUntil.String()
+		if qUntil != "" {
+
+			if err := r.SetQueryParam("until", qUntil); err != nil {
+				return err
+			}
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
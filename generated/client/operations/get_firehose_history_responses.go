@@ -29,6 +29,12 @@ func (o *GetFirehoseHistoryReader) ReadResponse(response runtime.ClientResponse,
 			return nil, err
 		}
 		return result, nil
+	case 400:
+		result := NewGetFirehoseHistoryBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 404:
 		result := NewGetFirehoseHistoryNotFound()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -109,6 +115,69 @@ func (o *GetFirehoseHistoryOK) readResponse(response runtime.ClientResponse, con
 	return nil
 }
 
+// NewGetFirehoseHistoryBadRequest creates a GetFirehoseHistoryBadRequest with default headers values
+func NewGetFirehoseHistoryBadRequest() *GetFirehoseHistoryBadRequest {
+	return &GetFirehoseHistoryBadRequest{}
+}
+
+/*
+GetFirehoseHistoryBadRequest describes a response with status code 400, with default header values.
+
+Request was invalid.
+*/
+type GetFirehoseHistoryBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this get firehose history bad request response has a 2xx status code
+func (o *GetFirehoseHistoryBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get firehose history bad request response has a 3xx status code
+func (o *GetFirehoseHistoryBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get firehose history bad request response has a 4xx status code
+func (o *GetFirehoseHistoryBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get firehose history bad request response has a 5xx status code
+func (o *GetFirehoseHistoryBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get firehose history bad request response a status code equal to that given
+func (o *GetFirehoseHistoryBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *GetFirehoseHistoryBadRequest) Error() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/history][%d] getFirehoseHistoryBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetFirehoseHistoryBadRequest) String() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/history][%d] getFirehoseHistoryBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetFirehoseHistoryBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetFirehoseHistoryBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewGetFirehoseHistoryNotFound creates a GetFirehoseHistoryNotFound with default headers values
 func NewGetFirehoseHistoryNotFound() *GetFirehoseHistoryNotFound {
 	return &GetFirehoseHistoryNotFound{}
@@ -68,6 +68,18 @@ type GetFirehoseLogsParams struct {
 	*/
 	Container *string
 
+	/* Download.
+
+	   Return the logs as a gzipped text file. Logs are never followed in this mode.
+	*/
+	Download *bool
+
+	/* Exclude.
+
+	   Drop the lines matching any of the given regular expressions.
+	*/
+	Exclude []string
+
 	/* FirehoseUrn.
 
 	   URN of the firehose.
@@ -82,6 +94,26 @@ type GetFirehoseLogsParams struct {
 	*/
 	Follow *bool
 
+	/* Grep.
+
+	   Return only the lines matching any of the given regular expressions.
+	*/
+	Grep []string
+
+	/* Level.
+
+	     Return only the JSON formatted lines with one of the given levels (e.g., ERROR,WARN).
+	Lines that are not JSON formatted are dropped.
+
+	*/
+	Level []string
+
+	/* MaxBytes.
+
+	   Stop the stream after the given number of bytes of log data.
+	*/
+	MaxBytes *int64
+
 	/* Pod.
 
 	   Return logs for selected pod.
@@ -100,6 +132,14 @@ type GetFirehoseLogsParams struct {
 	*/
 	ProjectSlug string
 
+	/* Reveal.
+
+	     Return the actual values of the sensitive env vars instead of masking them.
+	Requires manage permission on the project.
+
+	*/
+	Reveal *bool
+
 	/* SinceSeconds.
 
 	   Return logs since given seconds ago
@@ -193,6 +233,28 @@ func (o *GetFirehoseLogsParams) SetContainer(container *string) {
 	o.Container = container
 }
 
+// WithDownload adds the download to the get firehose logs params
+func (o *GetFirehoseLogsParams) WithDownload(download *bool) *GetFirehoseLogsParams {
+	o.SetDownload(download)
+	return o
+}
+
+// SetDownload adds the download to the get firehose logs params
+func (o *GetFirehoseLogsParams) SetDownload(download *bool) {
+	o.Download = download
+}
+
+// WithExclude adds the exclude to the get firehose logs params
+func (o *GetFirehoseLogsParams) WithExclude(exclude []string) *GetFirehoseLogsParams {
+	o.SetExclude(exclude)
+	return o
+}
+
+// SetExclude adds the exclude to the get firehose logs params
+func (o *GetFirehoseLogsParams) SetExclude(exclude []string) {
+	o.Exclude = exclude
+}
+
 // WithFirehoseUrn adds the firehoseUrn to the get firehose logs params
 func (o *GetFirehoseLogsParams) WithFirehoseUrn(firehoseUrn string) *GetFirehoseLogsParams {
 	o.SetFirehoseUrn(firehoseUrn)
@@ -215,6 +277,39 @@ func (o *GetFirehoseLogsParams) SetFollow(follow *bool) {
 	o.Follow = follow
 }
 
+// WithGrep adds the grep to the get firehose logs params
+func (o *GetFirehoseLogsParams) WithGrep(grep []string) *GetFirehoseLogsParams {
+	o.SetGrep(grep)
+	return o
+}
+
+// SetGrep adds the grep to the get firehose logs params
+func (o *GetFirehoseLogsParams) SetGrep(grep []string) {
+	o.Grep = grep
+}
+
+// WithLevel adds the level to the get firehose logs params
+func (o *GetFirehoseLogsParams) WithLevel(level []string) *GetFirehoseLogsParams {
+	o.SetLevel(level)
+	return o
+}
+
+// SetLevel adds the level to the get firehose logs params
+func (o *GetFirehoseLogsParams) SetLevel(level []string) {
+	o.Level = level
+}
+
+// WithMaxBytes adds the maxBytes to the get firehose logs params
+func (o *GetFirehoseLogsParams) WithMaxBytes(maxBytes *int64) *GetFirehoseLogsParams {
+	o.SetMaxBytes(maxBytes)
+	return o
+}
+
+// SetMaxBytes adds the maxBytes to the get firehose logs params
+func (o *GetFirehoseLogsParams) SetMaxBytes(maxBytes *int64) {
+	o.MaxBytes = maxBytes
+}
+
 // WithPod adds the pod to the get firehose logs params
 func (o *GetFirehoseLogsParams) WithPod(pod *string) *GetFirehoseLogsParams {
 	o.SetPod(pod)
@@ -248,6 +343,17 @@ func (o *GetFirehoseLogsParams) SetProjectSlug(projectSlug string) {
 	o.ProjectSlug = projectSlug
 }
 
+// WithReveal adds the reveal to the get firehose logs params
+func (o *GetFirehoseLogsParams) WithReveal(reveal *bool) *GetFirehoseLogsParams {
+	o.SetReveal(reveal)
+	return o
+}
+
+// SetReveal adds the reveal to the get firehose logs params
+func (o *GetFirehoseLogsParams) SetReveal(reveal *bool) {
+	o.Reveal = reveal
+}
+
 // WithSinceSeconds adds the sinceSeconds to the get firehose logs params
 func (o *GetFirehoseLogsParams) WithSinceSeconds(sinceSeconds *int64) *GetFirehoseLogsParams {
 	o.SetSinceSeconds(sinceSeconds)
@@ -306,6 +412,34 @@ func (o *GetFirehoseLogsParams) WriteToRequest(r runtime.ClientRequest, reg strf
 		}
 	}
 
+	if o.Download != nil {
+
+		// query param download
+		var qrDownload bool
+
+		if o.Download != nil {
+			qrDownload = *o.Download
+		}
+		qDownload := swag.FormatBool(qrDownload)
+		if qDownload != "" {
+
+			if err := r.SetQueryParam("download", qDownload); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Exclude != nil {
+
+		// binding items for exclude
+		joinedExclude := o.bindParamExclude(reg)
+
+		// query array param exclude
+		if err := r.SetQueryParam("exclude", joinedExclude...); err != nil {
+			return err
+		}
+	}
+
 	// path param firehoseUrn
 	if err := r.SetPathParam("firehoseUrn", o.FirehoseUrn); err != nil {
 		return err
@@ -328,6 +462,45 @@ func (o *GetFirehoseLogsParams) WriteToRequest(r runtime.ClientRequest, reg strf
 		}
 	}
 
+	if o.Grep != nil {
+
+		// binding items for grep
+		joinedGrep := o.bindParamGrep(reg)
+
+		// query array param grep
+		if err := r.SetQueryParam("grep", joinedGrep...); err != nil {
+			return err
+		}
+	}
+
+	if o.Level != nil {
+
+		// binding items for level
+		joinedLevel := o.bindParamLevel(reg)
+
+		// query array param level
+		if err := r.SetQueryParam("level", joinedLevel...); err != nil {
+			return err
+		}
+	}
+
+	if o.MaxBytes != nil {
+
+		// query param max_bytes
+		var qrMaxBytes int64
+
+		if o.MaxBytes != nil {
+			qrMaxBytes = *o.MaxBytes
+		}
+		qMaxBytes := swag.FormatInt64(qrMaxBytes)
+		if qMaxBytes != "" {
+
+			if err := r.SetQueryParam("max_bytes", qMaxBytes); err != nil {
+				return err
+			}
+		}
+	}
+
 	if o.Pod != nil {
 
 		// query param pod
@@ -367,6 +540,23 @@ func (o *GetFirehoseLogsParams) WriteToRequest(r runtime.ClientRequest, reg strf
 		return err
 	}
 
+	if o.Reveal != nil {
+
+		// query param reveal
+		var qrReveal bool
+
+		if o.Reveal != nil {
+			qrReveal = *o.Reveal
+		}
+		qReveal := swag.FormatBool(qrReveal)
+		if qReveal != "" {
+
+			if err := r.SetQueryParam("reveal", qReveal); err != nil {
+				return err
+			}
+		}
+	}
+
 	if o.SinceSeconds != nil {
 
 		// query param since_seconds
@@ -423,3 +613,54 @@ func (o *GetFirehoseLogsParams) WriteToRequest(r runtime.ClientRequest, reg strf
 	}
 	return nil
 }
+
+// bindParamGetFirehoseLogs binds the parameter exclude
+func (o *GetFirehoseLogsParams) bindParamExclude(formats strfmt.Registry) []string {
+	excludeIR := o.Exclude
+
+	var excludeIC []string
+	for _, excludeIIR := range excludeIR { // explode []string
+
+		excludeIIV := excludeIIR // string as string
+		excludeIC = append(excludeIC, excludeIIV)
+	}
+
+	// items.CollectionFormat: "multi"
+	excludeIS := swag.JoinByFormat(excludeIC, "multi")
+
+	return excludeIS
+}
+
+// bindParamGetFirehoseLogs binds the parameter grep
+func (o *GetFirehoseLogsParams) bindParamGrep(formats strfmt.Registry) []string {
+	grepIR := o.Grep
+
+	var grepIC []string
+	for _, grepIIR := range grepIR { // explode []string
+
+		grepIIV := grepIIR // string as string
+		grepIC = append(grepIC, grepIIV)
+	}
+
+	// items.CollectionFormat: "multi"
+	grepIS := swag.JoinByFormat(grepIC, "multi")
+
+	return grepIS
+}
+
+// bindParamGetFirehoseLogs binds the parameter level
+func (o *GetFirehoseLogsParams) bindParamLevel(formats strfmt.Registry) []string {
+	levelIR := o.Level
+
+	var levelIC []string
+	for _, levelIIR := range levelIR { // explode []string
+
+		levelIIV := levelIIR // string as string
+		levelIC = append(levelIC, levelIIV)
+	}
+
+	// items.CollectionFormat: "csv"
+	levelIS := swag.JoinByFormat(levelIC, "csv")
+
+	return levelIS
+}
@@ -0,0 +1,195 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetFirehoseMigrationParams creates a new GetFirehoseMigrationParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetFirehoseMigrationParams() *GetFirehoseMigrationParams {
+	return &GetFirehoseMigrationParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetFirehoseMigrationParamsWithTimeout creates a new GetFirehoseMigrationParams object
+// with the ability to set a timeout on a request.
+func NewGetFirehoseMigrationParamsWithTimeout(timeout time.Duration) *GetFirehoseMigrationParams {
+	return &GetFirehoseMigrationParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetFirehoseMigrationParamsWithContext creates a new GetFirehoseMigrationParams object
+// with the ability to set a context for a request.
+func NewGetFirehoseMigrationParamsWithContext(ctx context.Context) *GetFirehoseMigrationParams {
+	return &GetFirehoseMigrationParams{
+		Context: ctx,
+	}
+}
+
+// NewGetFirehoseMigrationParamsWithHTTPClient creates a new GetFirehoseMigrationParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetFirehoseMigrationParamsWithHTTPClient(client *http.Client) *GetFirehoseMigrationParams {
+	return &GetFirehoseMigrationParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetFirehoseMigrationParams contains all the parameters to send to the API endpoint
+
+	for the get firehose migration operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetFirehoseMigrationParams struct {
+
+	/* FirehoseUrn.
+
+	   URN of the firehose.
+	*/
+	FirehoseUrn string
+
+	/* MigrationID.
+
+	   Identifier of the migration.
+	*/
+	MigrationID string
+
+	/* ProjectSlug.
+
+	   Identifier for the project.
+	*/
+	ProjectSlug string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get firehose migration params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetFirehoseMigrationParams) WithDefaults() *GetFirehoseMigrationParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get firehose migration params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetFirehoseMigrationParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get firehose migration params
+func (o *GetFirehoseMigrationParams) WithTimeout(timeout time.Duration) *GetFirehoseMigrationParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get firehose migration params
+func (o *GetFirehoseMigrationParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get firehose migration params
+func (o *GetFirehoseMigrationParams) WithContext(ctx context.Context) *GetFirehoseMigrationParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get firehose migration params
+func (o *GetFirehoseMigrationParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get firehose migration params
+func (o *GetFirehoseMigrationParams) WithHTTPClient(client *http.Client) *GetFirehoseMigrationParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get firehose migration params
+func (o *GetFirehoseMigrationParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithFirehoseUrn adds the firehoseUrn to the get firehose migration params
+func (o *GetFirehoseMigrationParams) WithFirehoseUrn(firehoseUrn string) *GetFirehoseMigrationParams {
+	o.SetFirehoseUrn(firehoseUrn)
+	return o
+}
+
+// SetFirehoseUrn adds the firehoseUrn to the get firehose migration params
+func (o *GetFirehoseMigrationParams) SetFirehoseUrn(firehoseUrn string) {
+	o.FirehoseUrn = firehoseUrn
+}
+
+// WithMigrationID adds the migrationID to the get firehose migration params
+func (o *GetFirehoseMigrationParams) WithMigrationID(migrationID string) *GetFirehoseMigrationParams {
+	o.SetMigrationID(migrationID)
+	return o
+}
+
+// SetMigrationID adds the migrationId to the get firehose migration params
+func (o *GetFirehoseMigrationParams) SetMigrationID(migrationID string) {
+	o.MigrationID = migrationID
+}
+
+// WithProjectSlug adds the projectSlug to the get firehose migration params
+func (o *GetFirehoseMigrationParams) WithProjectSlug(projectSlug string) *GetFirehoseMigrationParams {
+	o.SetProjectSlug(projectSlug)
+	return o
+}
+
+// SetProjectSlug adds the projectSlug to the get firehose migration params
+func (o *GetFirehoseMigrationParams) SetProjectSlug(projectSlug string) {
+	o.ProjectSlug = projectSlug
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetFirehoseMigrationParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param firehoseUrn
+	if err := r.SetPathParam("firehoseUrn", o.FirehoseUrn); err != nil {
+		return err
+	}
+
+	// path param migrationId
+	if err := r.SetPathParam("migrationId", o.MigrationID); err != nil {
+		return err
+	}
+
+	// path param projectSlug
+	if err := r.SetPathParam("projectSlug", o.ProjectSlug); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
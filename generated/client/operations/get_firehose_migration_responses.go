@@ -0,0 +1,236 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/odpf/dex/generated/models"
+)
+
+// GetFirehoseMigrationReader is a Reader for the GetFirehoseMigration structure.
+type GetFirehoseMigrationReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetFirehoseMigrationReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetFirehoseMigrationOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewGetFirehoseMigrationNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewGetFirehoseMigrationInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewGetFirehoseMigrationOK creates a GetFirehoseMigrationOK with default headers values
+func NewGetFirehoseMigrationOK() *GetFirehoseMigrationOK {
+	return &GetFirehoseMigrationOK{}
+}
+
+/*
+GetFirehoseMigrationOK describes a response with status code 200, with default header values.
+
+Found the migration.
+*/
+type GetFirehoseMigrationOK struct {
+	Payload *models.FirehoseMigration
+}
+
+// IsSuccess returns true when this get firehose migration o k response has a 2xx status code
+func (o *GetFirehoseMigrationOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get firehose migration o k response has a 3xx status code
+func (o *GetFirehoseMigrationOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get firehose migration o k response has a 4xx status code
+func (o *GetFirehoseMigrationOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get firehose migration o k response has a 5xx status code
+func (o *GetFirehoseMigrationOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get firehose migration o k response a status code equal to that given
+func (o *GetFirehoseMigrationOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *GetFirehoseMigrationOK) Error() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/migrations/{migrationId}][%d] getFirehoseMigrationOK  %+v", 200, o.Payload)
+}
+
+func (o *GetFirehoseMigrationOK) String() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/migrations/{migrationId}][%d] getFirehoseMigrationOK  %+v", 200, o.Payload)
+}
+
+func (o *GetFirehoseMigrationOK) GetPayload() *models.FirehoseMigration {
+	return o.Payload
+}
+
+func (o *GetFirehoseMigrationOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.FirehoseMigration)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetFirehoseMigrationNotFound creates a GetFirehoseMigrationNotFound with default headers values
+func NewGetFirehoseMigrationNotFound() *GetFirehoseMigrationNotFound {
+	return &GetFirehoseMigrationNotFound{}
+}
+
+/*
+GetFirehoseMigrationNotFound describes a response with status code 404, with default header values.
+
+Migration with given id was not found
+*/
+type GetFirehoseMigrationNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this get firehose migration not found response has a 2xx status code
+func (o *GetFirehoseMigrationNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get firehose migration not found response has a 3xx status code
+func (o *GetFirehoseMigrationNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get firehose migration not found response has a 4xx status code
+func (o *GetFirehoseMigrationNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get firehose migration not found response has a 5xx status code
+func (o *GetFirehoseMigrationNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get firehose migration not found response a status code equal to that given
+func (o *GetFirehoseMigrationNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *GetFirehoseMigrationNotFound) Error() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/migrations/{migrationId}][%d] getFirehoseMigrationNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetFirehoseMigrationNotFound) String() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/migrations/{migrationId}][%d] getFirehoseMigrationNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetFirehoseMigrationNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetFirehoseMigrationNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetFirehoseMigrationInternalServerError creates a GetFirehoseMigrationInternalServerError with default headers values
+func NewGetFirehoseMigrationInternalServerError() *GetFirehoseMigrationInternalServerError {
+	return &GetFirehoseMigrationInternalServerError{}
+}
+
+/*
+GetFirehoseMigrationInternalServerError describes a response with status code 500, with default header values.
+
+internal error
+*/
+type GetFirehoseMigrationInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this get firehose migration internal server error response has a 2xx status code
+func (o *GetFirehoseMigrationInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get firehose migration internal server error response has a 3xx status code
+func (o *GetFirehoseMigrationInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get firehose migration internal server error response has a 4xx status code
+func (o *GetFirehoseMigrationInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get firehose migration internal server error response has a 5xx status code
+func (o *GetFirehoseMigrationInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this get firehose migration internal server error response a status code equal to that given
+func (o *GetFirehoseMigrationInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *GetFirehoseMigrationInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/migrations/{migrationId}][%d] getFirehoseMigrationInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetFirehoseMigrationInternalServerError) String() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/migrations/{migrationId}][%d] getFirehoseMigrationInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetFirehoseMigrationInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetFirehoseMigrationInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
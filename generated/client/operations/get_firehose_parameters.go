@@ -14,6 +14,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewGetFirehoseParams creates a new GetFirehoseParams object,
@@ -73,6 +74,14 @@ type GetFirehoseParams struct {
 	*/
 	ProjectSlug string
 
+	/* Reveal.
+
+	     Return the actual values of the sensitive env vars instead of masking them.
+	Requires manage permission on the project.
+
+	*/
+	Reveal *bool
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -148,6 +157,17 @@ func (o *GetFirehoseParams) SetProjectSlug(projectSlug string) {
 	o.ProjectSlug = projectSlug
 }
 
+// WithReveal adds the reveal to the get firehose params
+func (o *GetFirehoseParams) WithReveal(reveal *bool) *GetFirehoseParams {
+	o.SetReveal(reveal)
+	return o
+}
+
+// SetReveal adds the reveal to the get firehose params
+func (o *GetFirehoseParams) SetReveal(reveal *bool) {
+	o.Reveal = reveal
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *GetFirehoseParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -166,6 +186,23 @@ func (o *GetFirehoseParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.R
 		return err
 	}
 
+	if o.Reveal != nil {
+
+		// query param reveal
+		var qrReveal bool
+
+		if o.Reveal != nil {
+			qrReveal = *o.Reveal
+		}
+		qReveal := swag.FormatBool(qrReveal)
+		if qReveal != "" {
+
+			if err := r.SetQueryParam("reveal", qReveal); err != nil {
+				return err
+			}
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
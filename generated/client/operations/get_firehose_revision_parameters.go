@@ -0,0 +1,232 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetFirehoseRevisionParams creates a new GetFirehoseRevisionParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetFirehoseRevisionParams() *GetFirehoseRevisionParams {
+	return &GetFirehoseRevisionParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetFirehoseRevisionParamsWithTimeout creates a new GetFirehoseRevisionParams object
+// with the ability to set a timeout on a request.
+func NewGetFirehoseRevisionParamsWithTimeout(timeout time.Duration) *GetFirehoseRevisionParams {
+	return &GetFirehoseRevisionParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetFirehoseRevisionParamsWithContext creates a new GetFirehoseRevisionParams object
+// with the ability to set a context for a request.
+func NewGetFirehoseRevisionParamsWithContext(ctx context.Context) *GetFirehoseRevisionParams {
+	return &GetFirehoseRevisionParams{
+		Context: ctx,
+	}
+}
+
+// NewGetFirehoseRevisionParamsWithHTTPClient creates a new GetFirehoseRevisionParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetFirehoseRevisionParamsWithHTTPClient(client *http.Client) *GetFirehoseRevisionParams {
+	return &GetFirehoseRevisionParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetFirehoseRevisionParams contains all the parameters to send to the API endpoint
+
+	for the get firehose revision operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetFirehoseRevisionParams struct {
+
+	/* FirehoseUrn.
+
+	   URN of the firehose.
+	*/
+	FirehoseUrn string
+
+	/* ProjectSlug.
+
+	   Unique slug name of the project.
+	*/
+	ProjectSlug string
+
+	/* Reveal.
+
+	     Return the actual values of the sensitive env vars instead of masking them.
+	Requires manage permission on the project.
+
+	*/
+	Reveal *bool
+
+	/* Revision.
+
+	   Number of the revision, 1 being the oldest.
+	*/
+	Revision int64
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get firehose revision params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetFirehoseRevisionParams) WithDefaults() *GetFirehoseRevisionParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get firehose revision params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetFirehoseRevisionParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get firehose revision params
+func (o *GetFirehoseRevisionParams) WithTimeout(timeout time.Duration) *GetFirehoseRevisionParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get firehose revision params
+func (o *GetFirehoseRevisionParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get firehose revision params
+func (o *GetFirehoseRevisionParams) WithContext(ctx context.Context) *GetFirehoseRevisionParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get firehose revision params
+func (o *GetFirehoseRevisionParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get firehose revision params
+func (o *GetFirehoseRevisionParams) WithHTTPClient(client *http.Client) *GetFirehoseRevisionParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get firehose revision params
+func (o *GetFirehoseRevisionParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithFirehoseUrn adds the firehoseUrn to the get firehose revision params
+func (o *GetFirehoseRevisionParams) WithFirehoseUrn(firehoseUrn string) *GetFirehoseRevisionParams {
+	o.SetFirehoseUrn(firehoseUrn)
+	return o
+}
+
+// SetFirehoseUrn adds the firehoseUrn to the get firehose revision params
+func (o *GetFirehoseRevisionParams) SetFirehoseUrn(firehoseUrn string) {
+	o.FirehoseUrn = firehoseUrn
+}
+
+// WithProjectSlug adds the projectSlug to the get firehose revision params
+func (o *GetFirehoseRevisionParams) WithProjectSlug(projectSlug string) *GetFirehoseRevisionParams {
+	o.SetProjectSlug(projectSlug)
+	return o
+}
+
+// SetProjectSlug adds the projectSlug to the get firehose revision params
+func (o *GetFirehoseRevisionParams) SetProjectSlug(projectSlug string) {
+	o.ProjectSlug = projectSlug
+}
+
+// WithReveal adds the reveal to the get firehose revision params
+func (o *GetFirehoseRevisionParams) WithReveal(reveal *bool) *GetFirehoseRevisionParams {
+	o.SetReveal(reveal)
+	return o
+}
+
+// SetReveal adds the reveal to the get firehose revision params
+func (o *GetFirehoseRevisionParams) SetReveal(reveal *bool) {
+	o.Reveal = reveal
+}
+
+// WithRevision adds the revision to the get firehose revision params
+func (o *GetFirehoseRevisionParams) WithRevision(revision int64) *GetFirehoseRevisionParams {
+	o.SetRevision(revision)
+	return o
+}
+
+// SetRevision adds the revision to the get firehose revision params
+func (o *GetFirehoseRevisionParams) SetRevision(revision int64) {
+	o.Revision = revision
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetFirehoseRevisionParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param firehoseUrn
+	if err := r.SetPathParam("firehoseUrn", o.FirehoseUrn); err != nil {
+		return err
+	}
+
+	// path param projectSlug
+	if err := r.SetPathParam("projectSlug", o.ProjectSlug); err != nil {
+		return err
+	}
+
+	if o.Reveal != nil {
+
+		// query param reveal
+		var qrReveal bool
+
+		if o.Reveal != nil {
+			qrReveal = *o.Reveal
+		}
+		qReveal := swag.FormatBool(qrReveal)
+		if qReveal != "" {
+
+			if err := r.SetQueryParam("reveal", qReveal); err != nil {
+				return err
+			}
+		}
+	}
+
+	// path param revision
+	if err := r.SetPathParam("revision", swag.FormatInt64(o.Revision)); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,305 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/odpf/dex/generated/models"
+)
+
+// GetFirehoseRevisionReader is a Reader for the GetFirehoseRevision structure.
+type GetFirehoseRevisionReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetFirehoseRevisionReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetFirehoseRevisionOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewGetFirehoseRevisionBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewGetFirehoseRevisionNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewGetFirehoseRevisionInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewGetFirehoseRevisionOK creates a GetFirehoseRevisionOK with default headers values
+func NewGetFirehoseRevisionOK() *GetFirehoseRevisionOK {
+	return &GetFirehoseRevisionOK{}
+}
+
+/*
+GetFirehoseRevisionOK describes a response with status code 200, with default header values.
+
+Found the revision.
+*/
+type GetFirehoseRevisionOK struct {
+	Payload *models.Revision
+}
+
+// IsSuccess returns true when this get firehose revision o k response has a 2xx status code
+func (o *GetFirehoseRevisionOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get firehose revision o k response has a 3xx status code
+func (o *GetFirehoseRevisionOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get firehose revision o k response has a 4xx status code
+func (o *GetFirehoseRevisionOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get firehose revision o k response has a 5xx status code
+func (o *GetFirehoseRevisionOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get firehose revision o k response a status code equal to that given
+func (o *GetFirehoseRevisionOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *GetFirehoseRevisionOK) Error() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/history/{revision}][%d] getFirehoseRevisionOK  %+v", 200, o.Payload)
+}
+
+func (o *GetFirehoseRevisionOK) String() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/history/{revision}][%d] getFirehoseRevisionOK  %+v", 200, o.Payload)
+}
+
+func (o *GetFirehoseRevisionOK) GetPayload() *models.Revision {
+	return o.Payload
+}
+
+func (o *GetFirehoseRevisionOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Revision)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetFirehoseRevisionBadRequest creates a GetFirehoseRevisionBadRequest with default headers values
+func NewGetFirehoseRevisionBadRequest() *GetFirehoseRevisionBadRequest {
+	return &GetFirehoseRevisionBadRequest{}
+}
+
+/*
+GetFirehoseRevisionBadRequest describes a response with status code 400, with default header values.
+
+Request was invalid.
+*/
+type GetFirehoseRevisionBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this get firehose revision bad request response has a 2xx status code
+func (o *GetFirehoseRevisionBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get firehose revision bad request response has a 3xx status code
+func (o *GetFirehoseRevisionBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get firehose revision bad request response has a 4xx status code
+func (o *GetFirehoseRevisionBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get firehose revision bad request response has a 5xx status code
+func (o *GetFirehoseRevisionBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get firehose revision bad request response a status code equal to that given
+func (o *GetFirehoseRevisionBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *GetFirehoseRevisionBadRequest) Error() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/history/{revision}][%d] getFirehoseRevisionBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetFirehoseRevisionBadRequest) String() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/history/{revision}][%d] getFirehoseRevisionBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetFirehoseRevisionBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetFirehoseRevisionBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetFirehoseRevisionNotFound creates a GetFirehoseRevisionNotFound with default headers values
+func NewGetFirehoseRevisionNotFound() *GetFirehoseRevisionNotFound {
+	return &GetFirehoseRevisionNotFound{}
+}
+
+/*
+GetFirehoseRevisionNotFound describes a response with status code 404, with default header values.
+
+Firehose or the revision was not found
+*/
+type GetFirehoseRevisionNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this get firehose revision not found response has a 2xx status code
+func (o *GetFirehoseRevisionNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get firehose revision not found response has a 3xx status code
+func (o *GetFirehoseRevisionNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get firehose revision not found response has a 4xx status code
+func (o *GetFirehoseRevisionNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get firehose revision not found response has a 5xx status code
+func (o *GetFirehoseRevisionNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get firehose revision not found response a status code equal to that given
+func (o *GetFirehoseRevisionNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *GetFirehoseRevisionNotFound) Error() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/history/{revision}][%d] getFirehoseRevisionNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetFirehoseRevisionNotFound) String() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/history/{revision}][%d] getFirehoseRevisionNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetFirehoseRevisionNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetFirehoseRevisionNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetFirehoseRevisionInternalServerError creates a GetFirehoseRevisionInternalServerError with default headers values
+func NewGetFirehoseRevisionInternalServerError() *GetFirehoseRevisionInternalServerError {
+	return &GetFirehoseRevisionInternalServerError{}
+}
+
+/*
+GetFirehoseRevisionInternalServerError describes a response with status code 500, with default header values.
+
+internal error
+*/
+type GetFirehoseRevisionInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this get firehose revision internal server error response has a 2xx status code
+func (o *GetFirehoseRevisionInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get firehose revision internal server error response has a 3xx status code
+func (o *GetFirehoseRevisionInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get firehose revision internal server error response has a 4xx status code
+func (o *GetFirehoseRevisionInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get firehose revision internal server error response has a 5xx status code
+func (o *GetFirehoseRevisionInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this get firehose revision internal server error response a status code equal to that given
+func (o *GetFirehoseRevisionInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *GetFirehoseRevisionInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/history/{revision}][%d] getFirehoseRevisionInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetFirehoseRevisionInternalServerError) String() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/history/{revision}][%d] getFirehoseRevisionInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetFirehoseRevisionInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetFirehoseRevisionInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
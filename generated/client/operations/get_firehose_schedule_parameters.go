@@ -0,0 +1,195 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetFirehoseScheduleParams creates a new GetFirehoseScheduleParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetFirehoseScheduleParams() *GetFirehoseScheduleParams {
+	return &GetFirehoseScheduleParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetFirehoseScheduleParamsWithTimeout creates a new GetFirehoseScheduleParams object
+// with the ability to set a timeout on a request.
+func NewGetFirehoseScheduleParamsWithTimeout(timeout time.Duration) *GetFirehoseScheduleParams {
+	return &GetFirehoseScheduleParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetFirehoseScheduleParamsWithContext creates a new GetFirehoseScheduleParams object
+// with the ability to set a context for a request.
+func NewGetFirehoseScheduleParamsWithContext(ctx context.Context) *GetFirehoseScheduleParams {
+	return &GetFirehoseScheduleParams{
+		Context: ctx,
+	}
+}
+
+// NewGetFirehoseScheduleParamsWithHTTPClient creates a new GetFirehoseScheduleParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetFirehoseScheduleParamsWithHTTPClient(client *http.Client) *GetFirehoseScheduleParams {
+	return &GetFirehoseScheduleParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetFirehoseScheduleParams contains all the parameters to send to the API endpoint
+
+	for the get firehose schedule operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetFirehoseScheduleParams struct {
+
+	/* FirehoseUrn.
+
+	   URN of the firehose.
+	*/
+	FirehoseUrn string
+
+	/* ProjectSlug.
+
+	   Identifier for the project.
+	*/
+	ProjectSlug string
+
+	/* ScheduleID.
+
+	   Identifier of the schedule.
+	*/
+	ScheduleID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get firehose schedule params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetFirehoseScheduleParams) WithDefaults() *GetFirehoseScheduleParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get firehose schedule params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetFirehoseScheduleParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get firehose schedule params
+func (o *GetFirehoseScheduleParams) WithTimeout(timeout time.Duration) *GetFirehoseScheduleParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get firehose schedule params
+func (o *GetFirehoseScheduleParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get firehose schedule params
+func (o *GetFirehoseScheduleParams) WithContext(ctx context.Context) *GetFirehoseScheduleParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get firehose schedule params
+func (o *GetFirehoseScheduleParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get firehose schedule params
+func (o *GetFirehoseScheduleParams) WithHTTPClient(client *http.Client) *GetFirehoseScheduleParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get firehose schedule params
+func (o *GetFirehoseScheduleParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithFirehoseUrn adds the firehoseUrn to the get firehose schedule params
+func (o *GetFirehoseScheduleParams) WithFirehoseUrn(firehoseUrn string) *GetFirehoseScheduleParams {
+	o.SetFirehoseUrn(firehoseUrn)
+	return o
+}
+
+// SetFirehoseUrn adds the firehoseUrn to the get firehose schedule params
+func (o *GetFirehoseScheduleParams) SetFirehoseUrn(firehoseUrn string) {
+	o.FirehoseUrn = firehoseUrn
+}
+
+// WithProjectSlug adds the projectSlug to the get firehose schedule params
+func (o *GetFirehoseScheduleParams) WithProjectSlug(projectSlug string) *GetFirehoseScheduleParams {
+	o.SetProjectSlug(projectSlug)
+	return o
+}
+
+// SetProjectSlug adds the projectSlug to the get firehose schedule params
+func (o *GetFirehoseScheduleParams) SetProjectSlug(projectSlug string) {
+	o.ProjectSlug = projectSlug
+}
+
+// WithScheduleID adds the scheduleID to the get firehose schedule params
+func (o *GetFirehoseScheduleParams) WithScheduleID(scheduleID string) *GetFirehoseScheduleParams {
+	o.SetScheduleID(scheduleID)
+	return o
+}
+
+// SetScheduleID adds the scheduleId to the get firehose schedule params
+func (o *GetFirehoseScheduleParams) SetScheduleID(scheduleID string) {
+	o.ScheduleID = scheduleID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetFirehoseScheduleParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param firehoseUrn
+	if err := r.SetPathParam("firehoseUrn", o.FirehoseUrn); err != nil {
+		return err
+	}
+
+	// path param projectSlug
+	if err := r.SetPathParam("projectSlug", o.ProjectSlug); err != nil {
+		return err
+	}
+
+	// path param scheduleId
+	if err := r.SetPathParam("scheduleId", o.ScheduleID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
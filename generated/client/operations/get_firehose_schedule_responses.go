@@ -0,0 +1,236 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/odpf/dex/generated/models"
+)
+
+// GetFirehoseScheduleReader is a Reader for the GetFirehoseSchedule structure.
+type GetFirehoseScheduleReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetFirehoseScheduleReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetFirehoseScheduleOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewGetFirehoseScheduleNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewGetFirehoseScheduleInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewGetFirehoseScheduleOK creates a GetFirehoseScheduleOK with default headers values
+func NewGetFirehoseScheduleOK() *GetFirehoseScheduleOK {
+	return &GetFirehoseScheduleOK{}
+}
+
+/*
+GetFirehoseScheduleOK describes a response with status code 200, with default header values.
+
+Found the schedule.
+*/
+type GetFirehoseScheduleOK struct {
+	Payload *models.Schedule
+}
+
+// IsSuccess returns true when this get firehose schedule o k response has a 2xx status code
+func (o *GetFirehoseScheduleOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get firehose schedule o k response has a 3xx status code
+func (o *GetFirehoseScheduleOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get firehose schedule o k response has a 4xx status code
+func (o *GetFirehoseScheduleOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get firehose schedule o k response has a 5xx status code
+func (o *GetFirehoseScheduleOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get firehose schedule o k response a status code equal to that given
+func (o *GetFirehoseScheduleOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *GetFirehoseScheduleOK) Error() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/schedules/{scheduleId}][%d] getFirehoseScheduleOK  %+v", 200, o.Payload)
+}
+
+func (o *GetFirehoseScheduleOK) String() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/schedules/{scheduleId}][%d] getFirehoseScheduleOK  %+v", 200, o.Payload)
+}
+
+func (o *GetFirehoseScheduleOK) GetPayload() *models.Schedule {
+	return o.Payload
+}
+
+func (o *GetFirehoseScheduleOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Schedule)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetFirehoseScheduleNotFound creates a GetFirehoseScheduleNotFound with default headers values
+func NewGetFirehoseScheduleNotFound() *GetFirehoseScheduleNotFound {
+	return &GetFirehoseScheduleNotFound{}
+}
+
+/*
+GetFirehoseScheduleNotFound describes a response with status code 404, with default header values.
+
+Schedule was not found
+*/
+type GetFirehoseScheduleNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this get firehose schedule not found response has a 2xx status code
+func (o *GetFirehoseScheduleNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get firehose schedule not found response has a 3xx status code
+func (o *GetFirehoseScheduleNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get firehose schedule not found response has a 4xx status code
+func (o *GetFirehoseScheduleNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get firehose schedule not found response has a 5xx status code
+func (o *GetFirehoseScheduleNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get firehose schedule not found response a status code equal to that given
+func (o *GetFirehoseScheduleNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *GetFirehoseScheduleNotFound) Error() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/schedules/{scheduleId}][%d] getFirehoseScheduleNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetFirehoseScheduleNotFound) String() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/schedules/{scheduleId}][%d] getFirehoseScheduleNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetFirehoseScheduleNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetFirehoseScheduleNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetFirehoseScheduleInternalServerError creates a GetFirehoseScheduleInternalServerError with default headers values
+func NewGetFirehoseScheduleInternalServerError() *GetFirehoseScheduleInternalServerError {
+	return &GetFirehoseScheduleInternalServerError{}
+}
+
+/*
+GetFirehoseScheduleInternalServerError describes a response with status code 500, with default header values.
+
+internal error
+*/
+type GetFirehoseScheduleInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this get firehose schedule internal server error response has a 2xx status code
+func (o *GetFirehoseScheduleInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get firehose schedule internal server error response has a 3xx status code
+func (o *GetFirehoseScheduleInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get firehose schedule internal server error response has a 4xx status code
+func (o *GetFirehoseScheduleInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get firehose schedule internal server error response has a 5xx status code
+func (o *GetFirehoseScheduleInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this get firehose schedule internal server error response a status code equal to that given
+func (o *GetFirehoseScheduleInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *GetFirehoseScheduleInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/schedules/{scheduleId}][%d] getFirehoseScheduleInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetFirehoseScheduleInternalServerError) String() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/schedules/{scheduleId}][%d] getFirehoseScheduleInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetFirehoseScheduleInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetFirehoseScheduleInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,210 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetFirehoseTemplateParams creates a new GetFirehoseTemplateParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetFirehoseTemplateParams() *GetFirehoseTemplateParams {
+	return &GetFirehoseTemplateParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetFirehoseTemplateParamsWithTimeout creates a new GetFirehoseTemplateParams object
+// with the ability to set a timeout on a request.
+func NewGetFirehoseTemplateParamsWithTimeout(timeout time.Duration) *GetFirehoseTemplateParams {
+	return &GetFirehoseTemplateParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetFirehoseTemplateParamsWithContext creates a new GetFirehoseTemplateParams object
+// with the ability to set a context for a request.
+func NewGetFirehoseTemplateParamsWithContext(ctx context.Context) *GetFirehoseTemplateParams {
+	return &GetFirehoseTemplateParams{
+		Context: ctx,
+	}
+}
+
+// NewGetFirehoseTemplateParamsWithHTTPClient creates a new GetFirehoseTemplateParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetFirehoseTemplateParamsWithHTTPClient(client *http.Client) *GetFirehoseTemplateParams {
+	return &GetFirehoseTemplateParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetFirehoseTemplateParams contains all the parameters to send to the API endpoint
+
+	for the get firehose template operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetFirehoseTemplateParams struct {
+
+	/* ProjectSlug.
+
+	   Unique slug name of the project.
+	*/
+	ProjectSlug string
+
+	/* Reveal.
+
+	     Return the actual values of the sensitive env vars instead of masking them.
+	Requires manage permission on the project.
+
+	*/
+	Reveal *bool
+
+	/* TemplateName.
+
+	   Name of the template.
+	*/
+	TemplateName string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get firehose template params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetFirehoseTemplateParams) WithDefaults() *GetFirehoseTemplateParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get firehose template params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetFirehoseTemplateParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get firehose template params
+func (o *GetFirehoseTemplateParams) WithTimeout(timeout time.Duration) *GetFirehoseTemplateParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get firehose template params
+func (o *GetFirehoseTemplateParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get firehose template params
+func (o *GetFirehoseTemplateParams) WithContext(ctx context.Context) *GetFirehoseTemplateParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get firehose template params
+func (o *GetFirehoseTemplateParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get firehose template params
+func (o *GetFirehoseTemplateParams) WithHTTPClient(client *http.Client) *GetFirehoseTemplateParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get firehose template params
+func (o *GetFirehoseTemplateParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithProjectSlug adds the projectSlug to the get firehose template params
+func (o *GetFirehoseTemplateParams) WithProjectSlug(projectSlug string) *GetFirehoseTemplateParams {
+	o.SetProjectSlug(projectSlug)
+	return o
+}
+
+// SetProjectSlug adds the projectSlug to the get firehose template params
+func (o *GetFirehoseTemplateParams) SetProjectSlug(projectSlug string) {
+	o.ProjectSlug = projectSlug
+}
+
+// WithReveal adds the reveal to the get firehose template params
+func (o *GetFirehoseTemplateParams) WithReveal(reveal *bool) *GetFirehoseTemplateParams {
+	o.SetReveal(reveal)
+	return o
+}
+
+// SetReveal adds the reveal to the get firehose template params
+func (o *GetFirehoseTemplateParams) SetReveal(reveal *bool) {
+	o.Reveal = reveal
+}
+
+// WithTemplateName adds the templateName to the get firehose template params
+func (o *GetFirehoseTemplateParams) WithTemplateName(templateName string) *GetFirehoseTemplateParams {
+	o.SetTemplateName(templateName)
+	return o
+}
+
+// SetTemplateName adds the templateName to the get firehose template params
+func (o *GetFirehoseTemplateParams) SetTemplateName(templateName string) {
+	o.TemplateName = templateName
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetFirehoseTemplateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param projectSlug
+	if err := r.SetPathParam("projectSlug", o.ProjectSlug); err != nil {
+		return err
+	}
+
+	if o.Reveal != nil {
+
+		// query param reveal
+		var qrReveal bool
+
+		if o.Reveal != nil {
+			qrReveal = *o.Reveal
+		}
+		qReveal := swag.FormatBool(qrReveal)
+		if qReveal != "" {
+
+			if err := r.SetQueryParam("reveal", qReveal); err != nil {
+				return err
+			}
+		}
+	}
+
+	// path param templateName
+	if err := r.SetPathParam("templateName", o.TemplateName); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,236 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/odpf/dex/generated/models"
+)
+
+// GetFirehoseTemplateReader is a Reader for the GetFirehoseTemplate structure.
+type GetFirehoseTemplateReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetFirehoseTemplateReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetFirehoseTemplateOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewGetFirehoseTemplateNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewGetFirehoseTemplateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewGetFirehoseTemplateOK creates a GetFirehoseTemplateOK with default headers values
+func NewGetFirehoseTemplateOK() *GetFirehoseTemplateOK {
+	return &GetFirehoseTemplateOK{}
+}
+
+/*
+GetFirehoseTemplateOK describes a response with status code 200, with default header values.
+
+Found template with given name.
+*/
+type GetFirehoseTemplateOK struct {
+	Payload *models.FirehoseTemplate
+}
+
+// IsSuccess returns true when this get firehose template o k response has a 2xx status code
+func (o *GetFirehoseTemplateOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get firehose template o k response has a 3xx status code
+func (o *GetFirehoseTemplateOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get firehose template o k response has a 4xx status code
+func (o *GetFirehoseTemplateOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get firehose template o k response has a 5xx status code
+func (o *GetFirehoseTemplateOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get firehose template o k response a status code equal to that given
+func (o *GetFirehoseTemplateOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *GetFirehoseTemplateOK) Error() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoseTemplates/{templateName}][%d] getFirehoseTemplateOK  %+v", 200, o.Payload)
+}
+
+func (o *GetFirehoseTemplateOK) String() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoseTemplates/{templateName}][%d] getFirehoseTemplateOK  %+v", 200, o.Payload)
+}
+
+func (o *GetFirehoseTemplateOK) GetPayload() *models.FirehoseTemplate {
+	return o.Payload
+}
+
+func (o *GetFirehoseTemplateOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.FirehoseTemplate)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetFirehoseTemplateNotFound creates a GetFirehoseTemplateNotFound with default headers values
+func NewGetFirehoseTemplateNotFound() *GetFirehoseTemplateNotFound {
+	return &GetFirehoseTemplateNotFound{}
+}
+
+/*
+GetFirehoseTemplateNotFound describes a response with status code 404, with default header values.
+
+Template with given name was not found.
+*/
+type GetFirehoseTemplateNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this get firehose template not found response has a 2xx status code
+func (o *GetFirehoseTemplateNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get firehose template not found response has a 3xx status code
+func (o *GetFirehoseTemplateNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get firehose template not found response has a 4xx status code
+func (o *GetFirehoseTemplateNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get firehose template not found response has a 5xx status code
+func (o *GetFirehoseTemplateNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get firehose template not found response a status code equal to that given
+func (o *GetFirehoseTemplateNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *GetFirehoseTemplateNotFound) Error() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoseTemplates/{templateName}][%d] getFirehoseTemplateNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetFirehoseTemplateNotFound) String() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoseTemplates/{templateName}][%d] getFirehoseTemplateNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetFirehoseTemplateNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetFirehoseTemplateNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetFirehoseTemplateInternalServerError creates a GetFirehoseTemplateInternalServerError with default headers values
+func NewGetFirehoseTemplateInternalServerError() *GetFirehoseTemplateInternalServerError {
+	return &GetFirehoseTemplateInternalServerError{}
+}
+
+/*
+GetFirehoseTemplateInternalServerError describes a response with status code 500, with default header values.
+
+internal error
+*/
+type GetFirehoseTemplateInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this get firehose template internal server error response has a 2xx status code
+func (o *GetFirehoseTemplateInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get firehose template internal server error response has a 3xx status code
+func (o *GetFirehoseTemplateInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get firehose template internal server error response has a 4xx status code
+func (o *GetFirehoseTemplateInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get firehose template internal server error response has a 5xx status code
+func (o *GetFirehoseTemplateInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this get firehose template internal server error response a status code equal to that given
+func (o *GetFirehoseTemplateInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *GetFirehoseTemplateInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoseTemplates/{templateName}][%d] getFirehoseTemplateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetFirehoseTemplateInternalServerError) String() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoseTemplates/{templateName}][%d] getFirehoseTemplateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetFirehoseTemplateInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetFirehoseTemplateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
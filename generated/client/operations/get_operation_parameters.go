@@ -0,0 +1,151 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetOperationParams creates a new GetOperationParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetOperationParams() *GetOperationParams {
+	return &GetOperationParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetOperationParamsWithTimeout creates a new GetOperationParams object
+// with the ability to set a timeout on a request.
+func NewGetOperationParamsWithTimeout(timeout time.Duration) *GetOperationParams {
+	return &GetOperationParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetOperationParamsWithContext creates a new GetOperationParams object
+// with the ability to set a context for a request.
+func NewGetOperationParamsWithContext(ctx context.Context) *GetOperationParams {
+	return &GetOperationParams{
+		Context: ctx,
+	}
+}
+
+// NewGetOperationParamsWithHTTPClient creates a new GetOperationParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetOperationParamsWithHTTPClient(client *http.Client) *GetOperationParams {
+	return &GetOperationParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetOperationParams contains all the parameters to send to the API endpoint
+
+	for the get operation operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetOperationParams struct {
+
+	/* OperationID.
+
+	   Identifier of the operation.
+	*/
+	OperationID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get operation params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetOperationParams) WithDefaults() *GetOperationParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get operation params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetOperationParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get operation params
+func (o *GetOperationParams) WithTimeout(timeout time.Duration) *GetOperationParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get operation params
+func (o *GetOperationParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get operation params
+func (o *GetOperationParams) WithContext(ctx context.Context) *GetOperationParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get operation params
+func (o *GetOperationParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get operation params
+func (o *GetOperationParams) WithHTTPClient(client *http.Client) *GetOperationParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get operation params
+func (o *GetOperationParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithOperationID adds the operationID to the get operation params
+func (o *GetOperationParams) WithOperationID(operationID string) *GetOperationParams {
+	o.SetOperationID(operationID)
+	return o
+}
+
+// SetOperationID adds the operationId to the get operation params
+func (o *GetOperationParams) SetOperationID(operationID string) {
+	o.OperationID = operationID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetOperationParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param operationId
+	if err := r.SetPathParam("operationId", o.OperationID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,305 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/odpf/dex/generated/models"
+)
+
+// GetOperationReader is a Reader for the GetOperation structure.
+type GetOperationReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetOperationReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetOperationOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 403:
+		result := NewGetOperationForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewGetOperationNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewGetOperationInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewGetOperationOK creates a GetOperationOK with default headers values
+func NewGetOperationOK() *GetOperationOK {
+	return &GetOperationOK{}
+}
+
+/*
+GetOperationOK describes a response with status code 200, with default header values.
+
+Found the operation.
+*/
+type GetOperationOK struct {
+	Payload *models.Operation
+}
+
+// IsSuccess returns true when this get operation o k response has a 2xx status code
+func (o *GetOperationOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get operation o k response has a 3xx status code
+func (o *GetOperationOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get operation o k response has a 4xx status code
+func (o *GetOperationOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get operation o k response has a 5xx status code
+func (o *GetOperationOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get operation o k response a status code equal to that given
+func (o *GetOperationOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *GetOperationOK) Error() string {
+	return fmt.Sprintf("[GET /operations/{operationId}][%d] getOperationOK  %+v", 200, o.Payload)
+}
+
+func (o *GetOperationOK) String() string {
+	return fmt.Sprintf("[GET /operations/{operationId}][%d] getOperationOK  %+v", 200, o.Payload)
+}
+
+func (o *GetOperationOK) GetPayload() *models.Operation {
+	return o.Payload
+}
+
+func (o *GetOperationOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Operation)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetOperationForbidden creates a GetOperationForbidden with default headers values
+func NewGetOperationForbidden() *GetOperationForbidden {
+	return &GetOperationForbidden{}
+}
+
+/*
+GetOperationForbidden describes a response with status code 403, with default header values.
+
+User does not have view permission on the project of the operation.
+*/
+type GetOperationForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this get operation forbidden response has a 2xx status code
+func (o *GetOperationForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get operation forbidden response has a 3xx status code
+func (o *GetOperationForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get operation forbidden response has a 4xx status code
+func (o *GetOperationForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get operation forbidden response has a 5xx status code
+func (o *GetOperationForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get operation forbidden response a status code equal to that given
+func (o *GetOperationForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *GetOperationForbidden) Error() string {
+	return fmt.Sprintf("[GET /operations/{operationId}][%d] getOperationForbidden  %+v", 403, o.Payload)
+}
+
+func (o *GetOperationForbidden) String() string {
+	return fmt.Sprintf("[GET /operations/{operationId}][%d] getOperationForbidden  %+v", 403, o.Payload)
+}
+
+func (o *GetOperationForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetOperationForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetOperationNotFound creates a GetOperationNotFound with default headers values
+func NewGetOperationNotFound() *GetOperationNotFound {
+	return &GetOperationNotFound{}
+}
+
+/*
+GetOperationNotFound describes a response with status code 404, with default header values.
+
+Operation with given id was not found
+*/
+type GetOperationNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this get operation not found response has a 2xx status code
+func (o *GetOperationNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get operation not found response has a 3xx status code
+func (o *GetOperationNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get operation not found response has a 4xx status code
+func (o *GetOperationNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get operation not found response has a 5xx status code
+func (o *GetOperationNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get operation not found response a status code equal to that given
+func (o *GetOperationNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *GetOperationNotFound) Error() string {
+	return fmt.Sprintf("[GET /operations/{operationId}][%d] getOperationNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetOperationNotFound) String() string {
+	return fmt.Sprintf("[GET /operations/{operationId}][%d] getOperationNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetOperationNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetOperationNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetOperationInternalServerError creates a GetOperationInternalServerError with default headers values
+func NewGetOperationInternalServerError() *GetOperationInternalServerError {
+	return &GetOperationInternalServerError{}
+}
+
+/*
+GetOperationInternalServerError describes a response with status code 500, with default header values.
+
+internal error
+*/
+type GetOperationInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this get operation internal server error response has a 2xx status code
+func (o *GetOperationInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get operation internal server error response has a 3xx status code
+func (o *GetOperationInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get operation internal server error response has a 4xx status code
+func (o *GetOperationInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get operation internal server error response has a 5xx status code
+func (o *GetOperationInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this get operation internal server error response a status code equal to that given
+func (o *GetOperationInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *GetOperationInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /operations/{operationId}][%d] getOperationInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetOperationInternalServerError) String() string {
+	return fmt.Sprintf("[GET /operations/{operationId}][%d] getOperationInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetOperationInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetOperationInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
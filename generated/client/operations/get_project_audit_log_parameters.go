@@ -0,0 +1,360 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetProjectAuditLogParams creates a new GetProjectAuditLogParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetProjectAuditLogParams() *GetProjectAuditLogParams {
+	return &GetProjectAuditLogParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetProjectAuditLogParamsWithTimeout creates a new GetProjectAuditLogParams object
+// with the ability to set a timeout on a request.
+func NewGetProjectAuditLogParamsWithTimeout(timeout time.Duration) *GetProjectAuditLogParams {
+	return &GetProjectAuditLogParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetProjectAuditLogParamsWithContext creates a new GetProjectAuditLogParams object
+// with the ability to set a context for a request.
+func NewGetProjectAuditLogParamsWithContext(ctx context.Context) *GetProjectAuditLogParams {
+	return &GetProjectAuditLogParams{
+		Context: ctx,
+	}
+}
+
+// NewGetProjectAuditLogParamsWithHTTPClient creates a new GetProjectAuditLogParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetProjectAuditLogParamsWithHTTPClient(client *http.Client) *GetProjectAuditLogParams {
+	return &GetProjectAuditLogParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetProjectAuditLogParams contains all the parameters to send to the API endpoint
+
+	for the get project audit log operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetProjectAuditLogParams struct {
+
+	/* Action.
+
+	   Return entries for the given action only (e.g., create, scale).
+	*/
+	Action *string
+
+	/* Actor.
+
+	   Return entries performed by the given actor only.
+	*/
+	Actor *string
+
+	/* From.
+
+	   Return entries recorded at or after this time.
+
+	   Format: date-time
+	*/
+	From *strfmt.DateTime
+
+	/* Limit.
+
+	   Maximum number of entries to return (default 100, max 1000).
+	*/
+	Limit *int64
+
+	/* ProjectSlug.
+
+	   Unique slug name of the project.
+	*/
+	ProjectSlug string
+
+	/* To.
+
+	   Return entries recorded at or before this time.
+
+	   Format: date-time
+	*/
+	To *strfmt.DateTime
+
+	/* Urn.
+
+	   Return entries for the given firehose URN only.
+	*/
+	Urn *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get project audit log params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetProjectAuditLogParams) WithDefaults() *GetProjectAuditLogParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get project audit log params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetProjectAuditLogParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get project audit log params
+func (o *GetProjectAuditLogParams) WithTimeout(timeout time.Duration) *GetProjectAuditLogParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get project audit log params
+func (o *GetProjectAuditLogParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get project audit log params
+func (o *GetProjectAuditLogParams) WithContext(ctx context.Context) *GetProjectAuditLogParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get project audit log params
+func (o *GetProjectAuditLogParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get project audit log params
+func (o *GetProjectAuditLogParams) WithHTTPClient(client *http.Client) *GetProjectAuditLogParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get project audit log params
+func (o *GetProjectAuditLogParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAction adds the action to the get project audit log params
+func (o *GetProjectAuditLogParams) WithAction(action *string) *GetProjectAuditLogParams {
+	o.SetAction(action)
+	return o
+}
+
+// SetAction adds the action to the get project audit log params
+func (o *GetProjectAuditLogParams) SetAction(action *string) {
+	o.Action = action
+}
+
+// WithActor adds the actor to the get project audit log params
+func (o *GetProjectAuditLogParams) WithActor(actor *string) *GetProjectAuditLogParams {
+	o.SetActor(actor)
+	return o
+}
+
+// SetActor adds the actor to the get project audit log params
+func (o *GetProjectAuditLogParams) SetActor(actor *string) {
+	o.Actor = actor
+}
+
+// WithFrom adds the from to the get project audit log params
+func (o *GetProjectAuditLogParams) WithFrom(from *strfmt.DateTime) *GetProjectAuditLogParams {
+	o.SetFrom(from)
+	return o
+}
+
+// SetFrom adds the from to the get project audit log params
+func (o *GetProjectAuditLogParams) SetFrom(from *strfmt.DateTime) {
+	o.From = from
+}
+
+// WithLimit adds the limit to the get project audit log params
+func (o *GetProjectAuditLogParams) WithLimit(limit *int64) *GetProjectAuditLogParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the get project audit log params
+func (o *GetProjectAuditLogParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithProjectSlug adds the projectSlug to the get project audit log params
+func (o *GetProjectAuditLogParams) WithProjectSlug(projectSlug string) *GetProjectAuditLogParams {
+	o.SetProjectSlug(projectSlug)
+	return o
+}
+
+// SetProjectSlug adds the projectSlug to the get project audit log params
+func (o *GetProjectAuditLogParams) SetProjectSlug(projectSlug string) {
+	o.ProjectSlug = projectSlug
+}
+
+// WithTo adds the to to the get project audit log params
+func (o *GetProjectAuditLogParams) WithTo(to *strfmt.DateTime) *GetProjectAuditLogParams {
+	o.SetTo(to)
+	return o
+}
+
+// SetTo adds the to to the get project audit log params
+func (o *GetProjectAuditLogParams) SetTo(to *strfmt.DateTime) {
+	o.To = to
+}
+
+// WithUrn adds the urn to the get project audit log params
+func (o *GetProjectAuditLogParams) WithUrn(urn *string) *GetProjectAuditLogParams {
+	o.SetUrn(urn)
+	return o
+}
+
+// SetUrn adds the urn to the get project audit log params
+func (o *GetProjectAuditLogParams) SetUrn(urn *string) {
+	o.Urn = urn
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetProjectAuditLogParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Action != nil {
+
+		// query param action
+		var qrAction string
+
+		if o.Action != nil {
+			qrAction = *o.Action
+		}
+		qAction := qrAction
+		if qAction != "" {
+
+			if err := r.SetQueryParam("action", qAction); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Actor != nil {
+
+		// query param actor
+		var qrActor string
+
+		if o.Actor != nil {
+			qrActor = *o.Actor
+		}
+		qActor := qrActor
+		if qActor != "" {
+
+			if err := r.SetQueryParam("actor", qActor); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.From != nil {
+
+		// query param from
+		var qrFrom strfmt.DateTime
+
+		if o.From != nil {
+			qrFrom = *o.From
+		}
+		qFrom := qrFrom.String()
+		if qFrom != "" {
+
+			if err := r.SetQueryParam("from", qFrom); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	// path param projectSlug
+	if err := r.SetPathParam("projectSlug", o.ProjectSlug); err != nil {
+		return err
+	}
+
+	if o.To != nil {
+
+		// query param to
+		var qrTo strfmt.DateTime
+
+		if o.To != nil {
+			qrTo = *o.To
+		}
+		qTo := qrTo.String()
+		if qTo != "" {
+
+			if err := r.SetQueryParam("to", qTo); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Urn != nil {
+
+		// query param urn
+		var qrUrn string
+
+		if o.Urn != nil {
+			qrUrn = *o.Urn
+		}
+		qUrn := qrUrn
+		if qUrn != "" {
+
+			if err := r.SetQueryParam("urn", qUrn); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
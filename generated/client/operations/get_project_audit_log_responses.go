@@ -0,0 +1,236 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/odpf/dex/generated/models"
+)
+
+// GetProjectAuditLogReader is a Reader for the GetProjectAuditLog structure.
+type GetProjectAuditLogReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetProjectAuditLogReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetProjectAuditLogOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewGetProjectAuditLogBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewGetProjectAuditLogInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewGetProjectAuditLogOK creates a GetProjectAuditLogOK with default headers values
+func NewGetProjectAuditLogOK() *GetProjectAuditLogOK {
+	return &GetProjectAuditLogOK{}
+}
+
+/*
+GetProjectAuditLogOK describes a response with status code 200, with default header values.
+
+Audit entries matching the filters.
+*/
+type GetProjectAuditLogOK struct {
+	Payload *models.AuditEntryArray
+}
+
+// IsSuccess returns true when this get project audit log o k response has a 2xx status code
+func (o *GetProjectAuditLogOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get project audit log o k response has a 3xx status code
+func (o *GetProjectAuditLogOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get project audit log o k response has a 4xx status code
+func (o *GetProjectAuditLogOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get project audit log o k response has a 5xx status code
+func (o *GetProjectAuditLogOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get project audit log o k response a status code equal to that given
+func (o *GetProjectAuditLogOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *GetProjectAuditLogOK) Error() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/audit][%d] getProjectAuditLogOK  %+v", 200, o.Payload)
+}
+
+func (o *GetProjectAuditLogOK) String() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/audit][%d] getProjectAuditLogOK  %+v", 200, o.Payload)
+}
+
+func (o *GetProjectAuditLogOK) GetPayload() *models.AuditEntryArray {
+	return o.Payload
+}
+
+func (o *GetProjectAuditLogOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.AuditEntryArray)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetProjectAuditLogBadRequest creates a GetProjectAuditLogBadRequest with default headers values
+func NewGetProjectAuditLogBadRequest() *GetProjectAuditLogBadRequest {
+	return &GetProjectAuditLogBadRequest{}
+}
+
+/*
+GetProjectAuditLogBadRequest describes a response with status code 400, with default header values.
+
+Invalid filters or audit log is not queryable.
+*/
+type GetProjectAuditLogBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this get project audit log bad request response has a 2xx status code
+func (o *GetProjectAuditLogBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get project audit log bad request response has a 3xx status code
+func (o *GetProjectAuditLogBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get project audit log bad request response has a 4xx status code
+func (o *GetProjectAuditLogBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get project audit log bad request response has a 5xx status code
+func (o *GetProjectAuditLogBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get project audit log bad request response a status code equal to that given
+func (o *GetProjectAuditLogBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *GetProjectAuditLogBadRequest) Error() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/audit][%d] getProjectAuditLogBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetProjectAuditLogBadRequest) String() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/audit][%d] getProjectAuditLogBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetProjectAuditLogBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetProjectAuditLogBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetProjectAuditLogInternalServerError creates a GetProjectAuditLogInternalServerError with default headers values
+func NewGetProjectAuditLogInternalServerError() *GetProjectAuditLogInternalServerError {
+	return &GetProjectAuditLogInternalServerError{}
+}
+
+/*
+GetProjectAuditLogInternalServerError describes a response with status code 500, with default header values.
+
+internal error
+*/
+type GetProjectAuditLogInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this get project audit log internal server error response has a 2xx status code
+func (o *GetProjectAuditLogInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get project audit log internal server error response has a 3xx status code
+func (o *GetProjectAuditLogInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get project audit log internal server error response has a 4xx status code
+func (o *GetProjectAuditLogInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get project audit log internal server error response has a 5xx status code
+func (o *GetProjectAuditLogInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this get project audit log internal server error response a status code equal to that given
+func (o *GetProjectAuditLogInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *GetProjectAuditLogInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/audit][%d] getProjectAuditLogInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetProjectAuditLogInternalServerError) String() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/audit][%d] getProjectAuditLogInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetProjectAuditLogInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetProjectAuditLogInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetSinkTypeSchemaParams creates a new GetSinkTypeSchemaParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetSinkTypeSchemaParams() *GetSinkTypeSchemaParams {
+	return &GetSinkTypeSchemaParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetSinkTypeSchemaParamsWithTimeout creates a new GetSinkTypeSchemaParams object
+// with the ability to set a timeout on a request.
+func NewGetSinkTypeSchemaParamsWithTimeout(timeout time.Duration) *GetSinkTypeSchemaParams {
+	return &GetSinkTypeSchemaParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetSinkTypeSchemaParamsWithContext creates a new GetSinkTypeSchemaParams object
+// with the ability to set a context for a request.
+func NewGetSinkTypeSchemaParamsWithContext(ctx context.Context) *GetSinkTypeSchemaParams {
+	return &GetSinkTypeSchemaParams{
+		Context: ctx,
+	}
+}
+
+// NewGetSinkTypeSchemaParamsWithHTTPClient creates a new GetSinkTypeSchemaParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetSinkTypeSchemaParamsWithHTTPClient(client *http.Client) *GetSinkTypeSchemaParams {
+	return &GetSinkTypeSchemaParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetSinkTypeSchemaParams contains all the parameters to send to the API endpoint
+
+	for the get sink type schema operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetSinkTypeSchemaParams struct {
+
+	/* SinkType.
+
+	   Firehose sink type (e.g., BIGQUERY).
+	*/
+	SinkType string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get sink type schema params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetSinkTypeSchemaParams) WithDefaults() *GetSinkTypeSchemaParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get sink type schema params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetSinkTypeSchemaParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get sink type schema params
+func (o *GetSinkTypeSchemaParams) WithTimeout(timeout time.Duration) *GetSinkTypeSchemaParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get sink type schema params
+func (o *GetSinkTypeSchemaParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get sink type schema params
+func (o *GetSinkTypeSchemaParams) WithContext(ctx context.Context) *GetSinkTypeSchemaParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get sink type schema params
+func (o *GetSinkTypeSchemaParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get sink type schema params
+func (o *GetSinkTypeSchemaParams) WithHTTPClient(client *http.Client) *GetSinkTypeSchemaParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get sink type schema params
+func (o *GetSinkTypeSchemaParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithSinkType adds the sinkType to the get sink type schema params
+func (o *GetSinkTypeSchemaParams) WithSinkType(sinkType string) *GetSinkTypeSchemaParams {
+	o.SetSinkType(sinkType)
+	return o
+}
+
+// SetSinkType adds the sinkType to the get sink type schema params
+func (o *GetSinkTypeSchemaParams) SetSinkType(sinkType string) {
+	o.SinkType = sinkType
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetSinkTypeSchemaParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param sinkType
+	if err := r.SetPathParam("sinkType", o.SinkType); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
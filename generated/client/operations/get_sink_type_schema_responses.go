@@ -0,0 +1,167 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/odpf/dex/generated/models"
+)
+
+// GetSinkTypeSchemaReader is a Reader for the GetSinkTypeSchema structure.
+type GetSinkTypeSchemaReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetSinkTypeSchemaReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetSinkTypeSchemaOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewGetSinkTypeSchemaNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewGetSinkTypeSchemaOK creates a GetSinkTypeSchemaOK with default headers values
+func NewGetSinkTypeSchemaOK() *GetSinkTypeSchemaOK {
+	return &GetSinkTypeSchemaOK{}
+}
+
+/*
+GetSinkTypeSchemaOK describes a response with status code 200, with default header values.
+
+Schema of the sink type.
+*/
+type GetSinkTypeSchemaOK struct {
+	Payload *models.SinkTypeSchema
+}
+
+// IsSuccess returns true when this get sink type schema o k response has a 2xx status code
+func (o *GetSinkTypeSchemaOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get sink type schema o k response has a 3xx status code
+func (o *GetSinkTypeSchemaOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get sink type schema o k response has a 4xx status code
+func (o *GetSinkTypeSchemaOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get sink type schema o k response has a 5xx status code
+func (o *GetSinkTypeSchemaOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get sink type schema o k response a status code equal to that given
+func (o *GetSinkTypeSchemaOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *GetSinkTypeSchemaOK) Error() string {
+	return fmt.Sprintf("[GET /sinkTypes/{sinkType}/schema][%d] getSinkTypeSchemaOK  %+v", 200, o.Payload)
+}
+
+func (o *GetSinkTypeSchemaOK) String() string {
+	return fmt.Sprintf("[GET /sinkTypes/{sinkType}/schema][%d] getSinkTypeSchemaOK  %+v", 200, o.Payload)
+}
+
+func (o *GetSinkTypeSchemaOK) GetPayload() *models.SinkTypeSchema {
+	return o.Payload
+}
+
+func (o *GetSinkTypeSchemaOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.SinkTypeSchema)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetSinkTypeSchemaNotFound creates a GetSinkTypeSchemaNotFound with default headers values
+func NewGetSinkTypeSchemaNotFound() *GetSinkTypeSchemaNotFound {
+	return &GetSinkTypeSchemaNotFound{}
+}
+
+/*
+GetSinkTypeSchemaNotFound describes a response with status code 404, with default header values.
+
+Sink type is not supported.
+*/
+type GetSinkTypeSchemaNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this get sink type schema not found response has a 2xx status code
+func (o *GetSinkTypeSchemaNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get sink type schema not found response has a 3xx status code
+func (o *GetSinkTypeSchemaNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get sink type schema not found response has a 4xx status code
+func (o *GetSinkTypeSchemaNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get sink type schema not found response has a 5xx status code
+func (o *GetSinkTypeSchemaNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get sink type schema not found response a status code equal to that given
+func (o *GetSinkTypeSchemaNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *GetSinkTypeSchemaNotFound) Error() string {
+	return fmt.Sprintf("[GET /sinkTypes/{sinkType}/schema][%d] getSinkTypeSchemaNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetSinkTypeSchemaNotFound) String() string {
+	return fmt.Sprintf("[GET /sinkTypes/{sinkType}/schema][%d] getSinkTypeSchemaNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetSinkTypeSchemaNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetSinkTypeSchemaNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
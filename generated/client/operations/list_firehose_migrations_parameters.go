@@ -0,0 +1,173 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListFirehoseMigrationsParams creates a new ListFirehoseMigrationsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewListFirehoseMigrationsParams() *ListFirehoseMigrationsParams {
+	return &ListFirehoseMigrationsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListFirehoseMigrationsParamsWithTimeout creates a new ListFirehoseMigrationsParams object
+// with the ability to set a timeout on a request.
+func NewListFirehoseMigrationsParamsWithTimeout(timeout time.Duration) *ListFirehoseMigrationsParams {
+	return &ListFirehoseMigrationsParams{
+		timeout: timeout,
+	}
+}
+
+// NewListFirehoseMigrationsParamsWithContext creates a new ListFirehoseMigrationsParams object
+// with the ability to set a context for a request.
+func NewListFirehoseMigrationsParamsWithContext(ctx context.Context) *ListFirehoseMigrationsParams {
+	return &ListFirehoseMigrationsParams{
+		Context: ctx,
+	}
+}
+
+// NewListFirehoseMigrationsParamsWithHTTPClient creates a new ListFirehoseMigrationsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewListFirehoseMigrationsParamsWithHTTPClient(client *http.Client) *ListFirehoseMigrationsParams {
+	return &ListFirehoseMigrationsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ListFirehoseMigrationsParams contains all the parameters to send to the API endpoint
+
+	for the list firehose migrations operation.
+
+	Typically these are written to a http.Request.
+*/
+type ListFirehoseMigrationsParams struct {
+
+	/* FirehoseUrn.
+
+	   URN of the firehose.
+	*/
+	FirehoseUrn string
+
+	/* ProjectSlug.
+
+	   Identifier for the project.
+	*/
+	ProjectSlug string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the list firehose migrations params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListFirehoseMigrationsParams) WithDefaults() *ListFirehoseMigrationsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the list firehose migrations params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListFirehoseMigrationsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the list firehose migrations params
+func (o *ListFirehoseMigrationsParams) WithTimeout(timeout time.Duration) *ListFirehoseMigrationsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list firehose migrations params
+func (o *ListFirehoseMigrationsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list firehose migrations params
+func (o *ListFirehoseMigrationsParams) WithContext(ctx context.Context) *ListFirehoseMigrationsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list firehose migrations params
+func (o *ListFirehoseMigrationsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list firehose migrations params
+func (o *ListFirehoseMigrationsParams) WithHTTPClient(client *http.Client) *ListFirehoseMigrationsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list firehose migrations params
+func (o *ListFirehoseMigrationsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithFirehoseUrn adds the firehoseUrn to the list firehose migrations params
+func (o *ListFirehoseMigrationsParams) WithFirehoseUrn(firehoseUrn string) *ListFirehoseMigrationsParams {
+	o.SetFirehoseUrn(firehoseUrn)
+	return o
+}
+
+// SetFirehoseUrn adds the firehoseUrn to the list firehose migrations params
+func (o *ListFirehoseMigrationsParams) SetFirehoseUrn(firehoseUrn string) {
+	o.FirehoseUrn = firehoseUrn
+}
+
+// WithProjectSlug adds the projectSlug to the list firehose migrations params
+func (o *ListFirehoseMigrationsParams) WithProjectSlug(projectSlug string) *ListFirehoseMigrationsParams {
+	o.SetProjectSlug(projectSlug)
+	return o
+}
+
+// SetProjectSlug adds the projectSlug to the list firehose migrations params
+func (o *ListFirehoseMigrationsParams) SetProjectSlug(projectSlug string) {
+	o.ProjectSlug = projectSlug
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListFirehoseMigrationsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param firehoseUrn
+	if err := r.SetPathParam("firehoseUrn", o.FirehoseUrn); err != nil {
+		return err
+	}
+
+	// path param projectSlug
+	if err := r.SetPathParam("projectSlug", o.ProjectSlug); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/odpf/dex/generated/models"
+)
+
+// ListFirehoseMigrationsReader is a Reader for the ListFirehoseMigrations structure.
+type ListFirehoseMigrationsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListFirehoseMigrationsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListFirehoseMigrationsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 500:
+		result := NewListFirehoseMigrationsInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewListFirehoseMigrationsOK creates a ListFirehoseMigrationsOK with default headers values
+func NewListFirehoseMigrationsOK() *ListFirehoseMigrationsOK {
+	return &ListFirehoseMigrationsOK{}
+}
+
+/*
+ListFirehoseMigrationsOK describes a response with status code 200, with default header values.
+
+Found migrations of the firehose.
+*/
+type ListFirehoseMigrationsOK struct {
+	Payload *models.FirehoseMigrationArray
+}
+
+// IsSuccess returns true when this list firehose migrations o k response has a 2xx status code
+func (o *ListFirehoseMigrationsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this list firehose migrations o k response has a 3xx status code
+func (o *ListFirehoseMigrationsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list firehose migrations o k response has a 4xx status code
+func (o *ListFirehoseMigrationsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list firehose migrations o k response has a 5xx status code
+func (o *ListFirehoseMigrationsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list firehose migrations o k response a status code equal to that given
+func (o *ListFirehoseMigrationsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *ListFirehoseMigrationsOK) Error() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/migrations][%d] listFirehoseMigrationsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListFirehoseMigrationsOK) String() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/migrations][%d] listFirehoseMigrationsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListFirehoseMigrationsOK) GetPayload() *models.FirehoseMigrationArray {
+	return o.Payload
+}
+
+func (o *ListFirehoseMigrationsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.FirehoseMigrationArray)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListFirehoseMigrationsInternalServerError creates a ListFirehoseMigrationsInternalServerError with default headers values
+func NewListFirehoseMigrationsInternalServerError() *ListFirehoseMigrationsInternalServerError {
+	return &ListFirehoseMigrationsInternalServerError{}
+}
+
+/*
+ListFirehoseMigrationsInternalServerError describes a response with status code 500, with default header values.
+
+internal error
+*/
+type ListFirehoseMigrationsInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this list firehose migrations internal server error response has a 2xx status code
+func (o *ListFirehoseMigrationsInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this list firehose migrations internal server error response has a 3xx status code
+func (o *ListFirehoseMigrationsInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list firehose migrations internal server error response has a 4xx status code
+func (o *ListFirehoseMigrationsInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list firehose migrations internal server error response has a 5xx status code
+func (o *ListFirehoseMigrationsInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this list firehose migrations internal server error response a status code equal to that given
+func (o *ListFirehoseMigrationsInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *ListFirehoseMigrationsInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/migrations][%d] listFirehoseMigrationsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ListFirehoseMigrationsInternalServerError) String() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/migrations][%d] listFirehoseMigrationsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ListFirehoseMigrationsInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ListFirehoseMigrationsInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,208 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewListFirehoseOperationsParams creates a new ListFirehoseOperationsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewListFirehoseOperationsParams() *ListFirehoseOperationsParams {
+	return &ListFirehoseOperationsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListFirehoseOperationsParamsWithTimeout creates a new ListFirehoseOperationsParams object
+// with the ability to set a timeout on a request.
+func NewListFirehoseOperationsParamsWithTimeout(timeout time.Duration) *ListFirehoseOperationsParams {
+	return &ListFirehoseOperationsParams{
+		timeout: timeout,
+	}
+}
+
+// NewListFirehoseOperationsParamsWithContext creates a new ListFirehoseOperationsParams object
+// with the ability to set a context for a request.
+func NewListFirehoseOperationsParamsWithContext(ctx context.Context) *ListFirehoseOperationsParams {
+	return &ListFirehoseOperationsParams{
+		Context: ctx,
+	}
+}
+
+// NewListFirehoseOperationsParamsWithHTTPClient creates a new ListFirehoseOperationsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewListFirehoseOperationsParamsWithHTTPClient(client *http.Client) *ListFirehoseOperationsParams {
+	return &ListFirehoseOperationsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ListFirehoseOperationsParams contains all the parameters to send to the API endpoint
+
+	for the list firehose operations operation.
+
+	Typically these are written to a http.Request.
+*/
+type ListFirehoseOperationsParams struct {
+
+	/* FirehoseUrn.
+
+	   URN of the firehose.
+	*/
+	FirehoseUrn string
+
+	/* Limit.
+
+	   Maximum number of operations to return (default 20, max 100).
+	*/
+	Limit *int64
+
+	/* ProjectSlug.
+
+	   Identifier for the project.
+	*/
+	ProjectSlug string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the list firehose operations params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListFirehoseOperationsParams) WithDefaults() *ListFirehoseOperationsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the list firehose operations params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListFirehoseOperationsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the list firehose operations params
+func (o *ListFirehoseOperationsParams) WithTimeout(timeout time.Duration) *ListFirehoseOperationsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list firehose operations params
+func (o *ListFirehoseOperationsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list firehose operations params
+func (o *ListFirehoseOperationsParams) WithContext(ctx context.Context) *ListFirehoseOperationsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list firehose operations params
+func (o *ListFirehoseOperationsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list firehose operations params
+func (o *ListFirehoseOperationsParams) WithHTTPClient(client *http.Client) *ListFirehoseOperationsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list firehose operations params
+func (o *ListFirehoseOperationsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithFirehoseUrn adds the firehoseUrn to the list firehose operations params
+func (o *ListFirehoseOperationsParams) WithFirehoseUrn(firehoseUrn string) *ListFirehoseOperationsParams {
+	o.SetFirehoseUrn(firehoseUrn)
+	return o
+}
+
+// SetFirehoseUrn adds the firehoseUrn to the list firehose operations params
+func (o *ListFirehoseOperationsParams) SetFirehoseUrn(firehoseUrn string) {
+	o.FirehoseUrn = firehoseUrn
+}
+
+// WithLimit adds the limit to the list firehose operations params
+func (o *ListFirehoseOperationsParams) WithLimit(limit *int64) *ListFirehoseOperationsParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the list firehose operations params
+func (o *ListFirehoseOperationsParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithProjectSlug adds the projectSlug to the list firehose operations params
+func (o *ListFirehoseOperationsParams) WithProjectSlug(projectSlug string) *ListFirehoseOperationsParams {
+	o.SetProjectSlug(projectSlug)
+	return o
+}
+
+// SetProjectSlug adds the projectSlug to the list firehose operations params
+func (o *ListFirehoseOperationsParams) SetProjectSlug(projectSlug string) {
+	o.ProjectSlug = projectSlug
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListFirehoseOperationsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param firehoseUrn
+	if err := r.SetPathParam("firehoseUrn", o.FirehoseUrn); err != nil {
+		return err
+	}
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	// path param projectSlug
+	if err := r.SetPathParam("projectSlug", o.ProjectSlug); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,236 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/odpf/dex/generated/models"
+)
+
+// ListFirehoseOperationsReader is a Reader for the ListFirehoseOperations structure.
+type ListFirehoseOperationsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListFirehoseOperationsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListFirehoseOperationsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewListFirehoseOperationsBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewListFirehoseOperationsInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewListFirehoseOperationsOK creates a ListFirehoseOperationsOK with default headers values
+func NewListFirehoseOperationsOK() *ListFirehoseOperationsOK {
+	return &ListFirehoseOperationsOK{}
+}
+
+/*
+ListFirehoseOperationsOK describes a response with status code 200, with default header values.
+
+Found operations of the firehose.
+*/
+type ListFirehoseOperationsOK struct {
+	Payload *models.OperationArray
+}
+
+// IsSuccess returns true when this list firehose operations o k response has a 2xx status code
+func (o *ListFirehoseOperationsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this list firehose operations o k response has a 3xx status code
+func (o *ListFirehoseOperationsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list firehose operations o k response has a 4xx status code
+func (o *ListFirehoseOperationsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list firehose operations o k response has a 5xx status code
+func (o *ListFirehoseOperationsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list firehose operations o k response a status code equal to that given
+func (o *ListFirehoseOperationsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *ListFirehoseOperationsOK) Error() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/operations][%d] listFirehoseOperationsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListFirehoseOperationsOK) String() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/operations][%d] listFirehoseOperationsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListFirehoseOperationsOK) GetPayload() *models.OperationArray {
+	return o.Payload
+}
+
+func (o *ListFirehoseOperationsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.OperationArray)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListFirehoseOperationsBadRequest creates a ListFirehoseOperationsBadRequest with default headers values
+func NewListFirehoseOperationsBadRequest() *ListFirehoseOperationsBadRequest {
+	return &ListFirehoseOperationsBadRequest{}
+}
+
+/*
+ListFirehoseOperationsBadRequest describes a response with status code 400, with default header values.
+
+Request was invalid.
+*/
+type ListFirehoseOperationsBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this list firehose operations bad request response has a 2xx status code
+func (o *ListFirehoseOperationsBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this list firehose operations bad request response has a 3xx status code
+func (o *ListFirehoseOperationsBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list firehose operations bad request response has a 4xx status code
+func (o *ListFirehoseOperationsBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this list firehose operations bad request response has a 5xx status code
+func (o *ListFirehoseOperationsBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list firehose operations bad request response a status code equal to that given
+func (o *ListFirehoseOperationsBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *ListFirehoseOperationsBadRequest) Error() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/operations][%d] listFirehoseOperationsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ListFirehoseOperationsBadRequest) String() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/operations][%d] listFirehoseOperationsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ListFirehoseOperationsBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ListFirehoseOperationsBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListFirehoseOperationsInternalServerError creates a ListFirehoseOperationsInternalServerError with default headers values
+func NewListFirehoseOperationsInternalServerError() *ListFirehoseOperationsInternalServerError {
+	return &ListFirehoseOperationsInternalServerError{}
+}
+
+/*
+ListFirehoseOperationsInternalServerError describes a response with status code 500, with default header values.
+
+internal error
+*/
+type ListFirehoseOperationsInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this list firehose operations internal server error response has a 2xx status code
+func (o *ListFirehoseOperationsInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this list firehose operations internal server error response has a 3xx status code
+func (o *ListFirehoseOperationsInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list firehose operations internal server error response has a 4xx status code
+func (o *ListFirehoseOperationsInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list firehose operations internal server error response has a 5xx status code
+func (o *ListFirehoseOperationsInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this list firehose operations internal server error response a status code equal to that given
+func (o *ListFirehoseOperationsInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *ListFirehoseOperationsInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/operations][%d] listFirehoseOperationsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ListFirehoseOperationsInternalServerError) String() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/operations][%d] listFirehoseOperationsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ListFirehoseOperationsInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ListFirehoseOperationsInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
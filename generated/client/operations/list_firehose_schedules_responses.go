@@ -0,0 +1,167 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/odpf/dex/generated/models"
+)
+
+// ListFirehoseSchedulesReader is a Reader for the ListFirehoseSchedules structure.
+type ListFirehoseSchedulesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListFirehoseSchedulesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListFirehoseSchedulesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 500:
+		result := NewListFirehoseSchedulesInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewListFirehoseSchedulesOK creates a ListFirehoseSchedulesOK with default headers values
+func NewListFirehoseSchedulesOK() *ListFirehoseSchedulesOK {
+	return &ListFirehoseSchedulesOK{}
+}
+
+/*
+ListFirehoseSchedulesOK describes a response with status code 200, with default header values.
+
+Found schedules of the firehose.
+*/
+type ListFirehoseSchedulesOK struct {
+	Payload *models.ScheduleArray
+}
+
+// IsSuccess returns true when this list firehose schedules o k response has a 2xx status code
+func (o *ListFirehoseSchedulesOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this list firehose schedules o k response has a 3xx status code
+func (o *ListFirehoseSchedulesOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list firehose schedules o k response has a 4xx status code
+func (o *ListFirehoseSchedulesOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list firehose schedules o k response has a 5xx status code
+func (o *ListFirehoseSchedulesOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list firehose schedules o k response a status code equal to that given
+func (o *ListFirehoseSchedulesOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *ListFirehoseSchedulesOK) Error() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/schedules][%d] listFirehoseSchedulesOK  %+v", 200, o.Payload)
+}
+
+func (o *ListFirehoseSchedulesOK) String() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/schedules][%d] listFirehoseSchedulesOK  %+v", 200, o.Payload)
+}
+
+func (o *ListFirehoseSchedulesOK) GetPayload() *models.ScheduleArray {
+	return o.Payload
+}
+
+func (o *ListFirehoseSchedulesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ScheduleArray)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListFirehoseSchedulesInternalServerError creates a ListFirehoseSchedulesInternalServerError with default headers values
+func NewListFirehoseSchedulesInternalServerError() *ListFirehoseSchedulesInternalServerError {
+	return &ListFirehoseSchedulesInternalServerError{}
+}
+
+/*
+ListFirehoseSchedulesInternalServerError describes a response with status code 500, with default header values.
+
+internal error
+*/
+type ListFirehoseSchedulesInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this list firehose schedules internal server error response has a 2xx status code
+func (o *ListFirehoseSchedulesInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this list firehose schedules internal server error response has a 3xx status code
+func (o *ListFirehoseSchedulesInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list firehose schedules internal server error response has a 4xx status code
+func (o *ListFirehoseSchedulesInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list firehose schedules internal server error response has a 5xx status code
+func (o *ListFirehoseSchedulesInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this list firehose schedules internal server error response a status code equal to that given
+func (o *ListFirehoseSchedulesInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *ListFirehoseSchedulesInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/schedules][%d] listFirehoseSchedulesInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ListFirehoseSchedulesInternalServerError) String() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/schedules][%d] listFirehoseSchedulesInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ListFirehoseSchedulesInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ListFirehoseSchedulesInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
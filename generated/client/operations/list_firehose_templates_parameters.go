@@ -0,0 +1,188 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewListFirehoseTemplatesParams creates a new ListFirehoseTemplatesParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewListFirehoseTemplatesParams() *ListFirehoseTemplatesParams {
+	return &ListFirehoseTemplatesParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListFirehoseTemplatesParamsWithTimeout creates a new ListFirehoseTemplatesParams object
+// with the ability to set a timeout on a request.
+func NewListFirehoseTemplatesParamsWithTimeout(timeout time.Duration) *ListFirehoseTemplatesParams {
+	return &ListFirehoseTemplatesParams{
+		timeout: timeout,
+	}
+}
+
+// NewListFirehoseTemplatesParamsWithContext creates a new ListFirehoseTemplatesParams object
+// with the ability to set a context for a request.
+func NewListFirehoseTemplatesParamsWithContext(ctx context.Context) *ListFirehoseTemplatesParams {
+	return &ListFirehoseTemplatesParams{
+		Context: ctx,
+	}
+}
+
+// NewListFirehoseTemplatesParamsWithHTTPClient creates a new ListFirehoseTemplatesParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewListFirehoseTemplatesParamsWithHTTPClient(client *http.Client) *ListFirehoseTemplatesParams {
+	return &ListFirehoseTemplatesParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ListFirehoseTemplatesParams contains all the parameters to send to the API endpoint
+
+	for the list firehose templates operation.
+
+	Typically these are written to a http.Request.
+*/
+type ListFirehoseTemplatesParams struct {
+
+	/* ProjectSlug.
+
+	   Unique slug name of the project.
+	*/
+	ProjectSlug string
+
+	/* Reveal.
+
+	     Return the actual values of the sensitive env vars instead of masking them.
+	Requires manage permission on the project.
+
+	*/
+	Reveal *bool
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the list firehose templates params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListFirehoseTemplatesParams) WithDefaults() *ListFirehoseTemplatesParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the list firehose templates params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListFirehoseTemplatesParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the list firehose templates params
+func (o *ListFirehoseTemplatesParams) WithTimeout(timeout time.Duration) *ListFirehoseTemplatesParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list firehose templates params
+func (o *ListFirehoseTemplatesParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list firehose templates params
+func (o *ListFirehoseTemplatesParams) WithContext(ctx context.Context) *ListFirehoseTemplatesParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list firehose templates params
+func (o *ListFirehoseTemplatesParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list firehose templates params
+func (o *ListFirehoseTemplatesParams) WithHTTPClient(client *http.Client) *ListFirehoseTemplatesParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list firehose templates params
+func (o *ListFirehoseTemplatesParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithProjectSlug adds the projectSlug to the list firehose templates params
+func (o *ListFirehoseTemplatesParams) WithProjectSlug(projectSlug string) *ListFirehoseTemplatesParams {
+	o.SetProjectSlug(projectSlug)
+	return o
+}
+
+// SetProjectSlug adds the projectSlug to the list firehose templates params
+func (o *ListFirehoseTemplatesParams) SetProjectSlug(projectSlug string) {
+	o.ProjectSlug = projectSlug
+}
+
+// WithReveal adds the reveal to the list firehose templates params
+func (o *ListFirehoseTemplatesParams) WithReveal(reveal *bool) *ListFirehoseTemplatesParams {
+	o.SetReveal(reveal)
+	return o
+}
+
+// SetReveal adds the reveal to the list firehose templates params
+func (o *ListFirehoseTemplatesParams) SetReveal(reveal *bool) {
+	o.Reveal = reveal
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListFirehoseTemplatesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param projectSlug
+	if err := r.SetPathParam("projectSlug", o.ProjectSlug); err != nil {
+		return err
+	}
+
+	if o.Reveal != nil {
+
+		// query param reveal
+		var qrReveal bool
+
+		if o.Reveal != nil {
+			qrReveal = *o.Reveal
+		}
+		qReveal := swag.FormatBool(qrReveal)
+		if qReveal != "" {
+
+			if err := r.SetQueryParam("reveal", qReveal); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
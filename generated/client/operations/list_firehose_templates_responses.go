@@ -0,0 +1,167 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/odpf/dex/generated/models"
+)
+
+// ListFirehoseTemplatesReader is a Reader for the ListFirehoseTemplates structure.
+type ListFirehoseTemplatesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListFirehoseTemplatesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListFirehoseTemplatesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 500:
+		result := NewListFirehoseTemplatesInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewListFirehoseTemplatesOK creates a ListFirehoseTemplatesOK with default headers values
+func NewListFirehoseTemplatesOK() *ListFirehoseTemplatesOK {
+	return &ListFirehoseTemplatesOK{}
+}
+
+/*
+ListFirehoseTemplatesOK describes a response with status code 200, with default header values.
+
+Templates of the project.
+*/
+type ListFirehoseTemplatesOK struct {
+	Payload *models.FirehoseTemplateArray
+}
+
+// IsSuccess returns true when this list firehose templates o k response has a 2xx status code
+func (o *ListFirehoseTemplatesOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this list firehose templates o k response has a 3xx status code
+func (o *ListFirehoseTemplatesOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list firehose templates o k response has a 4xx status code
+func (o *ListFirehoseTemplatesOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list firehose templates o k response has a 5xx status code
+func (o *ListFirehoseTemplatesOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list firehose templates o k response a status code equal to that given
+func (o *ListFirehoseTemplatesOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *ListFirehoseTemplatesOK) Error() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoseTemplates][%d] listFirehoseTemplatesOK  %+v", 200, o.Payload)
+}
+
+func (o *ListFirehoseTemplatesOK) String() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoseTemplates][%d] listFirehoseTemplatesOK  %+v", 200, o.Payload)
+}
+
+func (o *ListFirehoseTemplatesOK) GetPayload() *models.FirehoseTemplateArray {
+	return o.Payload
+}
+
+func (o *ListFirehoseTemplatesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.FirehoseTemplateArray)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListFirehoseTemplatesInternalServerError creates a ListFirehoseTemplatesInternalServerError with default headers values
+func NewListFirehoseTemplatesInternalServerError() *ListFirehoseTemplatesInternalServerError {
+	return &ListFirehoseTemplatesInternalServerError{}
+}
+
+/*
+ListFirehoseTemplatesInternalServerError describes a response with status code 500, with default header values.
+
+internal error
+*/
+type ListFirehoseTemplatesInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this list firehose templates internal server error response has a 2xx status code
+func (o *ListFirehoseTemplatesInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this list firehose templates internal server error response has a 3xx status code
+func (o *ListFirehoseTemplatesInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list firehose templates internal server error response has a 4xx status code
+func (o *ListFirehoseTemplatesInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list firehose templates internal server error response has a 5xx status code
+func (o *ListFirehoseTemplatesInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this list firehose templates internal server error response a status code equal to that given
+func (o *ListFirehoseTemplatesInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *ListFirehoseTemplatesInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoseTemplates][%d] listFirehoseTemplatesInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ListFirehoseTemplatesInternalServerError) String() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoseTemplates][%d] listFirehoseTemplatesInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ListFirehoseTemplatesInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ListFirehoseTemplatesInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
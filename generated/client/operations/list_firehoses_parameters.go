@@ -14,6 +14,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewListFirehosesParams creates a new ListFirehosesParams object,
@@ -61,6 +62,14 @@ ListFirehosesParams contains all the parameters to send to the API endpoint
 */
 type ListFirehosesParams struct {
 
+	/* Expand.
+
+	     Include the given fields in each firehose of the response. By default, only
+	the metadata of firehoses is returned.
+
+	*/
+	Expand []string
+
 	/* Group.
 
 	   Return firehoses belonging to only this group.
@@ -73,18 +82,46 @@ type ListFirehosesParams struct {
 	*/
 	KubeCluster *string
 
+	/* PageSize.
+
+	     Maximum number of firehoses to return. All firehoses are returned
+	when not set or 0.
+
+	*/
+	PageSize *int64
+
+	/* PageToken.
+
+	   Token from the `next_page_token` of previous response to fetch next page.
+	*/
+	PageToken *string
+
 	/* ProjectSlug.
 
 	   Unique identifier of the project.
 	*/
 	ProjectSlug string
 
+	/* Reveal.
+
+	     Return the actual values of the sensitive env vars instead of masking them.
+	Requires manage permission on the project.
+
+	*/
+	Reveal *bool
+
 	/* SinkType.
 
 	   Return firehoses with this sink type.
 	*/
 	SinkType *string
 
+	/* Sort.
+
+	   Sort by the given field. Prefix with '-' for descending order. Defaults to name.
+	*/
+	Sort *string
+
 	/* Status.
 
 	   Return firehoses only with this status.
@@ -158,6 +195,17 @@ func (o *ListFirehosesParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithExpand adds the expand to the list firehoses params
+func (o *ListFirehosesParams) WithExpand(expand []string) *ListFirehosesParams {
+	o.SetExpand(expand)
+	return o
+}
+
+// SetExpand adds the expand to the list firehoses params
+func (o *ListFirehosesParams) SetExpand(expand []string) {
+	o.Expand = expand
+}
+
 // WithGroup adds the group to the list firehoses params
 func (o *ListFirehosesParams) WithGroup(group *string) *ListFirehosesParams {
 	o.SetGroup(group)
@@ -180,6 +228,28 @@ func (o *ListFirehosesParams) SetKubeCluster(kubeCluster *string) {
 	o.KubeCluster = kubeCluster
 }
 
+// WithPageSize adds the pageSize to the list firehoses params
+func (o *ListFirehosesParams) WithPageSize(pageSize *int64) *ListFirehosesParams {
+	o.SetPageSize(pageSize)
+	return o
+}
+
+// SetPageSize adds the pageSize to the list firehoses params
+func (o *ListFirehosesParams) SetPageSize(pageSize *int64) {
+	o.PageSize = pageSize
+}
+
+// WithPageToken adds the pageToken to the list firehoses params
+func (o *ListFirehosesParams) WithPageToken(pageToken *string) *ListFirehosesParams {
+	o.SetPageToken(pageToken)
+	return o
+}
+
+// SetPageToken adds the pageToken to the list firehoses params
+func (o *ListFirehosesParams) SetPageToken(pageToken *string) {
+	o.PageToken = pageToken
+}
+
 // WithProjectSlug adds the projectSlug to the list firehoses params
 func (o *ListFirehosesParams) WithProjectSlug(projectSlug string) *ListFirehosesParams {
 	o.SetProjectSlug(projectSlug)
@@ -191,6 +261,17 @@ func (o *ListFirehosesParams) SetProjectSlug(projectSlug string) {
 	o.ProjectSlug = projectSlug
 }
 
+// WithReveal adds the reveal to the list firehoses params
+func (o *ListFirehosesParams) WithReveal(reveal *bool) *ListFirehosesParams {
+	o.SetReveal(reveal)
+	return o
+}
+
+// SetReveal adds the reveal to the list firehoses params
+func (o *ListFirehosesParams) SetReveal(reveal *bool) {
+	o.Reveal = reveal
+}
+
 // WithSinkType adds the sinkType to the list firehoses params
 func (o *ListFirehosesParams) WithSinkType(sinkType *string) *ListFirehosesParams {
 	o.SetSinkType(sinkType)
@@ -202,6 +283,17 @@ func (o *ListFirehosesParams) SetSinkType(sinkType *string) {
 	o.SinkType = sinkType
 }
 
+// WithSort adds the sort to the list firehoses params
+func (o *ListFirehosesParams) WithSort(sort *string) *ListFirehosesParams {
+	o.SetSort(sort)
+	return o
+}
+
+// SetSort adds the sort to the list firehoses params
+func (o *ListFirehosesParams) SetSort(sort *string) {
+	o.Sort = sort
+}
+
 // WithStatus adds the status to the list firehoses params
 func (o *ListFirehosesParams) WithStatus(status *string) *ListFirehosesParams {
 	o.SetStatus(status)
@@ -243,6 +335,17 @@ func (o *ListFirehosesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt
 	}
 	var res []error
 
+	if o.Expand != nil {
+
+		// binding items for expand
+		joinedExpand := o.bindParamExpand(reg)
+
+		// query array param expand
+		if err := r.SetQueryParam("expand", joinedExpand...); err != nil {
+			return err
+		}
+	}
+
 	if o.Group != nil {
 
 		// query param group
@@ -277,11 +380,62 @@ func (o *ListFirehosesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt
 		}
 	}
 
+	if o.PageSize != nil {
+
+		// query param page_size
+		var qrPageSize int64
+
+		if o.PageSize != nil {
+			qrPageSize = *o.PageSize
+		}
+		qPageSize := swag.FormatInt64(qrPageSize)
+		if qPageSize != "" {
+
+			if err := r.SetQueryParam("page_size", qPageSize); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.PageToken != nil {
+
+		// query param page_token
+		var qrPageToken string
+
+		if o.PageToken != nil {
+			qrPageToken = *o.PageToken
+		}
+		qPageToken := qrPageToken
+		if qPageToken != "" {
+
+			if err := r.SetQueryParam("page_token", qPageToken); err != nil {
+				return err
+			}
+		}
+	}
+
 	// path param projectSlug
 	if err := r.SetPathParam("projectSlug", o.ProjectSlug); err != nil {
 		return err
 	}
 
+	if o.Reveal != nil {
+
+		// query param reveal
+		var qrReveal bool
+
+		if o.Reveal != nil {
+			qrReveal = *o.Reveal
+		}
+		qReveal := swag.FormatBool(qrReveal)
+		if qReveal != "" {
+
+			if err := r.SetQueryParam("reveal", qReveal); err != nil {
+				return err
+			}
+		}
+	}
+
 	if o.SinkType != nil {
 
 		// query param sink_type
@@ -299,6 +453,23 @@ func (o *ListFirehosesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt
 		}
 	}
 
+	if o.Sort != nil {
+
+		// query param sort
+		var qrSort string
+
+		if o.Sort != nil {
+			qrSort = *o.Sort
+		}
+		qSort := qrSort
+		if qSort != "" {
+
+			if err := r.SetQueryParam("sort", qSort); err != nil {
+				return err
+			}
+		}
+	}
+
 	if o.Status != nil {
 
 		// query param status
@@ -355,3 +526,20 @@ func (o *ListFirehosesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt
 	}
 	return nil
 }
+
+// bindParamListFirehoses binds the parameter expand
+func (o *ListFirehosesParams) bindParamExpand(formats strfmt.Registry) []string {
+	expandIR := o.Expand
+
+	var expandIC []string
+	for _, expandIIR := range expandIR { // explode []string
+
+		expandIIV := expandIIR // string as string
+		expandIC = append(expandIC, expandIIV)
+	}
+
+	// items.CollectionFormat: "csv"
+	expandIS := swag.JoinByFormat(expandIC, "csv")
+
+	return expandIS
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewListKubernetesParams creates a new ListKubernetesParams object,
@@ -61,12 +62,32 @@ ListKubernetesParams contains all the parameters to send to the API endpoint
 */
 type ListKubernetesParams struct {
 
+	/* PageSize.
+
+	     Maximum number of clusters to return. All clusters are returned
+	when not set or 0.
+
+	*/
+	PageSize *int64
+
+	/* PageToken.
+
+	   Token from the `next_page_token` of previous response to fetch next page.
+	*/
+	PageToken *string
+
 	/* ProjectSlug.
 
 	   Unique identifier of the project.
 	*/
 	ProjectSlug string
 
+	/* Sort.
+
+	   Sort by the given field. Prefix with '-' for descending order. Defaults to name.
+	*/
+	Sort *string
+
 	/* Tag.
 
 	   Return kubernetes clusters with given tag.
@@ -126,6 +147,28 @@ func (o *ListKubernetesParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithPageSize adds the pageSize to the list kubernetes params
+func (o *ListKubernetesParams) WithPageSize(pageSize *int64) *ListKubernetesParams {
+	o.SetPageSize(pageSize)
+	return o
+}
+
+// SetPageSize adds the pageSize to the list kubernetes params
+func (o *ListKubernetesParams) SetPageSize(pageSize *int64) {
+	o.PageSize = pageSize
+}
+
+// WithPageToken adds the pageToken to the list kubernetes params
+func (o *ListKubernetesParams) WithPageToken(pageToken *string) *ListKubernetesParams {
+	o.SetPageToken(pageToken)
+	return o
+}
+
+// SetPageToken adds the pageToken to the list kubernetes params
+func (o *ListKubernetesParams) SetPageToken(pageToken *string) {
+	o.PageToken = pageToken
+}
+
 // WithProjectSlug adds the projectSlug to the list kubernetes params
 func (o *ListKubernetesParams) WithProjectSlug(projectSlug string) *ListKubernetesParams {
 	o.SetProjectSlug(projectSlug)
@@ -137,6 +180,17 @@ func (o *ListKubernetesParams) SetProjectSlug(projectSlug string) {
 	o.ProjectSlug = projectSlug
 }
 
+// WithSort adds the sort to the list kubernetes params
+func (o *ListKubernetesParams) WithSort(sort *string) *ListKubernetesParams {
+	o.SetSort(sort)
+	return o
+}
+
+// SetSort adds the sort to the list kubernetes params
+func (o *ListKubernetesParams) SetSort(sort *string) {
+	o.Sort = sort
+}
+
 // WithTag adds the tag to the list kubernetes params
 func (o *ListKubernetesParams) WithTag(tag *string) *ListKubernetesParams {
 	o.SetTag(tag)
@@ -156,11 +210,62 @@ func (o *ListKubernetesParams) WriteToRequest(r runtime.ClientRequest, reg strfm
 	}
 	var res []error
 
+	if o.PageSize != nil {
+
+		// query param page_size
+		var qrPageSize int64
+
+		if o.PageSize != nil {
+			qrPageSize = *o.PageSize
+		}
+		qPageSize := swag.FormatInt64(qrPageSize)
+		if qPageSize != "" {
+
+			if err := r.SetQueryParam("page_size", qPageSize); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.PageToken != nil {
+
+		// query param page_token
+		var qrPageToken string
+
+		if o.PageToken != nil {
+			qrPageToken = *o.PageToken
+		}
+		qPageToken := qrPageToken
+		if qPageToken != "" {
+
+			if err := r.SetQueryParam("page_token", qPageToken); err != nil {
+				return err
+			}
+		}
+	}
+
 	// path param projectSlug
 	if err := r.SetPathParam("projectSlug", o.ProjectSlug); err != nil {
 		return err
 	}
 
+	if o.Sort != nil {
+
+		// query param sort
+		var qrSort string
+
+		if o.Sort != nil {
+			qrSort = *o.Sort
+		}
+		qSort := qrSort
+		if qSort != "" {
+
+			if err := r.SetQueryParam("sort", qSort); err != nil {
+				return err
+			}
+		}
+	}
+
 	if o.Tag != nil {
 
 		// query param tag
@@ -14,6 +14,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewListProjectsParams creates a new ListProjectsParams object,
@@ -60,6 +61,27 @@ ListProjectsParams contains all the parameters to send to the API endpoint
 	Typically these are written to a http.Request.
 */
 type ListProjectsParams struct {
+
+	/* PageSize.
+
+	     Maximum number of projects to return. All projects are returned
+	when not set or 0.
+
+	*/
+	PageSize *int64
+
+	/* PageToken.
+
+	   Token from the `next_page_token` of previous response to fetch next page.
+	*/
+	PageToken *string
+
+	/* Sort.
+
+	   Sort by the given field. Prefix with '-' for descending order. Defaults to name.
+	*/
+	Sort *string
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -113,6 +135,39 @@ func (o *ListProjectsParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithPageSize adds the pageSize to the list projects params
+func (o *ListProjectsParams) WithPageSize(pageSize *int64) *ListProjectsParams {
+	o.SetPageSize(pageSize)
+	return o
+}
+
+// SetPageSize adds the pageSize to the list projects params
+func (o *ListProjectsParams) SetPageSize(pageSize *int64) {
+	o.PageSize = pageSize
+}
+
+// WithPageToken adds the pageToken to the list projects params
+func (o *ListProjectsParams) WithPageToken(pageToken *string) *ListProjectsParams {
+	o.SetPageToken(pageToken)
+	return o
+}
+
+// SetPageToken adds the pageToken to the list projects params
+func (o *ListProjectsParams) SetPageToken(pageToken *string) {
+	o.PageToken = pageToken
+}
+
+// WithSort adds the sort to the list projects params
+func (o *ListProjectsParams) WithSort(sort *string) *ListProjectsParams {
+	o.SetSort(sort)
+	return o
+}
+
+// SetSort adds the sort to the list projects params
+func (o *ListProjectsParams) SetSort(sort *string) {
+	o.Sort = sort
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *ListProjectsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -121,6 +176,57 @@ func (o *ListProjectsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.
 	}
 	var res []error
 
+	if o.PageSize != nil {
+
+		// query param page_size
+		var qrPageSize int64
+
+		if o.PageSize != nil {
+			qrPageSize = *o.PageSize
+		}
+		qPageSize := swag.FormatInt64(qrPageSize)
+		if qPageSize != "" {
+
+			if err := r.SetQueryParam("page_size", qPageSize); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.PageToken != nil {
+
+		// query param page_token
+		var qrPageToken string
+
+		if o.PageToken != nil {
+			qrPageToken = *o.PageToken
+		}
+		qPageToken := qrPageToken
+		if qPageToken != "" {
+
+			if err := r.SetQueryParam("page_token", qPageToken); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Sort != nil {
+
+		// query param sort
+		var qrSort string
+
+		if o.Sort != nil {
+			qrSort = *o.Sort
+		}
+		qSort := qrSort
+		if qSort != "" {
+
+			if err := r.SetQueryParam("sort", qSort); err != nil {
+				return err
+			}
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -0,0 +1,190 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewMigrateFirehoseParams creates a new MigrateFirehoseParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewMigrateFirehoseParams() *MigrateFirehoseParams {
+	return &MigrateFirehoseParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewMigrateFirehoseParamsWithTimeout creates a new MigrateFirehoseParams object
+// with the ability to set a timeout on a request.
+func NewMigrateFirehoseParamsWithTimeout(timeout time.Duration) *MigrateFirehoseParams {
+	return &MigrateFirehoseParams{
+		timeout: timeout,
+	}
+}
+
+// NewMigrateFirehoseParamsWithContext creates a new MigrateFirehoseParams object
+// with the ability to set a context for a request.
+func NewMigrateFirehoseParamsWithContext(ctx context.Context) *MigrateFirehoseParams {
+	return &MigrateFirehoseParams{
+		Context: ctx,
+	}
+}
+
+// NewMigrateFirehoseParamsWithHTTPClient creates a new MigrateFirehoseParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewMigrateFirehoseParamsWithHTTPClient(client *http.Client) *MigrateFirehoseParams {
+	return &MigrateFirehoseParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+MigrateFirehoseParams contains all the parameters to send to the API endpoint
+
+	for the migrate firehose operation.
+
+	Typically these are written to a http.Request.
+*/
+type MigrateFirehoseParams struct {
+
+	// Body.
+	Body MigrateFirehoseBody
+
+	/* FirehoseUrn.
+
+	   URN of the firehose.
+	*/
+	FirehoseUrn string
+
+	/* ProjectSlug.
+
+	   Identifier for the project.
+	*/
+	ProjectSlug string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the migrate firehose params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *MigrateFirehoseParams) WithDefaults() *MigrateFirehoseParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the migrate firehose params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *MigrateFirehoseParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the migrate firehose params
+func (o *MigrateFirehoseParams) WithTimeout(timeout time.Duration) *MigrateFirehoseParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the migrate firehose params
+func (o *MigrateFirehoseParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the migrate firehose params
+func (o *MigrateFirehoseParams) WithContext(ctx context.Context) *MigrateFirehoseParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the migrate firehose params
+func (o *MigrateFirehoseParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the migrate firehose params
+func (o *MigrateFirehoseParams) WithHTTPClient(client *http.Client) *MigrateFirehoseParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the migrate firehose params
+func (o *MigrateFirehoseParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the migrate firehose params
+func (o *MigrateFirehoseParams) WithBody(body MigrateFirehoseBody) *MigrateFirehoseParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the migrate firehose params
+func (o *MigrateFirehoseParams) SetBody(body MigrateFirehoseBody) {
+	o.Body = body
+}
+
+// WithFirehoseUrn adds the firehoseUrn to the migrate firehose params
+func (o *MigrateFirehoseParams) WithFirehoseUrn(firehoseUrn string) *MigrateFirehoseParams {
+	o.SetFirehoseUrn(firehoseUrn)
+	return o
+}
+
+// SetFirehoseUrn adds the firehoseUrn to the migrate firehose params
+func (o *MigrateFirehoseParams) SetFirehoseUrn(firehoseUrn string) {
+	o.FirehoseUrn = firehoseUrn
+}
+
+// WithProjectSlug adds the projectSlug to the migrate firehose params
+func (o *MigrateFirehoseParams) WithProjectSlug(projectSlug string) *MigrateFirehoseParams {
+	o.SetProjectSlug(projectSlug)
+	return o
+}
+
+// SetProjectSlug adds the projectSlug to the migrate firehose params
+func (o *MigrateFirehoseParams) SetProjectSlug(projectSlug string) {
+	o.ProjectSlug = projectSlug
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *MigrateFirehoseParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if err := r.SetBodyParam(o.Body); err != nil {
+		return err
+	}
+
+	// path param firehoseUrn
+	if err := r.SetPathParam("firehoseUrn", o.FirehoseUrn); err != nil {
+		return err
+	}
+
+	// path param projectSlug
+	if err := r.SetPathParam("projectSlug", o.ProjectSlug); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,447 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+
+	"github.com/odpf/dex/generated/models"
+)
+
+// MigrateFirehoseReader is a Reader for the MigrateFirehose structure.
+type MigrateFirehoseReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *MigrateFirehoseReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 202:
+		result := NewMigrateFirehoseAccepted()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewMigrateFirehoseBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewMigrateFirehoseNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewMigrateFirehoseConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewMigrateFirehoseInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewMigrateFirehoseAccepted creates a MigrateFirehoseAccepted with default headers values
+func NewMigrateFirehoseAccepted() *MigrateFirehoseAccepted {
+	return &MigrateFirehoseAccepted{}
+}
+
+/*
+MigrateFirehoseAccepted describes a response with status code 202, with default header values.
+
+Migration has been started.
+*/
+type MigrateFirehoseAccepted struct {
+
+	/* Id of the operation tracking the changes. Not set for dry-run requests.
+	 */
+	XOperationID string
+
+	Payload *models.FirehoseMigration
+}
+
+// IsSuccess returns true when this migrate firehose accepted response has a 2xx status code
+func (o *MigrateFirehoseAccepted) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this migrate firehose accepted response has a 3xx status code
+func (o *MigrateFirehoseAccepted) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this migrate firehose accepted response has a 4xx status code
+func (o *MigrateFirehoseAccepted) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this migrate firehose accepted response has a 5xx status code
+func (o *MigrateFirehoseAccepted) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this migrate firehose accepted response a status code equal to that given
+func (o *MigrateFirehoseAccepted) IsCode(code int) bool {
+	return code == 202
+}
+
+func (o *MigrateFirehoseAccepted) Error() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/migrate][%d] migrateFirehoseAccepted  %+v", 202, o.Payload)
+}
+
+func (o *MigrateFirehoseAccepted) String() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/migrate][%d] migrateFirehoseAccepted  %+v", 202, o.Payload)
+}
+
+func (o *MigrateFirehoseAccepted) GetPayload() *models.FirehoseMigration {
+	return o.Payload
+}
+
+func (o *MigrateFirehoseAccepted) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header X-Operation-Id
+	hdrXOperationID := response.GetHeader("X-Operation-Id")
+
+	if hdrXOperationID != "" {
+		o.XOperationID = hdrXOperationID
+	}
+
+	o.Payload = new(models.FirehoseMigration)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewMigrateFirehoseBadRequest creates a MigrateFirehoseBadRequest with default headers values
+func NewMigrateFirehoseBadRequest() *MigrateFirehoseBadRequest {
+	return &MigrateFirehoseBadRequest{}
+}
+
+/*
+MigrateFirehoseBadRequest describes a response with status code 400, with default header values.
+
+Request was invalid.
+*/
+type MigrateFirehoseBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this migrate firehose bad request response has a 2xx status code
+func (o *MigrateFirehoseBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this migrate firehose bad request response has a 3xx status code
+func (o *MigrateFirehoseBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this migrate firehose bad request response has a 4xx status code
+func (o *MigrateFirehoseBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this migrate firehose bad request response has a 5xx status code
+func (o *MigrateFirehoseBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this migrate firehose bad request response a status code equal to that given
+func (o *MigrateFirehoseBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *MigrateFirehoseBadRequest) Error() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/migrate][%d] migrateFirehoseBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *MigrateFirehoseBadRequest) String() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/migrate][%d] migrateFirehoseBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *MigrateFirehoseBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *MigrateFirehoseBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewMigrateFirehoseNotFound creates a MigrateFirehoseNotFound with default headers values
+func NewMigrateFirehoseNotFound() *MigrateFirehoseNotFound {
+	return &MigrateFirehoseNotFound{}
+}
+
+/*
+MigrateFirehoseNotFound describes a response with status code 404, with default header values.
+
+Firehose with given URN was not found
+*/
+type MigrateFirehoseNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this migrate firehose not found response has a 2xx status code
+func (o *MigrateFirehoseNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this migrate firehose not found response has a 3xx status code
+func (o *MigrateFirehoseNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this migrate firehose not found response has a 4xx status code
+func (o *MigrateFirehoseNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this migrate firehose not found response has a 5xx status code
+func (o *MigrateFirehoseNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this migrate firehose not found response a status code equal to that given
+func (o *MigrateFirehoseNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *MigrateFirehoseNotFound) Error() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/migrate][%d] migrateFirehoseNotFound  %+v", 404, o.Payload)
+}
+
+func (o *MigrateFirehoseNotFound) String() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/migrate][%d] migrateFirehoseNotFound  %+v", 404, o.Payload)
+}
+
+func (o *MigrateFirehoseNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *MigrateFirehoseNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewMigrateFirehoseConflict creates a MigrateFirehoseConflict with default headers values
+func NewMigrateFirehoseConflict() *MigrateFirehoseConflict {
+	return &MigrateFirehoseConflict{}
+}
+
+/*
+MigrateFirehoseConflict describes a response with status code 409, with default header values.
+
+Firehose has pending changes or a migration in progress.
+*/
+type MigrateFirehoseConflict struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this migrate firehose conflict response has a 2xx status code
+func (o *MigrateFirehoseConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this migrate firehose conflict response has a 3xx status code
+func (o *MigrateFirehoseConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this migrate firehose conflict response has a 4xx status code
+func (o *MigrateFirehoseConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this migrate firehose conflict response has a 5xx status code
+func (o *MigrateFirehoseConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this migrate firehose conflict response a status code equal to that given
+func (o *MigrateFirehoseConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+func (o *MigrateFirehoseConflict) Error() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/migrate][%d] migrateFirehoseConflict  %+v", 409, o.Payload)
+}
+
+func (o *MigrateFirehoseConflict) String() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/migrate][%d] migrateFirehoseConflict  %+v", 409, o.Payload)
+}
+
+func (o *MigrateFirehoseConflict) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *MigrateFirehoseConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewMigrateFirehoseInternalServerError creates a MigrateFirehoseInternalServerError with default headers values
+func NewMigrateFirehoseInternalServerError() *MigrateFirehoseInternalServerError {
+	return &MigrateFirehoseInternalServerError{}
+}
+
+/*
+MigrateFirehoseInternalServerError describes a response with status code 500, with default header values.
+
+internal error
+*/
+type MigrateFirehoseInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this migrate firehose internal server error response has a 2xx status code
+func (o *MigrateFirehoseInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this migrate firehose internal server error response has a 3xx status code
+func (o *MigrateFirehoseInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this migrate firehose internal server error response has a 4xx status code
+func (o *MigrateFirehoseInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this migrate firehose internal server error response has a 5xx status code
+func (o *MigrateFirehoseInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this migrate firehose internal server error response a status code equal to that given
+func (o *MigrateFirehoseInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *MigrateFirehoseInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/migrate][%d] migrateFirehoseInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *MigrateFirehoseInternalServerError) String() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/migrate][%d] migrateFirehoseInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *MigrateFirehoseInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *MigrateFirehoseInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*
+MigrateFirehoseBody migrate firehose body
+swagger:model MigrateFirehoseBody
+*/
+type MigrateFirehoseBody struct {
+
+	// URN of the kubernetes cluster to migrate to.
+	// Required: true
+	KubeCluster *string `json:"kube_cluster"`
+}
+
+// Validate validates this migrate firehose body
+func (o *MigrateFirehoseBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateKubeCluster(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *MigrateFirehoseBody) validateKubeCluster(formats strfmt.Registry) error {
+
+	if err := validate.Required("body"+"."+"kube_cluster", "body", o.KubeCluster); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this migrate firehose body based on context it is used
+func (o *MigrateFirehoseBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *MigrateFirehoseBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *MigrateFirehoseBody) UnmarshalBinary(b []byte) error {
+	var res MigrateFirehoseBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -31,7 +31,21 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
-	CreateFirehose(params *CreateFirehoseParams, opts ...ClientOption) (*CreateFirehoseCreated, error)
+	CloneFirehose(params *CloneFirehoseParams, opts ...ClientOption) (*CloneFirehoseOK, *CloneFirehoseCreated, error)
+
+	CreateFirehose(params *CreateFirehoseParams, opts ...ClientOption) (*CreateFirehoseOK, *CreateFirehoseCreated, error)
+
+	CreateFirehoseSchedule(params *CreateFirehoseScheduleParams, opts ...ClientOption) (*CreateFirehoseScheduleCreated, error)
+
+	CreateFirehoseTemplate(params *CreateFirehoseTemplateParams, opts ...ClientOption) (*CreateFirehoseTemplateCreated, error)
+
+	DeleteFirehose(params *DeleteFirehoseParams, opts ...ClientOption) (*DeleteFirehoseNoContent, error)
+
+	DeleteFirehoseAutoscalePolicy(params *DeleteFirehoseAutoscalePolicyParams, opts ...ClientOption) (*DeleteFirehoseAutoscalePolicyNoContent, error)
+
+	DeleteFirehoseSchedule(params *DeleteFirehoseScheduleParams, opts ...ClientOption) (*DeleteFirehoseScheduleNoContent, error)
+
+	DeleteFirehoseTemplate(params *DeleteFirehoseTemplateParams, opts ...ClientOption) (*DeleteFirehoseTemplateNoContent, error)
 
 	GetFirehose(params *GetFirehoseParams, opts ...ClientOption) (*GetFirehoseOK, error)
 
@@ -39,22 +53,54 @@ type ClientService interface {
 
 	GetFirehoseAlerts(params *GetFirehoseAlertsParams, opts ...ClientOption) (*GetFirehoseAlertsOK, error)
 
+	GetFirehoseAutoscalePolicy(params *GetFirehoseAutoscalePolicyParams, opts ...ClientOption) (*GetFirehoseAutoscalePolicyOK, error)
+
+	GetFirehoseConsumerLag(params *GetFirehoseConsumerLagParams, opts ...ClientOption) (*GetFirehoseConsumerLagOK, error)
+
+	GetFirehoseEvents(params *GetFirehoseEventsParams, writer io.Writer, opts ...ClientOption) (*GetFirehoseEventsOK, error)
+
 	GetFirehoseHistory(params *GetFirehoseHistoryParams, opts ...ClientOption) (*GetFirehoseHistoryOK, error)
 
 	GetFirehoseLogs(params *GetFirehoseLogsParams, writer io.Writer, opts ...ClientOption) (*GetFirehoseLogsOK, error)
 
+	GetFirehoseMigration(params *GetFirehoseMigrationParams, opts ...ClientOption) (*GetFirehoseMigrationOK, error)
+
+	GetFirehoseRevision(params *GetFirehoseRevisionParams, opts ...ClientOption) (*GetFirehoseRevisionOK, error)
+
+	GetFirehoseSchedule(params *GetFirehoseScheduleParams, opts ...ClientOption) (*GetFirehoseScheduleOK, error)
+
+	GetFirehoseTemplate(params *GetFirehoseTemplateParams, opts ...ClientOption) (*GetFirehoseTemplateOK, error)
+
+	GetOperation(params *GetOperationParams, opts ...ClientOption) (*GetOperationOK, error)
+
+	GetProjectAuditLog(params *GetProjectAuditLogParams, opts ...ClientOption) (*GetProjectAuditLogOK, error)
+
 	GetProjectBySlug(params *GetProjectBySlugParams, opts ...ClientOption) (*GetProjectBySlugOK, error)
 
+	GetSinkTypeSchema(params *GetSinkTypeSchemaParams, opts ...ClientOption) (*GetSinkTypeSchemaOK, error)
+
 	ListAlertTemplates(params *ListAlertTemplatesParams, opts ...ClientOption) (*ListAlertTemplatesOK, error)
 
+	ListFirehoseMigrations(params *ListFirehoseMigrationsParams, opts ...ClientOption) (*ListFirehoseMigrationsOK, error)
+
+	ListFirehoseOperations(params *ListFirehoseOperationsParams, opts ...ClientOption) (*ListFirehoseOperationsOK, error)
+
+	ListFirehoseSchedules(params *ListFirehoseSchedulesParams, opts ...ClientOption) (*ListFirehoseSchedulesOK, error)
+
+	ListFirehoseTemplates(params *ListFirehoseTemplatesParams, opts ...ClientOption) (*ListFirehoseTemplatesOK, error)
+
 	ListFirehoses(params *ListFirehosesParams, opts ...ClientOption) (*ListFirehosesOK, error)
 
 	ListKubernetes(params *ListKubernetesParams, opts ...ClientOption) (*ListKubernetesOK, error)
 
 	ListProjects(params *ListProjectsParams, opts ...ClientOption) (*ListProjectsOK, error)
 
+	MigrateFirehose(params *MigrateFirehoseParams, opts ...ClientOption) (*MigrateFirehoseAccepted, error)
+
 	ResetOffset(params *ResetOffsetParams, opts ...ClientOption) (*ResetOffsetOK, error)
 
+	RollbackFirehose(params *RollbackFirehoseParams, opts ...ClientOption) (*RollbackFirehoseOK, error)
+
 	ScaleFirehose(params *ScaleFirehoseParams, opts ...ClientOption) (*ScaleFirehoseOK, error)
 
 	StartFirehose(params *StartFirehoseParams, opts ...ClientOption) (*StartFirehoseOK, error)
@@ -63,32 +109,885 @@ type ClientService interface {
 
 	UpdateFirehose(params *UpdateFirehoseParams, opts ...ClientOption) (*UpdateFirehoseOK, error)
 
+	UpdateFirehoseSchedule(params *UpdateFirehoseScheduleParams, opts ...ClientOption) (*UpdateFirehoseScheduleOK, error)
+
+	UpdateFirehoseTemplate(params *UpdateFirehoseTemplateParams, opts ...ClientOption) (*UpdateFirehoseTemplateOK, error)
+
 	UpgradeFirehose(params *UpgradeFirehoseParams, opts ...ClientOption) (*UpgradeFirehoseOK, error)
 
 	UpsertFirehoseAlertPolicy(params *UpsertFirehoseAlertPolicyParams, opts ...ClientOption) (*UpsertFirehoseAlertPolicyOK, error)
 
+	UpsertFirehoseAutoscalePolicy(params *UpsertFirehoseAutoscalePolicyParams, opts ...ClientOption) (*UpsertFirehoseAutoscalePolicyOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
 /*
-CreateFirehose creates a new firehose
+	CloneFirehose clones a firehose
+
+	Create a new firehose with the configs of an existing firehose. The clone can be
+
+created in another project or kubernetes cluster.
+*/
+func (a *Client) CloneFirehose(params *CloneFirehoseParams, opts ...ClientOption) (*CloneFirehoseOK, *CloneFirehoseCreated, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCloneFirehoseParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "cloneFirehose",
+		Method:             "POST",
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/clone",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &CloneFirehoseReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, nil, err
+	}
+	switch value := result.(type) {
+	case *CloneFirehoseOK:
+		return value, nil, nil
+	case *CloneFirehoseCreated:
+		return nil, value, nil
+	}
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for operations: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+CreateFirehose creates a new firehose
+
+Create and deploy a new firehose as per the configurations in the body.
+*/
+func (a *Client) CreateFirehose(params *CreateFirehoseParams, opts ...ClientOption) (*CreateFirehoseOK, *CreateFirehoseCreated, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCreateFirehoseParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "createFirehose",
+		Method:             "POST",
+		PathPattern:        "/projects/{projectSlug}/firehoses",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &CreateFirehoseReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, nil, err
+	}
+	switch value := result.(type) {
+	case *CreateFirehoseOK:
+		return value, nil, nil
+	case *CreateFirehoseCreated:
+		return nil, value, nil
+	}
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for operations: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+	CreateFirehoseSchedule creates a schedule for a firehose
+
+	Apply an action on the firehose at the times given by a cron expression. Runs are
+
+dispatched on behalf of the user who created the schedule and are recorded in the
+history and the operations of the firehose.
+*/
+func (a *Client) CreateFirehoseSchedule(params *CreateFirehoseScheduleParams, opts ...ClientOption) (*CreateFirehoseScheduleCreated, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCreateFirehoseScheduleParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "createFirehoseSchedule",
+		Method:             "POST",
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/schedules",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &CreateFirehoseScheduleReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*CreateFirehoseScheduleCreated)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for createFirehoseSchedule: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+CreateFirehoseTemplate creates a new firehose template
+*/
+func (a *Client) CreateFirehoseTemplate(params *CreateFirehoseTemplateParams, opts ...ClientOption) (*CreateFirehoseTemplateCreated, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCreateFirehoseTemplateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "createFirehoseTemplate",
+		Method:             "POST",
+		PathPattern:        "/projects/{projectSlug}/firehoseTemplates",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &CreateFirehoseTemplateReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*CreateFirehoseTemplateCreated)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for createFirehoseTemplate: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+DeleteFirehose deletes a firehose
+
+Delete the firehose with given URN.
+*/
+func (a *Client) DeleteFirehose(params *DeleteFirehoseParams, opts ...ClientOption) (*DeleteFirehoseNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteFirehoseParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "deleteFirehose",
+		Method:             "DELETE",
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DeleteFirehoseReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeleteFirehoseNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for deleteFirehose: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+DeleteFirehoseAutoscalePolicy deletes autoscale policy of a firehose
+*/
+func (a *Client) DeleteFirehoseAutoscalePolicy(params *DeleteFirehoseAutoscalePolicyParams, opts ...ClientOption) (*DeleteFirehoseAutoscalePolicyNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteFirehoseAutoscalePolicyParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "deleteFirehoseAutoscalePolicy",
+		Method:             "DELETE",
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/autoscalePolicy",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DeleteFirehoseAutoscalePolicyReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeleteFirehoseAutoscalePolicyNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for deleteFirehoseAutoscalePolicy: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+DeleteFirehoseSchedule deletes a schedule of a firehose
+*/
+func (a *Client) DeleteFirehoseSchedule(params *DeleteFirehoseScheduleParams, opts ...ClientOption) (*DeleteFirehoseScheduleNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteFirehoseScheduleParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "deleteFirehoseSchedule",
+		Method:             "DELETE",
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/schedules/{scheduleId}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DeleteFirehoseScheduleReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeleteFirehoseScheduleNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for deleteFirehoseSchedule: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+DeleteFirehoseTemplate deletes firehose template
+*/
+func (a *Client) DeleteFirehoseTemplate(params *DeleteFirehoseTemplateParams, opts ...ClientOption) (*DeleteFirehoseTemplateNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteFirehoseTemplateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "deleteFirehoseTemplate",
+		Method:             "DELETE",
+		PathPattern:        "/projects/{projectSlug}/firehoseTemplates/{templateName}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DeleteFirehoseTemplateReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeleteFirehoseTemplateNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for deleteFirehoseTemplate: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GetFirehose gets firehose by u r n
+
+Get firehose by URN.
+*/
+func (a *Client) GetFirehose(params *GetFirehoseParams, opts ...ClientOption) (*GetFirehoseOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetFirehoseParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getFirehose",
+		Method:             "GET",
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetFirehoseReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetFirehoseOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for getFirehose: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GetFirehoseAlertPolicy alerts policy for a firehose
+
+Alert policy for a Firehose.
+*/
+func (a *Client) GetFirehoseAlertPolicy(params *GetFirehoseAlertPolicyParams, opts ...ClientOption) (*GetFirehoseAlertPolicyOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetFirehoseAlertPolicyParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getFirehoseAlertPolicy",
+		Method:             "GET",
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/alertPolicy",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetFirehoseAlertPolicyReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetFirehoseAlertPolicyOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for getFirehoseAlertPolicy: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GetFirehoseAlerts triggereds alerts for a firehose
+
+Triggered alerts for a Firehose.
+*/
+func (a *Client) GetFirehoseAlerts(params *GetFirehoseAlertsParams, opts ...ClientOption) (*GetFirehoseAlertsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetFirehoseAlertsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getFirehoseAlerts",
+		Method:             "GET",
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/alerts",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetFirehoseAlertsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetFirehoseAlertsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for getFirehoseAlerts: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GetFirehoseAutoscalePolicy autoscales policy of a firehose
+
+Autoscale policy of a firehose with its most recent decisions.
+*/
+func (a *Client) GetFirehoseAutoscalePolicy(params *GetFirehoseAutoscalePolicyParams, opts ...ClientOption) (*GetFirehoseAutoscalePolicyOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetFirehoseAutoscalePolicyParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getFirehoseAutoscalePolicy",
+		Method:             "GET",
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/autoscalePolicy",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetFirehoseAutoscalePolicyReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetFirehoseAutoscalePolicyOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for getFirehoseAutoscalePolicy: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+	GetFirehoseConsumerLag consumers lag of a firehose
+
+	Committed offset, log-end offset and lag of the consumer group of the firehose for each
+
+partition of its topic, read from the source Kafka cluster.
+*/
+func (a *Client) GetFirehoseConsumerLag(params *GetFirehoseConsumerLagParams, opts ...ClientOption) (*GetFirehoseConsumerLagOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetFirehoseConsumerLagParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getFirehoseConsumerLag",
+		Method:             "GET",
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/consumerLag",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetFirehoseConsumerLagReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetFirehoseConsumerLagOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for getFirehoseConsumerLag: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+	GetFirehoseEvents streams state changes of a firehose
+
+	Stream state, status and output changes of a Firehose as server-sent events.
+
+A `state` event is sent with the current state on connect and whenever it
+changes. A `deleted` event is sent and the stream is closed when the firehose
+is deleted.
+*/
+func (a *Client) GetFirehoseEvents(params *GetFirehoseEventsParams, writer io.Writer, opts ...ClientOption) (*GetFirehoseEventsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetFirehoseEventsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getFirehoseEvents",
+		Method:             "GET",
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/events",
+		ProducesMediaTypes: []string{"text/event-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetFirehoseEventsReader{formats: a.formats, writer: writer},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetFirehoseEventsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for getFirehoseEvents: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+	GetFirehoseHistory histories for a firehose
+
+	Revisions of the firehose, oldest first, each with the difference from the previous
+
+revision. Revisions are numbered from 1 and the numbers are retained when filtered.
+*/
+func (a *Client) GetFirehoseHistory(params *GetFirehoseHistoryParams, opts ...ClientOption) (*GetFirehoseHistoryOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetFirehoseHistoryParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getFirehoseHistory",
+		Method:             "GET",
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/history",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetFirehoseHistoryReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetFirehoseHistoryOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for getFirehoseHistory: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GetFirehoseLogs streams logs for a firehose
+
+Stream logs for a Firehose.
+*/
+func (a *Client) GetFirehoseLogs(params *GetFirehoseLogsParams, writer io.Writer, opts ...ClientOption) (*GetFirehoseLogsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetFirehoseLogsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getFirehoseLogs",
+		Method:             "GET",
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/logs",
+		ProducesMediaTypes: []string{"application/gzip", "application/x-ndjson", "text/plain"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetFirehoseLogsReader{formats: a.formats, writer: writer},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetFirehoseLogsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for getFirehoseLogs: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GetFirehoseMigration gets a migration of a firehose
+
+Get a migration of a firehose with the status of each of its steps.
+*/
+func (a *Client) GetFirehoseMigration(params *GetFirehoseMigrationParams, opts ...ClientOption) (*GetFirehoseMigrationOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetFirehoseMigrationParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getFirehoseMigration",
+		Method:             "GET",
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/migrations/{migrationId}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetFirehoseMigrationReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetFirehoseMigrationOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for getFirehoseMigration: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GetFirehoseRevision gets a revision of a firehose
+
+Full spec of the firehose at the given revision.
+*/
+func (a *Client) GetFirehoseRevision(params *GetFirehoseRevisionParams, opts ...ClientOption) (*GetFirehoseRevisionOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetFirehoseRevisionParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getFirehoseRevision",
+		Method:             "GET",
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/history/{revision}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetFirehoseRevisionReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetFirehoseRevisionOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for getFirehoseRevision: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GetFirehoseSchedule gets a schedule of a firehose
+
+Get a schedule of a firehose with its most recent runs.
+*/
+func (a *Client) GetFirehoseSchedule(params *GetFirehoseScheduleParams, opts ...ClientOption) (*GetFirehoseScheduleOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetFirehoseScheduleParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getFirehoseSchedule",
+		Method:             "GET",
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/schedules/{scheduleId}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetFirehoseScheduleReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetFirehoseScheduleOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for getFirehoseSchedule: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GetFirehoseTemplate gets firehose template by name
+*/
+func (a *Client) GetFirehoseTemplate(params *GetFirehoseTemplateParams, opts ...ClientOption) (*GetFirehoseTemplateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetFirehoseTemplateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getFirehoseTemplate",
+		Method:             "GET",
+		PathPattern:        "/projects/{projectSlug}/firehoseTemplates/{templateName}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetFirehoseTemplateReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetFirehoseTemplateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for getFirehoseTemplate: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+	GetOperation gets an operation
+
+	Get a mutating request on a firehose with the state transitions of the firehose
+
+observed until the changes were applied.
+*/
+func (a *Client) GetOperation(params *GetOperationParams, opts ...ClientOption) (*GetOperationOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetOperationParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getOperation",
+		Method:             "GET",
+		PathPattern:        "/operations/{operationId}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetOperationReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetOperationOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for getOperation: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GetProjectAuditLog audits log of a project
 
-Create and deploy a new firehose as per the configurations in the body.
+Mutating firehose operations performed in the project, most recent first.
 */
-func (a *Client) CreateFirehose(params *CreateFirehoseParams, opts ...ClientOption) (*CreateFirehoseCreated, error) {
+func (a *Client) GetProjectAuditLog(params *GetProjectAuditLogParams, opts ...ClientOption) (*GetProjectAuditLogOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewCreateFirehoseParams()
+		params = NewGetProjectAuditLogParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "createFirehose",
-		Method:             "POST",
-		PathPattern:        "/projects/{projectSlug}/firehoses",
+		ID:                 "getProjectAuditLog",
+		Method:             "GET",
+		PathPattern:        "/projects/{projectSlug}/audit",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http", "https"},
 		Params:             params,
-		Reader:             &CreateFirehoseReader{formats: a.formats},
+		Reader:             &GetProjectAuditLogReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -100,35 +999,35 @@ func (a *Client) CreateFirehose(params *CreateFirehoseParams, opts ...ClientOpti
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*CreateFirehoseCreated)
+	success, ok := result.(*GetProjectAuditLogOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
 	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
-	msg := fmt.Sprintf("unexpected success response for createFirehose: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	msg := fmt.Sprintf("unexpected success response for getProjectAuditLog: API contract not enforced by server. Client expected to get an error, but got: %T", result)
 	panic(msg)
 }
 
 /*
-GetFirehose gets firehose by u r n
+GetProjectBySlug gets project by slug
 
-Get firehose by URN.
+Get project by its unique slug name.
 */
-func (a *Client) GetFirehose(params *GetFirehoseParams, opts ...ClientOption) (*GetFirehoseOK, error) {
+func (a *Client) GetProjectBySlug(params *GetProjectBySlugParams, opts ...ClientOption) (*GetProjectBySlugOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewGetFirehoseParams()
+		params = NewGetProjectBySlugParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "getFirehose",
+		ID:                 "getProjectBySlug",
 		Method:             "GET",
-		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}",
+		PathPattern:        "/projects/{slug}",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http", "https"},
 		Params:             params,
-		Reader:             &GetFirehoseReader{formats: a.formats},
+		Reader:             &GetProjectBySlugReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -140,35 +1039,35 @@ func (a *Client) GetFirehose(params *GetFirehoseParams, opts ...ClientOption) (*
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*GetFirehoseOK)
+	success, ok := result.(*GetProjectBySlugOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
 	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
-	msg := fmt.Sprintf("unexpected success response for getFirehose: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	msg := fmt.Sprintf("unexpected success response for getProjectBySlug: API contract not enforced by server. Client expected to get an error, but got: %T", result)
 	panic(msg)
 }
 
 /*
-GetFirehoseAlertPolicy alerts policy for a firehose
+GetSinkTypeSchema schemas of the sink specific env vars
 
-Alert policy for a Firehose.
+Env vars supported by the sink type along with their types and allowed values.
 */
-func (a *Client) GetFirehoseAlertPolicy(params *GetFirehoseAlertPolicyParams, opts ...ClientOption) (*GetFirehoseAlertPolicyOK, error) {
+func (a *Client) GetSinkTypeSchema(params *GetSinkTypeSchemaParams, opts ...ClientOption) (*GetSinkTypeSchemaOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewGetFirehoseAlertPolicyParams()
+		params = NewGetSinkTypeSchemaParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "getFirehoseAlertPolicy",
+		ID:                 "getSinkTypeSchema",
 		Method:             "GET",
-		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/alertPolicy",
+		PathPattern:        "/sinkTypes/{sinkType}/schema",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http", "https"},
 		Params:             params,
-		Reader:             &GetFirehoseAlertPolicyReader{formats: a.formats},
+		Reader:             &GetSinkTypeSchemaReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -180,35 +1079,35 @@ func (a *Client) GetFirehoseAlertPolicy(params *GetFirehoseAlertPolicyParams, op
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*GetFirehoseAlertPolicyOK)
+	success, ok := result.(*GetSinkTypeSchemaOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
 	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
-	msg := fmt.Sprintf("unexpected success response for getFirehoseAlertPolicy: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	msg := fmt.Sprintf("unexpected success response for getSinkTypeSchema: API contract not enforced by server. Client expected to get an error, but got: %T", result)
 	panic(msg)
 }
 
 /*
-GetFirehoseAlerts triggereds alerts for a firehose
+ListAlertTemplates gets list of alert templates for firehose
 
-Triggered alerts for a Firehose.
+Get list of alert templates for firehose.
 */
-func (a *Client) GetFirehoseAlerts(params *GetFirehoseAlertsParams, opts ...ClientOption) (*GetFirehoseAlertsOK, error) {
+func (a *Client) ListAlertTemplates(params *ListAlertTemplatesParams, opts ...ClientOption) (*ListAlertTemplatesOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewGetFirehoseAlertsParams()
+		params = NewListAlertTemplatesParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "getFirehoseAlerts",
+		ID:                 "listAlertTemplates",
 		Method:             "GET",
-		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/alerts",
+		PathPattern:        "/alertTemplates",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http", "https"},
 		Params:             params,
-		Reader:             &GetFirehoseAlertsReader{formats: a.formats},
+		Reader:             &ListAlertTemplatesReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -220,35 +1119,35 @@ func (a *Client) GetFirehoseAlerts(params *GetFirehoseAlertsParams, opts ...Clie
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*GetFirehoseAlertsOK)
+	success, ok := result.(*ListAlertTemplatesOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
 	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
-	msg := fmt.Sprintf("unexpected success response for getFirehoseAlerts: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	msg := fmt.Sprintf("unexpected success response for listAlertTemplates: API contract not enforced by server. Client expected to get an error, but got: %T", result)
 	panic(msg)
 }
 
 /*
-GetFirehoseHistory histories for a firehose
+ListFirehoseMigrations lists migrations of a firehose
 
-History for a Firehose.
+List migrations of a firehose, latest first.
 */
-func (a *Client) GetFirehoseHistory(params *GetFirehoseHistoryParams, opts ...ClientOption) (*GetFirehoseHistoryOK, error) {
+func (a *Client) ListFirehoseMigrations(params *ListFirehoseMigrationsParams, opts ...ClientOption) (*ListFirehoseMigrationsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewGetFirehoseHistoryParams()
+		params = NewListFirehoseMigrationsParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "getFirehoseHistory",
+		ID:                 "listFirehoseMigrations",
 		Method:             "GET",
-		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/history",
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/migrations",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http", "https"},
 		Params:             params,
-		Reader:             &GetFirehoseHistoryReader{formats: a.formats},
+		Reader:             &ListFirehoseMigrationsReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -260,35 +1159,35 @@ func (a *Client) GetFirehoseHistory(params *GetFirehoseHistoryParams, opts ...Cl
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*GetFirehoseHistoryOK)
+	success, ok := result.(*ListFirehoseMigrationsOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
 	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
-	msg := fmt.Sprintf("unexpected success response for getFirehoseHistory: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	msg := fmt.Sprintf("unexpected success response for listFirehoseMigrations: API contract not enforced by server. Client expected to get an error, but got: %T", result)
 	panic(msg)
 }
 
 /*
-GetFirehoseLogs streams logs for a firehose
+ListFirehoseOperations lists operations of a firehose
 
-Stream logs for a Firehose.
+Mutating requests on the firehose and their progress, most recent first.
 */
-func (a *Client) GetFirehoseLogs(params *GetFirehoseLogsParams, writer io.Writer, opts ...ClientOption) (*GetFirehoseLogsOK, error) {
+func (a *Client) ListFirehoseOperations(params *ListFirehoseOperationsParams, opts ...ClientOption) (*ListFirehoseOperationsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewGetFirehoseLogsParams()
+		params = NewListFirehoseOperationsParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "getFirehoseLogs",
+		ID:                 "listFirehoseOperations",
 		Method:             "GET",
-		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/logs",
-		ProducesMediaTypes: []string{"application/x-ndjson", "text/plain"},
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/operations",
+		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http", "https"},
 		Params:             params,
-		Reader:             &GetFirehoseLogsReader{formats: a.formats, writer: writer},
+		Reader:             &ListFirehoseOperationsReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -300,35 +1199,35 @@ func (a *Client) GetFirehoseLogs(params *GetFirehoseLogsParams, writer io.Writer
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*GetFirehoseLogsOK)
+	success, ok := result.(*ListFirehoseOperationsOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
 	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
-	msg := fmt.Sprintf("unexpected success response for getFirehoseLogs: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	msg := fmt.Sprintf("unexpected success response for listFirehoseOperations: API contract not enforced by server. Client expected to get an error, but got: %T", result)
 	panic(msg)
 }
 
 /*
-GetProjectBySlug gets project by slug
+ListFirehoseSchedules lists schedules of a firehose
 
-Get project by its unique slug name.
+Scheduled actions of the firehose, oldest first.
 */
-func (a *Client) GetProjectBySlug(params *GetProjectBySlugParams, opts ...ClientOption) (*GetProjectBySlugOK, error) {
+func (a *Client) ListFirehoseSchedules(params *ListFirehoseSchedulesParams, opts ...ClientOption) (*ListFirehoseSchedulesOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewGetProjectBySlugParams()
+		params = NewListFirehoseSchedulesParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "getProjectBySlug",
+		ID:                 "listFirehoseSchedules",
 		Method:             "GET",
-		PathPattern:        "/projects/{slug}",
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/schedules",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http", "https"},
 		Params:             params,
-		Reader:             &GetProjectBySlugReader{formats: a.formats},
+		Reader:             &ListFirehoseSchedulesReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -340,35 +1239,33 @@ func (a *Client) GetProjectBySlug(params *GetProjectBySlugParams, opts ...Client
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*GetProjectBySlugOK)
+	success, ok := result.(*ListFirehoseSchedulesOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
 	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
-	msg := fmt.Sprintf("unexpected success response for getProjectBySlug: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	msg := fmt.Sprintf("unexpected success response for listFirehoseSchedules: API contract not enforced by server. Client expected to get an error, but got: %T", result)
 	panic(msg)
 }
 
 /*
-ListAlertTemplates gets list of alert templates for firehose
-
-Get list of alert templates for firehose.
+ListFirehoseTemplates lists firehose templates of the project
 */
-func (a *Client) ListAlertTemplates(params *ListAlertTemplatesParams, opts ...ClientOption) (*ListAlertTemplatesOK, error) {
+func (a *Client) ListFirehoseTemplates(params *ListFirehoseTemplatesParams, opts ...ClientOption) (*ListFirehoseTemplatesOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewListAlertTemplatesParams()
+		params = NewListFirehoseTemplatesParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "listAlertTemplates",
+		ID:                 "listFirehoseTemplates",
 		Method:             "GET",
-		PathPattern:        "/alertTemplates",
+		PathPattern:        "/projects/{projectSlug}/firehoseTemplates",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http", "https"},
 		Params:             params,
-		Reader:             &ListAlertTemplatesReader{formats: a.formats},
+		Reader:             &ListFirehoseTemplatesReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -380,13 +1277,13 @@ func (a *Client) ListAlertTemplates(params *ListAlertTemplatesParams, opts ...Cl
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*ListAlertTemplatesOK)
+	success, ok := result.(*ListFirehoseTemplatesOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
 	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
-	msg := fmt.Sprintf("unexpected success response for listAlertTemplates: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	msg := fmt.Sprintf("unexpected success response for listFirehoseTemplates: API contract not enforced by server. Client expected to get an error, but got: %T", result)
 	panic(msg)
 }
 
@@ -510,6 +1407,50 @@ func (a *Client) ListProjects(params *ListProjectsParams, opts ...ClientOption)
 	panic(msg)
 }
 
+/*
+	MigrateFirehose migrates a firehose to another kubernetes cluster
+
+	Stop the firehose, record the offsets committed by its consumer group, recreate
+
+it on the target cluster with the same configs (and consumer group) and start it.
+The migration runs in the background and can be tracked using its id. If any of
+the steps fails, the firehose is restored on the original cluster.
+*/
+func (a *Client) MigrateFirehose(params *MigrateFirehoseParams, opts ...ClientOption) (*MigrateFirehoseAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewMigrateFirehoseParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "migrateFirehose",
+		Method:             "POST",
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/migrate",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &MigrateFirehoseReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*MigrateFirehoseAccepted)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for migrateFirehose: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 ResetOffset resets firehose consumption offset
 
@@ -550,6 +1491,51 @@ func (a *Client) ResetOffset(params *ResetOffsetParams, opts ...ClientOption) (*
 	panic(msg)
 }
 
+/*
+	RollbackFirehose rollbacks a firehose to an earlier revision
+
+	Update the firehose with the description and configs of an earlier revision. The
+
+revision can be selected by its number (1 being the oldest, as in history) or by a
+timestamp, in which case the latest revision created at or before it is used.
+Secret references of the revision are resolved again. The new revision is recorded
+with the reason 'rollback to revision N'.
+*/
+func (a *Client) RollbackFirehose(params *RollbackFirehoseParams, opts ...ClientOption) (*RollbackFirehoseOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRollbackFirehoseParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "rollbackFirehose",
+		Method:             "POST",
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/rollback",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &RollbackFirehoseReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RollbackFirehoseOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for rollbackFirehose: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 ScaleFirehose scales the number of instances of firehose
 
@@ -710,6 +1696,84 @@ func (a *Client) UpdateFirehose(params *UpdateFirehoseParams, opts ...ClientOpti
 	panic(msg)
 }
 
+/*
+UpdateFirehoseSchedule updates a schedule of a firehose
+
+Replace the cron expression, action, params and the enabled flag of the schedule.
+*/
+func (a *Client) UpdateFirehoseSchedule(params *UpdateFirehoseScheduleParams, opts ...ClientOption) (*UpdateFirehoseScheduleOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUpdateFirehoseScheduleParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "updateFirehoseSchedule",
+		Method:             "PUT",
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/schedules/{scheduleId}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &UpdateFirehoseScheduleReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*UpdateFirehoseScheduleOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for updateFirehoseSchedule: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+UpdateFirehoseTemplate updates firehose template
+*/
+func (a *Client) UpdateFirehoseTemplate(params *UpdateFirehoseTemplateParams, opts ...ClientOption) (*UpdateFirehoseTemplateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUpdateFirehoseTemplateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "updateFirehoseTemplate",
+		Method:             "PUT",
+		PathPattern:        "/projects/{projectSlug}/firehoseTemplates/{templateName}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &UpdateFirehoseTemplateReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*UpdateFirehoseTemplateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for updateFirehoseTemplate: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 UpgradeFirehose upgrades the firehose to the latest version supported
 
@@ -790,6 +1854,48 @@ func (a *Client) UpsertFirehoseAlertPolicy(params *UpsertFirehoseAlertPolicyPara
 	panic(msg)
 }
 
+/*
+	UpsertFirehoseAutoscalePolicy upserts autoscale policy of a firehose
+
+	Create or replace the autoscale policy of a firehose. The firehose is scaled between
+
+the min and max replicas to keep the consumer lag close to the target lag.
+*/
+func (a *Client) UpsertFirehoseAutoscalePolicy(params *UpsertFirehoseAutoscalePolicyParams, opts ...ClientOption) (*UpsertFirehoseAutoscalePolicyOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUpsertFirehoseAutoscalePolicyParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "upsertFirehoseAutoscalePolicy",
+		Method:             "PUT",
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/autoscalePolicy",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &UpsertFirehoseAutoscalePolicyReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*UpsertFirehoseAutoscalePolicyOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for upsertFirehoseAutoscalePolicy: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
@@ -68,6 +68,11 @@ ResetOffsetOK describes a response with status code 200, with default header val
 Found firehose with given URN
 */
 type ResetOffsetOK struct {
+
+	/* Id of the operation tracking the changes. Not set for dry-run requests.
+	 */
+	XOperationID string
+
 	Payload *models.Firehose
 }
 
@@ -110,6 +115,13 @@ func (o *ResetOffsetOK) GetPayload() *models.Firehose {
 
 func (o *ResetOffsetOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header X-Operation-Id
+	hdrXOperationID := response.GetHeader("X-Operation-Id")
+
+	if hdrXOperationID != "" {
+		o.XOperationID = hdrXOperationID
+	}
+
 	o.Payload = new(models.Firehose)
 
 	// response payload
@@ -0,0 +1,261 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewRollbackFirehoseParams creates a new RollbackFirehoseParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewRollbackFirehoseParams() *RollbackFirehoseParams {
+	return &RollbackFirehoseParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewRollbackFirehoseParamsWithTimeout creates a new RollbackFirehoseParams object
+// with the ability to set a timeout on a request.
+func NewRollbackFirehoseParamsWithTimeout(timeout time.Duration) *RollbackFirehoseParams {
+	return &RollbackFirehoseParams{
+		timeout: timeout,
+	}
+}
+
+// NewRollbackFirehoseParamsWithContext creates a new RollbackFirehoseParams object
+// with the ability to set a context for a request.
+func NewRollbackFirehoseParamsWithContext(ctx context.Context) *RollbackFirehoseParams {
+	return &RollbackFirehoseParams{
+		Context: ctx,
+	}
+}
+
+// NewRollbackFirehoseParamsWithHTTPClient creates a new RollbackFirehoseParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewRollbackFirehoseParamsWithHTTPClient(client *http.Client) *RollbackFirehoseParams {
+	return &RollbackFirehoseParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+RollbackFirehoseParams contains all the parameters to send to the API endpoint
+
+	for the rollback firehose operation.
+
+	Typically these are written to a http.Request.
+*/
+type RollbackFirehoseParams struct {
+
+	// Body.
+	Body RollbackFirehoseBody
+
+	/* DryRun.
+
+	   Only validate the request and return the changes that would be applied.
+	*/
+	DryRun *bool
+
+	/* FirehoseUrn.
+
+	   URN of the firehose.
+	*/
+	FirehoseUrn string
+
+	/* ProjectSlug.
+
+	   Unique slug name of the project.
+	*/
+	ProjectSlug string
+
+	/* Reveal.
+
+	     Return the actual values of the sensitive env vars instead of masking them.
+	Requires manage permission on the project.
+
+	*/
+	Reveal *bool
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the rollback firehose params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *RollbackFirehoseParams) WithDefaults() *RollbackFirehoseParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the rollback firehose params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *RollbackFirehoseParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the rollback firehose params
+func (o *RollbackFirehoseParams) WithTimeout(timeout time.Duration) *RollbackFirehoseParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the rollback firehose params
+func (o *RollbackFirehoseParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the rollback firehose params
+func (o *RollbackFirehoseParams) WithContext(ctx context.Context) *RollbackFirehoseParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the rollback firehose params
+func (o *RollbackFirehoseParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the rollback firehose params
+func (o *RollbackFirehoseParams) WithHTTPClient(client *http.Client) *RollbackFirehoseParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the rollback firehose params
+func (o *RollbackFirehoseParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the rollback firehose params
+func (o *RollbackFirehoseParams) WithBody(body RollbackFirehoseBody) *RollbackFirehoseParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the rollback firehose params
+func (o *RollbackFirehoseParams) SetBody(body RollbackFirehoseBody) {
+	o.Body = body
+}
+
+// WithDryRun adds the dryRun to the rollback firehose params
+func (o *RollbackFirehoseParams) WithDryRun(dryRun *bool) *RollbackFirehoseParams {
+	o.SetDryRun(dryRun)
+	return o
+}
+
+// SetDryRun adds the dryRun to the rollback firehose params
+func (o *RollbackFirehoseParams) SetDryRun(dryRun *bool) {
+	o.DryRun = dryRun
+}
+
+// WithFirehoseUrn adds the firehoseUrn to the rollback firehose params
+func (o *RollbackFirehoseParams) WithFirehoseUrn(firehoseUrn string) *RollbackFirehoseParams {
+	o.SetFirehoseUrn(firehoseUrn)
+	return o
+}
+
+// SetFirehoseUrn adds the firehoseUrn to the rollback firehose params
+func (o *RollbackFirehoseParams) SetFirehoseUrn(firehoseUrn string) {
+	o.FirehoseUrn = firehoseUrn
+}
+
+// WithProjectSlug adds the projectSlug to the rollback firehose params
+func (o *RollbackFirehoseParams) WithProjectSlug(projectSlug string) *RollbackFirehoseParams {
+	o.SetProjectSlug(projectSlug)
+	return o
+}
+
+// SetProjectSlug adds the projectSlug to the rollback firehose params
+func (o *RollbackFirehoseParams) SetProjectSlug(projectSlug string) {
+	o.ProjectSlug = projectSlug
+}
+
+// WithReveal adds the reveal to the rollback firehose params
+func (o *RollbackFirehoseParams) WithReveal(reveal *bool) *RollbackFirehoseParams {
+	o.SetReveal(reveal)
+	return o
+}
+
+// SetReveal adds the reveal to the rollback firehose params
+func (o *RollbackFirehoseParams) SetReveal(reveal *bool) {
+	o.Reveal = reveal
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *RollbackFirehoseParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if err := r.SetBodyParam(o.Body); err != nil {
+		return err
+	}
+
+	if o.DryRun != nil {
+
+		// query param dry_run
+		var qrDryRun bool
+
+		if o.DryRun != nil {
+			qrDryRun = *o.DryRun
+		}
+		qDryRun := swag.FormatBool(qrDryRun)
+		if qDryRun != "" {
+
+			if err := r.SetQueryParam("dry_run", qDryRun); err != nil {
+				return err
+			}
+		}
+	}
+
+	// path param firehoseUrn
+	if err := r.SetPathParam("firehoseUrn", o.FirehoseUrn); err != nil {
+		return err
+	}
+
+	// path param projectSlug
+	if err := r.SetPathParam("projectSlug", o.ProjectSlug); err != nil {
+		return err
+	}
+
+	if o.Reveal != nil {
+
+		// query param reveal
+		var qrReveal bool
+
+		if o.Reveal != nil {
+			qrReveal = *o.Reveal
+		}
+		qReveal := swag.FormatBool(qrReveal)
+		if qReveal != "" {
+
+			if err := r.SetQueryParam("reveal", qReveal); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
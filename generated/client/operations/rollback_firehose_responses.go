@@ -0,0 +1,384 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+
+	"github.com/odpf/dex/generated/models"
+)
+
+// RollbackFirehoseReader is a Reader for the RollbackFirehose structure.
+type RollbackFirehoseReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *RollbackFirehoseReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewRollbackFirehoseOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewRollbackFirehoseBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewRollbackFirehoseNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewRollbackFirehoseInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewRollbackFirehoseOK creates a RollbackFirehoseOK with default headers values
+func NewRollbackFirehoseOK() *RollbackFirehoseOK {
+	return &RollbackFirehoseOK{}
+}
+
+/*
+RollbackFirehoseOK describes a response with status code 200, with default header values.
+
+Successfully rolled back the firehose (or the preview for dry-run requests).
+*/
+type RollbackFirehoseOK struct {
+
+	/* Id of the operation tracking the changes. Not set for dry-run requests.
+	 */
+	XOperationID string
+
+	Payload *models.FirehoseRollback
+}
+
+// IsSuccess returns true when this rollback firehose o k response has a 2xx status code
+func (o *RollbackFirehoseOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this rollback firehose o k response has a 3xx status code
+func (o *RollbackFirehoseOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this rollback firehose o k response has a 4xx status code
+func (o *RollbackFirehoseOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this rollback firehose o k response has a 5xx status code
+func (o *RollbackFirehoseOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this rollback firehose o k response a status code equal to that given
+func (o *RollbackFirehoseOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *RollbackFirehoseOK) Error() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/rollback][%d] rollbackFirehoseOK  %+v", 200, o.Payload)
+}
+
+func (o *RollbackFirehoseOK) String() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/rollback][%d] rollbackFirehoseOK  %+v", 200, o.Payload)
+}
+
+func (o *RollbackFirehoseOK) GetPayload() *models.FirehoseRollback {
+	return o.Payload
+}
+
+func (o *RollbackFirehoseOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header X-Operation-Id
+	hdrXOperationID := response.GetHeader("X-Operation-Id")
+
+	if hdrXOperationID != "" {
+		o.XOperationID = hdrXOperationID
+	}
+
+	o.Payload = new(models.FirehoseRollback)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRollbackFirehoseBadRequest creates a RollbackFirehoseBadRequest with default headers values
+func NewRollbackFirehoseBadRequest() *RollbackFirehoseBadRequest {
+	return &RollbackFirehoseBadRequest{}
+}
+
+/*
+RollbackFirehoseBadRequest describes a response with status code 400, with default header values.
+
+Request was invalid.
+*/
+type RollbackFirehoseBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this rollback firehose bad request response has a 2xx status code
+func (o *RollbackFirehoseBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this rollback firehose bad request response has a 3xx status code
+func (o *RollbackFirehoseBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this rollback firehose bad request response has a 4xx status code
+func (o *RollbackFirehoseBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this rollback firehose bad request response has a 5xx status code
+func (o *RollbackFirehoseBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this rollback firehose bad request response a status code equal to that given
+func (o *RollbackFirehoseBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *RollbackFirehoseBadRequest) Error() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/rollback][%d] rollbackFirehoseBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *RollbackFirehoseBadRequest) String() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/rollback][%d] rollbackFirehoseBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *RollbackFirehoseBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *RollbackFirehoseBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRollbackFirehoseNotFound creates a RollbackFirehoseNotFound with default headers values
+func NewRollbackFirehoseNotFound() *RollbackFirehoseNotFound {
+	return &RollbackFirehoseNotFound{}
+}
+
+/*
+RollbackFirehoseNotFound describes a response with status code 404, with default header values.
+
+Firehose or the revision was not found
+*/
+type RollbackFirehoseNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this rollback firehose not found response has a 2xx status code
+func (o *RollbackFirehoseNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this rollback firehose not found response has a 3xx status code
+func (o *RollbackFirehoseNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this rollback firehose not found response has a 4xx status code
+func (o *RollbackFirehoseNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this rollback firehose not found response has a 5xx status code
+func (o *RollbackFirehoseNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this rollback firehose not found response a status code equal to that given
+func (o *RollbackFirehoseNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *RollbackFirehoseNotFound) Error() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/rollback][%d] rollbackFirehoseNotFound  %+v", 404, o.Payload)
+}
+
+func (o *RollbackFirehoseNotFound) String() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/rollback][%d] rollbackFirehoseNotFound  %+v", 404, o.Payload)
+}
+
+func (o *RollbackFirehoseNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *RollbackFirehoseNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRollbackFirehoseInternalServerError creates a RollbackFirehoseInternalServerError with default headers values
+func NewRollbackFirehoseInternalServerError() *RollbackFirehoseInternalServerError {
+	return &RollbackFirehoseInternalServerError{}
+}
+
+/*
+RollbackFirehoseInternalServerError describes a response with status code 500, with default header values.
+
+internal error
+*/
+type RollbackFirehoseInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this rollback firehose internal server error response has a 2xx status code
+func (o *RollbackFirehoseInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this rollback firehose internal server error response has a 3xx status code
+func (o *RollbackFirehoseInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this rollback firehose internal server error response has a 4xx status code
+func (o *RollbackFirehoseInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this rollback firehose internal server error response has a 5xx status code
+func (o *RollbackFirehoseInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this rollback firehose internal server error response a status code equal to that given
+func (o *RollbackFirehoseInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *RollbackFirehoseInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/rollback][%d] rollbackFirehoseInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *RollbackFirehoseInternalServerError) String() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/rollback][%d] rollbackFirehoseInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *RollbackFirehoseInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *RollbackFirehoseInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*
+RollbackFirehoseBody rollback firehose body
+swagger:model RollbackFirehoseBody
+*/
+type RollbackFirehoseBody struct {
+
+	// Number of the revision to rollback to.
+	Revision int64 `json:"revision,omitempty"`
+
+	// Rollback to the latest revision created at or before this time.
+	// Format: date-time
+	Timestamp strfmt.DateTime `json:"timestamp,omitempty"`
+}
+
+// Validate validates this rollback firehose body
+func (o *RollbackFirehoseBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateTimestamp(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *RollbackFirehoseBody) validateTimestamp(formats strfmt.Registry) error {
+	if swag.IsZero(o.Timestamp) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("body"+"."+"timestamp", "body", "date-time", o.Timestamp.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this rollback firehose body based on context it is used
+func (o *RollbackFirehoseBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *RollbackFirehoseBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *RollbackFirehoseBody) UnmarshalBinary(b []byte) error {
+	var res RollbackFirehoseBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
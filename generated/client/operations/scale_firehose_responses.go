@@ -67,6 +67,11 @@ ScaleFirehoseOK describes a response with status code 200, with default header v
 Successfully applied update.
 */
 type ScaleFirehoseOK struct {
+
+	/* Id of the operation tracking the changes. Not set for dry-run requests.
+	 */
+	XOperationID string
+
 	Payload *models.Firehose
 }
 
@@ -109,6 +114,13 @@ func (o *ScaleFirehoseOK) GetPayload() *models.Firehose {
 
 func (o *ScaleFirehoseOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header X-Operation-Id
+	hdrXOperationID := response.GetHeader("X-Operation-Id")
+
+	if hdrXOperationID != "" {
+		o.XOperationID = hdrXOperationID
+	}
+
 	o.Payload = new(models.Firehose)
 
 	// response payload
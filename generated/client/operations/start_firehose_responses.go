@@ -63,6 +63,11 @@ StartFirehoseOK describes a response with status code 200, with default header v
 Successfully applied update.
 */
 type StartFirehoseOK struct {
+
+	/* Id of the operation tracking the changes. Not set for dry-run requests.
+	 */
+	XOperationID string
+
 	Payload *models.Firehose
 }
 
@@ -105,6 +110,13 @@ func (o *StartFirehoseOK) GetPayload() *models.Firehose {
 
 func (o *StartFirehoseOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header X-Operation-Id
+	hdrXOperationID := response.GetHeader("X-Operation-Id")
+
+	if hdrXOperationID != "" {
+		o.XOperationID = hdrXOperationID
+	}
+
 	o.Payload = new(models.Firehose)
 
 	// response payload
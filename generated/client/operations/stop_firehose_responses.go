@@ -63,6 +63,11 @@ StopFirehoseOK describes a response with status code 200, with default header va
 Successfully applied update.
 */
 type StopFirehoseOK struct {
+
+	/* Id of the operation tracking the changes. Not set for dry-run requests.
+	 */
+	XOperationID string
+
 	Payload *models.Firehose
 }
 
@@ -105,6 +110,13 @@ func (o *StopFirehoseOK) GetPayload() *models.Firehose {
 
 func (o *StopFirehoseOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header X-Operation-Id
+	hdrXOperationID := response.GetHeader("X-Operation-Id")
+
+	if hdrXOperationID != "" {
+		o.XOperationID = hdrXOperationID
+	}
+
 	o.Payload = new(models.Firehose)
 
 	// response payload
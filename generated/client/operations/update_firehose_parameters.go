@@ -14,6 +14,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewUpdateFirehoseParams creates a new UpdateFirehoseParams object,
@@ -64,6 +65,14 @@ type UpdateFirehoseParams struct {
 	// Body.
 	Body UpdateFirehoseBody
 
+	/* DryRun.
+
+	     Only validate the request and return the firehose as it would be updated.
+	No changes are applied.
+
+	*/
+	DryRun *bool
+
 	/* FirehoseUrn.
 
 	   URN of the firehose.
@@ -140,6 +149,17 @@ func (o *UpdateFirehoseParams) SetBody(body UpdateFirehoseBody) {
 	o.Body = body
 }
 
+// WithDryRun adds the dryRun to the update firehose params
+func (o *UpdateFirehoseParams) WithDryRun(dryRun *bool) *UpdateFirehoseParams {
+	o.SetDryRun(dryRun)
+	return o
+}
+
+// SetDryRun adds the dryRun to the update firehose params
+func (o *UpdateFirehoseParams) SetDryRun(dryRun *bool) {
+	o.DryRun = dryRun
+}
+
 // WithFirehoseUrn adds the firehoseUrn to the update firehose params
 func (o *UpdateFirehoseParams) WithFirehoseUrn(firehoseUrn string) *UpdateFirehoseParams {
 	o.SetFirehoseUrn(firehoseUrn)
@@ -173,6 +193,23 @@ func (o *UpdateFirehoseParams) WriteToRequest(r runtime.ClientRequest, reg strfm
 		return err
 	}
 
+	if o.DryRun != nil {
+
+		// query param dry_run
+		var qrDryRun bool
+
+		if o.DryRun != nil {
+			qrDryRun = *o.DryRun
+		}
+		qDryRun := swag.FormatBool(qrDryRun)
+		if qDryRun != "" {
+
+			if err := r.SetQueryParam("dry_run", qDryRun); err != nil {
+				return err
+			}
+		}
+	}
+
 	// path param firehoseUrn
 	if err := r.SetPathParam("firehoseUrn", o.FirehoseUrn); err != nil {
 		return err
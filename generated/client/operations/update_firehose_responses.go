@@ -66,6 +66,17 @@ UpdateFirehoseOK describes a response with status code 200, with default header
 Found firehose with given URN
 */
 type UpdateFirehoseOK struct {
+
+	/* Comma separated keys of the sensitive env vars whose values are changed by the update.
+	Set only for dry-run requests since these changes are not visible in the masked values.
+
+	*/
+	XMaskedChanges string
+
+	/* Id of the operation tracking the changes. Not set for dry-run requests.
+	 */
+	XOperationID string
+
 	Payload *models.Firehose
 }
 
@@ -108,6 +119,20 @@ func (o *UpdateFirehoseOK) GetPayload() *models.Firehose {
 
 func (o *UpdateFirehoseOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header X-Masked-Changes
+	hdrXMaskedChanges := response.GetHeader("X-Masked-Changes")
+
+	if hdrXMaskedChanges != "" {
+		o.XMaskedChanges = hdrXMaskedChanges
+	}
+
+	// hydrates response header X-Operation-Id
+	hdrXOperationID := response.GetHeader("X-Operation-Id")
+
+	if hdrXOperationID != "" {
+		o.XOperationID = hdrXOperationID
+	}
+
 	o.Payload = new(models.Firehose)
 
 	// response payload
@@ -0,0 +1,212 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewUpdateFirehoseScheduleParams creates a new UpdateFirehoseScheduleParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewUpdateFirehoseScheduleParams() *UpdateFirehoseScheduleParams {
+	return &UpdateFirehoseScheduleParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewUpdateFirehoseScheduleParamsWithTimeout creates a new UpdateFirehoseScheduleParams object
+// with the ability to set a timeout on a request.
+func NewUpdateFirehoseScheduleParamsWithTimeout(timeout time.Duration) *UpdateFirehoseScheduleParams {
+	return &UpdateFirehoseScheduleParams{
+		timeout: timeout,
+	}
+}
+
+// NewUpdateFirehoseScheduleParamsWithContext creates a new UpdateFirehoseScheduleParams object
+// with the ability to set a context for a request.
+func NewUpdateFirehoseScheduleParamsWithContext(ctx context.Context) *UpdateFirehoseScheduleParams {
+	return &UpdateFirehoseScheduleParams{
+		Context: ctx,
+	}
+}
+
+// NewUpdateFirehoseScheduleParamsWithHTTPClient creates a new UpdateFirehoseScheduleParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewUpdateFirehoseScheduleParamsWithHTTPClient(client *http.Client) *UpdateFirehoseScheduleParams {
+	return &UpdateFirehoseScheduleParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+UpdateFirehoseScheduleParams contains all the parameters to send to the API endpoint
+
+	for the update firehose schedule operation.
+
+	Typically these are written to a http.Request.
+*/
+type UpdateFirehoseScheduleParams struct {
+
+	// Body.
+	Body UpdateFirehoseScheduleBody
+
+	/* FirehoseUrn.
+
+	   URN of the firehose.
+	*/
+	FirehoseUrn string
+
+	/* ProjectSlug.
+
+	   Identifier for the project.
+	*/
+	ProjectSlug string
+
+	/* ScheduleID.
+
+	   Identifier of the schedule.
+	*/
+	ScheduleID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the update firehose schedule params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *UpdateFirehoseScheduleParams) WithDefaults() *UpdateFirehoseScheduleParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the update firehose schedule params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *UpdateFirehoseScheduleParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the update firehose schedule params
+func (o *UpdateFirehoseScheduleParams) WithTimeout(timeout time.Duration) *UpdateFirehoseScheduleParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the update firehose schedule params
+func (o *UpdateFirehoseScheduleParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the update firehose schedule params
+func (o *UpdateFirehoseScheduleParams) WithContext(ctx context.Context) *UpdateFirehoseScheduleParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the update firehose schedule params
+func (o *UpdateFirehoseScheduleParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the update firehose schedule params
+func (o *UpdateFirehoseScheduleParams) WithHTTPClient(client *http.Client) *UpdateFirehoseScheduleParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the update firehose schedule params
+func (o *UpdateFirehoseScheduleParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the update firehose schedule params
+func (o *UpdateFirehoseScheduleParams) WithBody(body UpdateFirehoseScheduleBody) *UpdateFirehoseScheduleParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the update firehose schedule params
+func (o *UpdateFirehoseScheduleParams) SetBody(body UpdateFirehoseScheduleBody) {
+	o.Body = body
+}
+
+// WithFirehoseUrn adds the firehoseUrn to the update firehose schedule params
+func (o *UpdateFirehoseScheduleParams) WithFirehoseUrn(firehoseUrn string) *UpdateFirehoseScheduleParams {
+	o.SetFirehoseUrn(firehoseUrn)
+	return o
+}
+
+// SetFirehoseUrn adds the firehoseUrn to the update firehose schedule params
+func (o *UpdateFirehoseScheduleParams) SetFirehoseUrn(firehoseUrn string) {
+	o.FirehoseUrn = firehoseUrn
+}
+
+// WithProjectSlug adds the projectSlug to the update firehose schedule params
+func (o *UpdateFirehoseScheduleParams) WithProjectSlug(projectSlug string) *UpdateFirehoseScheduleParams {
+	o.SetProjectSlug(projectSlug)
+	return o
+}
+
+// SetProjectSlug adds the projectSlug to the update firehose schedule params
+func (o *UpdateFirehoseScheduleParams) SetProjectSlug(projectSlug string) {
+	o.ProjectSlug = projectSlug
+}
+
+// WithScheduleID adds the scheduleID to the update firehose schedule params
+func (o *UpdateFirehoseScheduleParams) WithScheduleID(scheduleID string) *UpdateFirehoseScheduleParams {
+	o.SetScheduleID(scheduleID)
+	return o
+}
+
+// SetScheduleID adds the scheduleId to the update firehose schedule params
+func (o *UpdateFirehoseScheduleParams) SetScheduleID(scheduleID string) {
+	o.ScheduleID = scheduleID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *UpdateFirehoseScheduleParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if err := r.SetBodyParam(o.Body); err != nil {
+		return err
+	}
+
+	// path param firehoseUrn
+	if err := r.SetPathParam("firehoseUrn", o.FirehoseUrn); err != nil {
+		return err
+	}
+
+	// path param projectSlug
+	if err := r.SetPathParam("projectSlug", o.ProjectSlug); err != nil {
+		return err
+	}
+
+	// path param scheduleId
+	if err := r.SetPathParam("scheduleId", o.ScheduleID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,517 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+
+	"github.com/odpf/dex/generated/models"
+)
+
+// UpdateFirehoseScheduleReader is a Reader for the UpdateFirehoseSchedule structure.
+type UpdateFirehoseScheduleReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *UpdateFirehoseScheduleReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewUpdateFirehoseScheduleOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewUpdateFirehoseScheduleBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewUpdateFirehoseScheduleNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewUpdateFirehoseScheduleInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewUpdateFirehoseScheduleOK creates a UpdateFirehoseScheduleOK with default headers values
+func NewUpdateFirehoseScheduleOK() *UpdateFirehoseScheduleOK {
+	return &UpdateFirehoseScheduleOK{}
+}
+
+/*
+UpdateFirehoseScheduleOK describes a response with status code 200, with default header values.
+
+Successfully updated the schedule.
+*/
+type UpdateFirehoseScheduleOK struct {
+	Payload *models.Schedule
+}
+
+// IsSuccess returns true when this update firehose schedule o k response has a 2xx status code
+func (o *UpdateFirehoseScheduleOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this update firehose schedule o k response has a 3xx status code
+func (o *UpdateFirehoseScheduleOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update firehose schedule o k response has a 4xx status code
+func (o *UpdateFirehoseScheduleOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this update firehose schedule o k response has a 5xx status code
+func (o *UpdateFirehoseScheduleOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update firehose schedule o k response a status code equal to that given
+func (o *UpdateFirehoseScheduleOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *UpdateFirehoseScheduleOK) Error() string {
+	return fmt.Sprintf("[PUT /projects/{projectSlug}/firehoses/{firehoseUrn}/schedules/{scheduleId}][%d] updateFirehoseScheduleOK  %+v", 200, o.Payload)
+}
+
+func (o *UpdateFirehoseScheduleOK) String() string {
+	return fmt.Sprintf("[PUT /projects/{projectSlug}/firehoses/{firehoseUrn}/schedules/{scheduleId}][%d] updateFirehoseScheduleOK  %+v", 200, o.Payload)
+}
+
+func (o *UpdateFirehoseScheduleOK) GetPayload() *models.Schedule {
+	return o.Payload
+}
+
+func (o *UpdateFirehoseScheduleOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Schedule)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateFirehoseScheduleBadRequest creates a UpdateFirehoseScheduleBadRequest with default headers values
+func NewUpdateFirehoseScheduleBadRequest() *UpdateFirehoseScheduleBadRequest {
+	return &UpdateFirehoseScheduleBadRequest{}
+}
+
+/*
+UpdateFirehoseScheduleBadRequest describes a response with status code 400, with default header values.
+
+Request was invalid.
+*/
+type UpdateFirehoseScheduleBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this update firehose schedule bad request response has a 2xx status code
+func (o *UpdateFirehoseScheduleBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update firehose schedule bad request response has a 3xx status code
+func (o *UpdateFirehoseScheduleBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update firehose schedule bad request response has a 4xx status code
+func (o *UpdateFirehoseScheduleBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this update firehose schedule bad request response has a 5xx status code
+func (o *UpdateFirehoseScheduleBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update firehose schedule bad request response a status code equal to that given
+func (o *UpdateFirehoseScheduleBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *UpdateFirehoseScheduleBadRequest) Error() string {
+	return fmt.Sprintf("[PUT /projects/{projectSlug}/firehoses/{firehoseUrn}/schedules/{scheduleId}][%d] updateFirehoseScheduleBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *UpdateFirehoseScheduleBadRequest) String() string {
+	return fmt.Sprintf("[PUT /projects/{projectSlug}/firehoses/{firehoseUrn}/schedules/{scheduleId}][%d] updateFirehoseScheduleBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *UpdateFirehoseScheduleBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *UpdateFirehoseScheduleBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateFirehoseScheduleNotFound creates a UpdateFirehoseScheduleNotFound with default headers values
+func NewUpdateFirehoseScheduleNotFound() *UpdateFirehoseScheduleNotFound {
+	return &UpdateFirehoseScheduleNotFound{}
+}
+
+/*
+UpdateFirehoseScheduleNotFound describes a response with status code 404, with default header values.
+
+Schedule was not found
+*/
+type UpdateFirehoseScheduleNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this update firehose schedule not found response has a 2xx status code
+func (o *UpdateFirehoseScheduleNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update firehose schedule not found response has a 3xx status code
+func (o *UpdateFirehoseScheduleNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update firehose schedule not found response has a 4xx status code
+func (o *UpdateFirehoseScheduleNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this update firehose schedule not found response has a 5xx status code
+func (o *UpdateFirehoseScheduleNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update firehose schedule not found response a status code equal to that given
+func (o *UpdateFirehoseScheduleNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *UpdateFirehoseScheduleNotFound) Error() string {
+	return fmt.Sprintf("[PUT /projects/{projectSlug}/firehoses/{firehoseUrn}/schedules/{scheduleId}][%d] updateFirehoseScheduleNotFound  %+v", 404, o.Payload)
+}
+
+func (o *UpdateFirehoseScheduleNotFound) String() string {
+	return fmt.Sprintf("[PUT /projects/{projectSlug}/firehoses/{firehoseUrn}/schedules/{scheduleId}][%d] updateFirehoseScheduleNotFound  %+v", 404, o.Payload)
+}
+
+func (o *UpdateFirehoseScheduleNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *UpdateFirehoseScheduleNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateFirehoseScheduleInternalServerError creates a UpdateFirehoseScheduleInternalServerError with default headers values
+func NewUpdateFirehoseScheduleInternalServerError() *UpdateFirehoseScheduleInternalServerError {
+	return &UpdateFirehoseScheduleInternalServerError{}
+}
+
+/*
+UpdateFirehoseScheduleInternalServerError describes a response with status code 500, with default header values.
+
+internal error
+*/
+type UpdateFirehoseScheduleInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this update firehose schedule internal server error response has a 2xx status code
+func (o *UpdateFirehoseScheduleInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update firehose schedule internal server error response has a 3xx status code
+func (o *UpdateFirehoseScheduleInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update firehose schedule internal server error response has a 4xx status code
+func (o *UpdateFirehoseScheduleInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this update firehose schedule internal server error response has a 5xx status code
+func (o *UpdateFirehoseScheduleInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this update firehose schedule internal server error response a status code equal to that given
+func (o *UpdateFirehoseScheduleInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *UpdateFirehoseScheduleInternalServerError) Error() string {
+	return fmt.Sprintf("[PUT /projects/{projectSlug}/firehoses/{firehoseUrn}/schedules/{scheduleId}][%d] updateFirehoseScheduleInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *UpdateFirehoseScheduleInternalServerError) String() string {
+	return fmt.Sprintf("[PUT /projects/{projectSlug}/firehoses/{firehoseUrn}/schedules/{scheduleId}][%d] updateFirehoseScheduleInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *UpdateFirehoseScheduleInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *UpdateFirehoseScheduleInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*
+UpdateFirehoseScheduleBody update firehose schedule body
+swagger:model UpdateFirehoseScheduleBody
+*/
+type UpdateFirehoseScheduleBody struct {
+
+	// action
+	// Required: true
+	// Enum: [start stop scale]
+	Action *string `json:"action"`
+
+	// Standard 5-field cron expression (e.g., '0 22 * * 1-5'). Descriptors such as
+	// '@daily' and a 'CRON_TZ=<zone>' prefix are supported. Times are in UTC by default.
+	//
+	// Example: 0 22 * * *
+	// Required: true
+	Cron *string `json:"cron"`
+
+	// Whether the schedule is active. Defaults to true.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// params
+	Params *UpdateFirehoseScheduleParamsBodyParams `json:"params,omitempty"`
+}
+
+// Validate validates this update firehose schedule body
+func (o *UpdateFirehoseScheduleBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateAction(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateCron(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateParams(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var updateFirehoseScheduleBodyTypeActionPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["start","stop","scale"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		updateFirehoseScheduleBodyTypeActionPropEnum = append(updateFirehoseScheduleBodyTypeActionPropEnum, v)
+	}
+}
+
+const (
+
+	// UpdateFirehoseScheduleBodyActionStart captures enum value "start"
+	UpdateFirehoseScheduleBodyActionStart string = "start"
+
+	// UpdateFirehoseScheduleBodyActionStop captures enum value "stop"
+	UpdateFirehoseScheduleBodyActionStop string = "stop"
+
+	// UpdateFirehoseScheduleBodyActionScale captures enum value "scale"
+	UpdateFirehoseScheduleBodyActionScale string = "scale"
+)
+
+// prop value enum
+func (o *UpdateFirehoseScheduleBody) validateActionEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, updateFirehoseScheduleBodyTypeActionPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *UpdateFirehoseScheduleBody) validateAction(formats strfmt.Registry) error {
+
+	if err := validate.Required("body"+"."+"action", "body", o.Action); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := o.validateActionEnum("body"+"."+"action", "body", *o.Action); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *UpdateFirehoseScheduleBody) validateCron(formats strfmt.Registry) error {
+
+	if err := validate.Required("body"+"."+"cron", "body", o.Cron); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *UpdateFirehoseScheduleBody) validateParams(formats strfmt.Registry) error {
+	if swag.IsZero(o.Params) { // not required
+		return nil
+	}
+
+	if o.Params != nil {
+		if err := o.Params.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("body" + "." + "params")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("body" + "." + "params")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this update firehose schedule body based on the context it is used
+func (o *UpdateFirehoseScheduleBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateParams(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *UpdateFirehoseScheduleBody) contextValidateParams(ctx context.Context, formats strfmt.Registry) error {
+
+	if o.Params != nil {
+		if err := o.Params.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("body" + "." + "params")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("body" + "." + "params")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *UpdateFirehoseScheduleBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *UpdateFirehoseScheduleBody) UnmarshalBinary(b []byte) error {
+	var res UpdateFirehoseScheduleBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+UpdateFirehoseScheduleParamsBodyParams Params of the action. 'scale' requires 'replicas'.
+swagger:model UpdateFirehoseScheduleParamsBodyParams
+*/
+type UpdateFirehoseScheduleParamsBodyParams struct {
+
+	// replicas
+	Replicas int64 `json:"replicas,omitempty"`
+}
+
+// Validate validates this update firehose schedule params body params
+func (o *UpdateFirehoseScheduleParamsBodyParams) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this update firehose schedule params body params based on context it is used
+func (o *UpdateFirehoseScheduleParamsBodyParams) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *UpdateFirehoseScheduleParamsBodyParams) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *UpdateFirehoseScheduleParamsBodyParams) UnmarshalBinary(b []byte) error {
+	var res UpdateFirehoseScheduleParamsBodyParams
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,194 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/odpf/dex/generated/models"
+)
+
+// NewUpdateFirehoseTemplateParams creates a new UpdateFirehoseTemplateParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewUpdateFirehoseTemplateParams() *UpdateFirehoseTemplateParams {
+	return &UpdateFirehoseTemplateParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewUpdateFirehoseTemplateParamsWithTimeout creates a new UpdateFirehoseTemplateParams object
+// with the ability to set a timeout on a request.
+func NewUpdateFirehoseTemplateParamsWithTimeout(timeout time.Duration) *UpdateFirehoseTemplateParams {
+	return &UpdateFirehoseTemplateParams{
+		timeout: timeout,
+	}
+}
+
+// NewUpdateFirehoseTemplateParamsWithContext creates a new UpdateFirehoseTemplateParams object
+// with the ability to set a context for a request.
+func NewUpdateFirehoseTemplateParamsWithContext(ctx context.Context) *UpdateFirehoseTemplateParams {
+	return &UpdateFirehoseTemplateParams{
+		Context: ctx,
+	}
+}
+
+// NewUpdateFirehoseTemplateParamsWithHTTPClient creates a new UpdateFirehoseTemplateParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewUpdateFirehoseTemplateParamsWithHTTPClient(client *http.Client) *UpdateFirehoseTemplateParams {
+	return &UpdateFirehoseTemplateParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+UpdateFirehoseTemplateParams contains all the parameters to send to the API endpoint
+
+	for the update firehose template operation.
+
+	Typically these are written to a http.Request.
+*/
+type UpdateFirehoseTemplateParams struct {
+
+	// Body.
+	Body *models.FirehoseTemplate
+
+	/* ProjectSlug.
+
+	   Unique slug name of the project.
+	*/
+	ProjectSlug string
+
+	/* TemplateName.
+
+	   Name of the template.
+	*/
+	TemplateName string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the update firehose template params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *UpdateFirehoseTemplateParams) WithDefaults() *UpdateFirehoseTemplateParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the update firehose template params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *UpdateFirehoseTemplateParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the update firehose template params
+func (o *UpdateFirehoseTemplateParams) WithTimeout(timeout time.Duration) *UpdateFirehoseTemplateParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the update firehose template params
+func (o *UpdateFirehoseTemplateParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the update firehose template params
+func (o *UpdateFirehoseTemplateParams) WithContext(ctx context.Context) *UpdateFirehoseTemplateParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the update firehose template params
+func (o *UpdateFirehoseTemplateParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the update firehose template params
+func (o *UpdateFirehoseTemplateParams) WithHTTPClient(client *http.Client) *UpdateFirehoseTemplateParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the update firehose template params
+func (o *UpdateFirehoseTemplateParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the update firehose template params
+func (o *UpdateFirehoseTemplateParams) WithBody(body *models.FirehoseTemplate) *UpdateFirehoseTemplateParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the update firehose template params
+func (o *UpdateFirehoseTemplateParams) SetBody(body *models.FirehoseTemplate) {
+	o.Body = body
+}
+
+// WithProjectSlug adds the projectSlug to the update firehose template params
+func (o *UpdateFirehoseTemplateParams) WithProjectSlug(projectSlug string) *UpdateFirehoseTemplateParams {
+	o.SetProjectSlug(projectSlug)
+	return o
+}
+
+// SetProjectSlug adds the projectSlug to the update firehose template params
+func (o *UpdateFirehoseTemplateParams) SetProjectSlug(projectSlug string) {
+	o.ProjectSlug = projectSlug
+}
+
+// WithTemplateName adds the templateName to the update firehose template params
+func (o *UpdateFirehoseTemplateParams) WithTemplateName(templateName string) *UpdateFirehoseTemplateParams {
+	o.SetTemplateName(templateName)
+	return o
+}
+
+// SetTemplateName adds the templateName to the update firehose template params
+func (o *UpdateFirehoseTemplateParams) SetTemplateName(templateName string) {
+	o.TemplateName = templateName
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *UpdateFirehoseTemplateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param projectSlug
+	if err := r.SetPathParam("projectSlug", o.ProjectSlug); err != nil {
+		return err
+	}
+
+	// path param templateName
+	if err := r.SetPathParam("templateName", o.TemplateName); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,305 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/odpf/dex/generated/models"
+)
+
+// UpdateFirehoseTemplateReader is a Reader for the UpdateFirehoseTemplate structure.
+type UpdateFirehoseTemplateReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *UpdateFirehoseTemplateReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewUpdateFirehoseTemplateOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewUpdateFirehoseTemplateBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewUpdateFirehoseTemplateNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewUpdateFirehoseTemplateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewUpdateFirehoseTemplateOK creates a UpdateFirehoseTemplateOK with default headers values
+func NewUpdateFirehoseTemplateOK() *UpdateFirehoseTemplateOK {
+	return &UpdateFirehoseTemplateOK{}
+}
+
+/*
+UpdateFirehoseTemplateOK describes a response with status code 200, with default header values.
+
+Successfully updated.
+*/
+type UpdateFirehoseTemplateOK struct {
+	Payload *models.FirehoseTemplate
+}
+
+// IsSuccess returns true when this update firehose template o k response has a 2xx status code
+func (o *UpdateFirehoseTemplateOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this update firehose template o k response has a 3xx status code
+func (o *UpdateFirehoseTemplateOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update firehose template o k response has a 4xx status code
+func (o *UpdateFirehoseTemplateOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this update firehose template o k response has a 5xx status code
+func (o *UpdateFirehoseTemplateOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update firehose template o k response a status code equal to that given
+func (o *UpdateFirehoseTemplateOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *UpdateFirehoseTemplateOK) Error() string {
+	return fmt.Sprintf("[PUT /projects/{projectSlug}/firehoseTemplates/{templateName}][%d] updateFirehoseTemplateOK  %+v", 200, o.Payload)
+}
+
+func (o *UpdateFirehoseTemplateOK) String() string {
+	return fmt.Sprintf("[PUT /projects/{projectSlug}/firehoseTemplates/{templateName}][%d] updateFirehoseTemplateOK  %+v", 200, o.Payload)
+}
+
+func (o *UpdateFirehoseTemplateOK) GetPayload() *models.FirehoseTemplate {
+	return o.Payload
+}
+
+func (o *UpdateFirehoseTemplateOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.FirehoseTemplate)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateFirehoseTemplateBadRequest creates a UpdateFirehoseTemplateBadRequest with default headers values
+func NewUpdateFirehoseTemplateBadRequest() *UpdateFirehoseTemplateBadRequest {
+	return &UpdateFirehoseTemplateBadRequest{}
+}
+
+/*
+UpdateFirehoseTemplateBadRequest describes a response with status code 400, with default header values.
+
+Request was invalid.
+*/
+type UpdateFirehoseTemplateBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this update firehose template bad request response has a 2xx status code
+func (o *UpdateFirehoseTemplateBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update firehose template bad request response has a 3xx status code
+func (o *UpdateFirehoseTemplateBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update firehose template bad request response has a 4xx status code
+func (o *UpdateFirehoseTemplateBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this update firehose template bad request response has a 5xx status code
+func (o *UpdateFirehoseTemplateBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update firehose template bad request response a status code equal to that given
+func (o *UpdateFirehoseTemplateBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *UpdateFirehoseTemplateBadRequest) Error() string {
+	return fmt.Sprintf("[PUT /projects/{projectSlug}/firehoseTemplates/{templateName}][%d] updateFirehoseTemplateBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *UpdateFirehoseTemplateBadRequest) String() string {
+	return fmt.Sprintf("[PUT /projects/{projectSlug}/firehoseTemplates/{templateName}][%d] updateFirehoseTemplateBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *UpdateFirehoseTemplateBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *UpdateFirehoseTemplateBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateFirehoseTemplateNotFound creates a UpdateFirehoseTemplateNotFound with default headers values
+func NewUpdateFirehoseTemplateNotFound() *UpdateFirehoseTemplateNotFound {
+	return &UpdateFirehoseTemplateNotFound{}
+}
+
+/*
+UpdateFirehoseTemplateNotFound describes a response with status code 404, with default header values.
+
+Template with given name was not found.
+*/
+type UpdateFirehoseTemplateNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this update firehose template not found response has a 2xx status code
+func (o *UpdateFirehoseTemplateNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update firehose template not found response has a 3xx status code
+func (o *UpdateFirehoseTemplateNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update firehose template not found response has a 4xx status code
+func (o *UpdateFirehoseTemplateNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this update firehose template not found response has a 5xx status code
+func (o *UpdateFirehoseTemplateNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update firehose template not found response a status code equal to that given
+func (o *UpdateFirehoseTemplateNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *UpdateFirehoseTemplateNotFound) Error() string {
+	return fmt.Sprintf("[PUT /projects/{projectSlug}/firehoseTemplates/{templateName}][%d] updateFirehoseTemplateNotFound  %+v", 404, o.Payload)
+}
+
+func (o *UpdateFirehoseTemplateNotFound) String() string {
+	return fmt.Sprintf("[PUT /projects/{projectSlug}/firehoseTemplates/{templateName}][%d] updateFirehoseTemplateNotFound  %+v", 404, o.Payload)
+}
+
+func (o *UpdateFirehoseTemplateNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *UpdateFirehoseTemplateNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateFirehoseTemplateInternalServerError creates a UpdateFirehoseTemplateInternalServerError with default headers values
+func NewUpdateFirehoseTemplateInternalServerError() *UpdateFirehoseTemplateInternalServerError {
+	return &UpdateFirehoseTemplateInternalServerError{}
+}
+
+/*
+UpdateFirehoseTemplateInternalServerError describes a response with status code 500, with default header values.
+
+internal error
+*/
+type UpdateFirehoseTemplateInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this update firehose template internal server error response has a 2xx status code
+func (o *UpdateFirehoseTemplateInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update firehose template internal server error response has a 3xx status code
+func (o *UpdateFirehoseTemplateInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update firehose template internal server error response has a 4xx status code
+func (o *UpdateFirehoseTemplateInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this update firehose template internal server error response has a 5xx status code
+func (o *UpdateFirehoseTemplateInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this update firehose template internal server error response a status code equal to that given
+func (o *UpdateFirehoseTemplateInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *UpdateFirehoseTemplateInternalServerError) Error() string {
+	return fmt.Sprintf("[PUT /projects/{projectSlug}/firehoseTemplates/{templateName}][%d] updateFirehoseTemplateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *UpdateFirehoseTemplateInternalServerError) String() string {
+	return fmt.Sprintf("[PUT /projects/{projectSlug}/firehoseTemplates/{templateName}][%d] updateFirehoseTemplateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *UpdateFirehoseTemplateInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *UpdateFirehoseTemplateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
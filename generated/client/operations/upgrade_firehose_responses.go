@@ -57,6 +57,11 @@ UpgradeFirehoseOK describes a response with status code 200, with default header
 Upgrade request accepted.
 */
 type UpgradeFirehoseOK struct {
+
+	/* Id of the operation tracking the changes. Not set for dry-run requests.
+	 */
+	XOperationID string
+
 	Payload *models.Firehose
 }
 
@@ -99,6 +104,13 @@ func (o *UpgradeFirehoseOK) GetPayload() *models.Firehose {
 
 func (o *UpgradeFirehoseOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header X-Operation-Id
+	hdrXOperationID := response.GetHeader("X-Operation-Id")
+
+	if hdrXOperationID != "" {
+		o.XOperationID = hdrXOperationID
+	}
+
 	o.Payload = new(models.Firehose)
 
 	// response payload
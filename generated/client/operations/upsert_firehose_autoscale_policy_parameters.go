@@ -0,0 +1,190 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewUpsertFirehoseAutoscalePolicyParams creates a new UpsertFirehoseAutoscalePolicyParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewUpsertFirehoseAutoscalePolicyParams() *UpsertFirehoseAutoscalePolicyParams {
+	return &UpsertFirehoseAutoscalePolicyParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewUpsertFirehoseAutoscalePolicyParamsWithTimeout creates a new UpsertFirehoseAutoscalePolicyParams object
+// with the ability to set a timeout on a request.
+func NewUpsertFirehoseAutoscalePolicyParamsWithTimeout(timeout time.Duration) *UpsertFirehoseAutoscalePolicyParams {
+	return &UpsertFirehoseAutoscalePolicyParams{
+		timeout: timeout,
+	}
+}
+
+// NewUpsertFirehoseAutoscalePolicyParamsWithContext creates a new UpsertFirehoseAutoscalePolicyParams object
+// with the ability to set a context for a request.
+func NewUpsertFirehoseAutoscalePolicyParamsWithContext(ctx context.Context) *UpsertFirehoseAutoscalePolicyParams {
+	return &UpsertFirehoseAutoscalePolicyParams{
+		Context: ctx,
+	}
+}
+
+// NewUpsertFirehoseAutoscalePolicyParamsWithHTTPClient creates a new UpsertFirehoseAutoscalePolicyParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewUpsertFirehoseAutoscalePolicyParamsWithHTTPClient(client *http.Client) *UpsertFirehoseAutoscalePolicyParams {
+	return &UpsertFirehoseAutoscalePolicyParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+UpsertFirehoseAutoscalePolicyParams contains all the parameters to send to the API endpoint
+
+	for the upsert firehose autoscale policy operation.
+
+	Typically these are written to a http.Request.
+*/
+type UpsertFirehoseAutoscalePolicyParams struct {
+
+	// Body.
+	Body UpsertFirehoseAutoscalePolicyBody
+
+	/* FirehoseUrn.
+
+	   URN of the firehose.
+	*/
+	FirehoseUrn string
+
+	/* ProjectSlug.
+
+	   Identifier for the project.
+	*/
+	ProjectSlug string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the upsert firehose autoscale policy params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *UpsertFirehoseAutoscalePolicyParams) WithDefaults() *UpsertFirehoseAutoscalePolicyParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the upsert firehose autoscale policy params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *UpsertFirehoseAutoscalePolicyParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the upsert firehose autoscale policy params
+func (o *UpsertFirehoseAutoscalePolicyParams) WithTimeout(timeout time.Duration) *UpsertFirehoseAutoscalePolicyParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the upsert firehose autoscale policy params
+func (o *UpsertFirehoseAutoscalePolicyParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the upsert firehose autoscale policy params
+func (o *UpsertFirehoseAutoscalePolicyParams) WithContext(ctx context.Context) *UpsertFirehoseAutoscalePolicyParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the upsert firehose autoscale policy params
+func (o *UpsertFirehoseAutoscalePolicyParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the upsert firehose autoscale policy params
+func (o *UpsertFirehoseAutoscalePolicyParams) WithHTTPClient(client *http.Client) *UpsertFirehoseAutoscalePolicyParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the upsert firehose autoscale policy params
+func (o *UpsertFirehoseAutoscalePolicyParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the upsert firehose autoscale policy params
+func (o *UpsertFirehoseAutoscalePolicyParams) WithBody(body UpsertFirehoseAutoscalePolicyBody) *UpsertFirehoseAutoscalePolicyParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the upsert firehose autoscale policy params
+func (o *UpsertFirehoseAutoscalePolicyParams) SetBody(body UpsertFirehoseAutoscalePolicyBody) {
+	o.Body = body
+}
+
+// WithFirehoseUrn adds the firehoseUrn to the upsert firehose autoscale policy params
+func (o *UpsertFirehoseAutoscalePolicyParams) WithFirehoseUrn(firehoseUrn string) *UpsertFirehoseAutoscalePolicyParams {
+	o.SetFirehoseUrn(firehoseUrn)
+	return o
+}
+
+// SetFirehoseUrn adds the firehoseUrn to the upsert firehose autoscale policy params
+func (o *UpsertFirehoseAutoscalePolicyParams) SetFirehoseUrn(firehoseUrn string) {
+	o.FirehoseUrn = firehoseUrn
+}
+
+// WithProjectSlug adds the projectSlug to the upsert firehose autoscale policy params
+func (o *UpsertFirehoseAutoscalePolicyParams) WithProjectSlug(projectSlug string) *UpsertFirehoseAutoscalePolicyParams {
+	o.SetProjectSlug(projectSlug)
+	return o
+}
+
+// SetProjectSlug adds the projectSlug to the upsert firehose autoscale policy params
+func (o *UpsertFirehoseAutoscalePolicyParams) SetProjectSlug(projectSlug string) {
+	o.ProjectSlug = projectSlug
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *UpsertFirehoseAutoscalePolicyParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if err := r.SetBodyParam(o.Body); err != nil {
+		return err
+	}
+
+	// path param firehoseUrn
+	if err := r.SetPathParam("firehoseUrn", o.FirehoseUrn); err != nil {
+		return err
+	}
+
+	// path param projectSlug
+	if err := r.SetPathParam("projectSlug", o.ProjectSlug); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
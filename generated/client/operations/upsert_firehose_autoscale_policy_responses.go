@@ -0,0 +1,421 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+
+	"github.com/odpf/dex/generated/models"
+)
+
+// UpsertFirehoseAutoscalePolicyReader is a Reader for the UpsertFirehoseAutoscalePolicy structure.
+type UpsertFirehoseAutoscalePolicyReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *UpsertFirehoseAutoscalePolicyReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewUpsertFirehoseAutoscalePolicyOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewUpsertFirehoseAutoscalePolicyBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewUpsertFirehoseAutoscalePolicyNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewUpsertFirehoseAutoscalePolicyInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewUpsertFirehoseAutoscalePolicyOK creates a UpsertFirehoseAutoscalePolicyOK with default headers values
+func NewUpsertFirehoseAutoscalePolicyOK() *UpsertFirehoseAutoscalePolicyOK {
+	return &UpsertFirehoseAutoscalePolicyOK{}
+}
+
+/*
+UpsertFirehoseAutoscalePolicyOK describes a response with status code 200, with default header values.
+
+Successfully updated the autoscale policy.
+*/
+type UpsertFirehoseAutoscalePolicyOK struct {
+	Payload *models.AutoscalePolicy
+}
+
+// IsSuccess returns true when this upsert firehose autoscale policy o k response has a 2xx status code
+func (o *UpsertFirehoseAutoscalePolicyOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this upsert firehose autoscale policy o k response has a 3xx status code
+func (o *UpsertFirehoseAutoscalePolicyOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this upsert firehose autoscale policy o k response has a 4xx status code
+func (o *UpsertFirehoseAutoscalePolicyOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this upsert firehose autoscale policy o k response has a 5xx status code
+func (o *UpsertFirehoseAutoscalePolicyOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this upsert firehose autoscale policy o k response a status code equal to that given
+func (o *UpsertFirehoseAutoscalePolicyOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *UpsertFirehoseAutoscalePolicyOK) Error() string {
+	return fmt.Sprintf("[PUT /projects/{projectSlug}/firehoses/{firehoseUrn}/autoscalePolicy][%d] upsertFirehoseAutoscalePolicyOK  %+v", 200, o.Payload)
+}
+
+func (o *UpsertFirehoseAutoscalePolicyOK) String() string {
+	return fmt.Sprintf("[PUT /projects/{projectSlug}/firehoses/{firehoseUrn}/autoscalePolicy][%d] upsertFirehoseAutoscalePolicyOK  %+v", 200, o.Payload)
+}
+
+func (o *UpsertFirehoseAutoscalePolicyOK) GetPayload() *models.AutoscalePolicy {
+	return o.Payload
+}
+
+func (o *UpsertFirehoseAutoscalePolicyOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.AutoscalePolicy)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpsertFirehoseAutoscalePolicyBadRequest creates a UpsertFirehoseAutoscalePolicyBadRequest with default headers values
+func NewUpsertFirehoseAutoscalePolicyBadRequest() *UpsertFirehoseAutoscalePolicyBadRequest {
+	return &UpsertFirehoseAutoscalePolicyBadRequest{}
+}
+
+/*
+UpsertFirehoseAutoscalePolicyBadRequest describes a response with status code 400, with default header values.
+
+Request was invalid.
+*/
+type UpsertFirehoseAutoscalePolicyBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this upsert firehose autoscale policy bad request response has a 2xx status code
+func (o *UpsertFirehoseAutoscalePolicyBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this upsert firehose autoscale policy bad request response has a 3xx status code
+func (o *UpsertFirehoseAutoscalePolicyBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this upsert firehose autoscale policy bad request response has a 4xx status code
+func (o *UpsertFirehoseAutoscalePolicyBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this upsert firehose autoscale policy bad request response has a 5xx status code
+func (o *UpsertFirehoseAutoscalePolicyBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this upsert firehose autoscale policy bad request response a status code equal to that given
+func (o *UpsertFirehoseAutoscalePolicyBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *UpsertFirehoseAutoscalePolicyBadRequest) Error() string {
+	return fmt.Sprintf("[PUT /projects/{projectSlug}/firehoses/{firehoseUrn}/autoscalePolicy][%d] upsertFirehoseAutoscalePolicyBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *UpsertFirehoseAutoscalePolicyBadRequest) String() string {
+	return fmt.Sprintf("[PUT /projects/{projectSlug}/firehoses/{firehoseUrn}/autoscalePolicy][%d] upsertFirehoseAutoscalePolicyBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *UpsertFirehoseAutoscalePolicyBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *UpsertFirehoseAutoscalePolicyBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpsertFirehoseAutoscalePolicyNotFound creates a UpsertFirehoseAutoscalePolicyNotFound with default headers values
+func NewUpsertFirehoseAutoscalePolicyNotFound() *UpsertFirehoseAutoscalePolicyNotFound {
+	return &UpsertFirehoseAutoscalePolicyNotFound{}
+}
+
+/*
+UpsertFirehoseAutoscalePolicyNotFound describes a response with status code 404, with default header values.
+
+Firehose with given URN was not found
+*/
+type UpsertFirehoseAutoscalePolicyNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this upsert firehose autoscale policy not found response has a 2xx status code
+func (o *UpsertFirehoseAutoscalePolicyNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this upsert firehose autoscale policy not found response has a 3xx status code
+func (o *UpsertFirehoseAutoscalePolicyNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this upsert firehose autoscale policy not found response has a 4xx status code
+func (o *UpsertFirehoseAutoscalePolicyNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this upsert firehose autoscale policy not found response has a 5xx status code
+func (o *UpsertFirehoseAutoscalePolicyNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this upsert firehose autoscale policy not found response a status code equal to that given
+func (o *UpsertFirehoseAutoscalePolicyNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *UpsertFirehoseAutoscalePolicyNotFound) Error() string {
+	return fmt.Sprintf("[PUT /projects/{projectSlug}/firehoses/{firehoseUrn}/autoscalePolicy][%d] upsertFirehoseAutoscalePolicyNotFound  %+v", 404, o.Payload)
+}
+
+func (o *UpsertFirehoseAutoscalePolicyNotFound) String() string {
+	return fmt.Sprintf("[PUT /projects/{projectSlug}/firehoses/{firehoseUrn}/autoscalePolicy][%d] upsertFirehoseAutoscalePolicyNotFound  %+v", 404, o.Payload)
+}
+
+func (o *UpsertFirehoseAutoscalePolicyNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *UpsertFirehoseAutoscalePolicyNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpsertFirehoseAutoscalePolicyInternalServerError creates a UpsertFirehoseAutoscalePolicyInternalServerError with default headers values
+func NewUpsertFirehoseAutoscalePolicyInternalServerError() *UpsertFirehoseAutoscalePolicyInternalServerError {
+	return &UpsertFirehoseAutoscalePolicyInternalServerError{}
+}
+
+/*
+UpsertFirehoseAutoscalePolicyInternalServerError describes a response with status code 500, with default header values.
+
+internal error
+*/
+type UpsertFirehoseAutoscalePolicyInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this upsert firehose autoscale policy internal server error response has a 2xx status code
+func (o *UpsertFirehoseAutoscalePolicyInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this upsert firehose autoscale policy internal server error response has a 3xx status code
+func (o *UpsertFirehoseAutoscalePolicyInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this upsert firehose autoscale policy internal server error response has a 4xx status code
+func (o *UpsertFirehoseAutoscalePolicyInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this upsert firehose autoscale policy internal server error response has a 5xx status code
+func (o *UpsertFirehoseAutoscalePolicyInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this upsert firehose autoscale policy internal server error response a status code equal to that given
+func (o *UpsertFirehoseAutoscalePolicyInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *UpsertFirehoseAutoscalePolicyInternalServerError) Error() string {
+	return fmt.Sprintf("[PUT /projects/{projectSlug}/firehoses/{firehoseUrn}/autoscalePolicy][%d] upsertFirehoseAutoscalePolicyInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *UpsertFirehoseAutoscalePolicyInternalServerError) String() string {
+	return fmt.Sprintf("[PUT /projects/{projectSlug}/firehoses/{firehoseUrn}/autoscalePolicy][%d] upsertFirehoseAutoscalePolicyInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *UpsertFirehoseAutoscalePolicyInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *UpsertFirehoseAutoscalePolicyInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*
+UpsertFirehoseAutoscalePolicyBody upsert firehose autoscale policy body
+swagger:model UpsertFirehoseAutoscalePolicyBody
+*/
+type UpsertFirehoseAutoscalePolicyBody struct {
+
+	// Minimum duration between two scalings of the firehose.
+	CooldownSeconds int64 `json:"cooldown_seconds,omitempty"`
+
+	// Whether the policy is evaluated. Defaults to true.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// max replicas
+	// Required: true
+	// Minimum: 1
+	MaxReplicas *int64 `json:"max_replicas"`
+
+	// min replicas
+	// Required: true
+	// Minimum: 1
+	MinReplicas *int64 `json:"min_replicas"`
+
+	// Total consumer lag across the partitions of the topic to maintain.
+	// Required: true
+	// Minimum: 1
+	TargetLag *int64 `json:"target_lag"`
+}
+
+// Validate validates this upsert firehose autoscale policy body
+func (o *UpsertFirehoseAutoscalePolicyBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateMaxReplicas(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateMinReplicas(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateTargetLag(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *UpsertFirehoseAutoscalePolicyBody) validateMaxReplicas(formats strfmt.Registry) error {
+
+	if err := validate.Required("body"+"."+"max_replicas", "body", o.MaxReplicas); err != nil {
+		return err
+	}
+
+	if err := validate.MinimumInt("body"+"."+"max_replicas", "body", *o.MaxReplicas, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *UpsertFirehoseAutoscalePolicyBody) validateMinReplicas(formats strfmt.Registry) error {
+
+	if err := validate.Required("body"+"."+"min_replicas", "body", o.MinReplicas); err != nil {
+		return err
+	}
+
+	if err := validate.MinimumInt("body"+"."+"min_replicas", "body", *o.MinReplicas, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *UpsertFirehoseAutoscalePolicyBody) validateTargetLag(formats strfmt.Registry) error {
+
+	if err := validate.Required("body"+"."+"target_lag", "body", o.TargetLag); err != nil {
+		return err
+	}
+
+	if err := validate.MinimumInt("body"+"."+"target_lag", "body", *o.TargetLag, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this upsert firehose autoscale policy body based on context it is used
+func (o *UpsertFirehoseAutoscalePolicyBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *UpsertFirehoseAutoscalePolicyBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *UpsertFirehoseAutoscalePolicyBody) UnmarshalBinary(b []byte) error {
+	var res UpsertFirehoseAutoscalePolicyBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
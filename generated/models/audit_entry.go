@@ -0,0 +1,159 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// AuditEntry audit entry
+//
+// swagger:model AuditEntry
+type AuditEntry struct {
+
+	// action
+	Action string `json:"action,omitempty"`
+
+	// actor
+	Actor string `json:"actor,omitempty"`
+
+	// actor id
+	ActorID string `json:"actor_id,omitempty"`
+
+	// error
+	Error string `json:"error,omitempty"`
+
+	// id
+	ID string `json:"id,omitempty"`
+
+	// latency ms
+	LatencyMs int64 `json:"latency_ms,omitempty"`
+
+	// params
+	Params interface{} `json:"params,omitempty"`
+
+	// project
+	Project string `json:"project,omitempty"`
+
+	// request id
+	RequestID string `json:"request_id,omitempty"`
+
+	// result
+	// Enum: [success failure]
+	Result string `json:"result,omitempty"`
+
+	// status code
+	StatusCode int64 `json:"status_code,omitempty"`
+
+	// timestamp
+	// Example: 2022-06-23T16:49:15.885541Z
+	// Format: date-time
+	Timestamp strfmt.DateTime `json:"timestamp,omitempty"`
+
+	// urn
+	Urn string `json:"urn,omitempty"`
+}
+
+// Validate validates this audit entry
+func (m *AuditEntry) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateResult(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTimestamp(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var auditEntryTypeResultPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["success","failure"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		auditEntryTypeResultPropEnum = append(auditEntryTypeResultPropEnum, v)
+	}
+}
+
+const (
+
+	// AuditEntryResultSuccess captures enum value "success"
+	AuditEntryResultSuccess string = "success"
+
+	// AuditEntryResultFailure captures enum value "failure"
+	AuditEntryResultFailure string = "failure"
+)
+
+// prop value enum
+func (m *AuditEntry) validateResultEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, auditEntryTypeResultPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *AuditEntry) validateResult(formats strfmt.Registry) error {
+	if swag.IsZero(m.Result) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateResultEnum("result", "body", m.Result); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AuditEntry) validateTimestamp(formats strfmt.Registry) error {
+	if swag.IsZero(m.Timestamp) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("timestamp", "body", "date-time", m.Timestamp.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this audit entry based on context it is used
+func (m *AuditEntry) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *AuditEntry) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *AuditEntry) UnmarshalBinary(b []byte) error {
+	var res AuditEntry
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
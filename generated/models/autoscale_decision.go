@@ -0,0 +1,98 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// AutoscaleDecision Outcome of an evaluation of the policy. 'scaled' is false if the replicas were
+// unchanged, if the change was held until the cooldown ends or if the scaling failed.
+// Consecutive unchanged or held decisions are collapsed into the latest one.
+//
+// swagger:model AutoscaleDecision
+type AutoscaleDecision struct {
+
+	// at
+	// Format: date-time
+	At strfmt.DateTime `json:"at,omitempty"`
+
+	// current replicas
+	CurrentReplicas int64 `json:"current_replicas,omitempty"`
+
+	// desired replicas
+	DesiredReplicas int64 `json:"desired_replicas,omitempty"`
+
+	// error
+	Error string `json:"error,omitempty"`
+
+	// lag
+	Lag int64 `json:"lag,omitempty"`
+
+	// operation id
+	OperationID string `json:"operation_id,omitempty"`
+
+	// reason
+	// Example: lag 12000 is above target 5000
+	Reason string `json:"reason,omitempty"`
+
+	// scaled
+	Scaled bool `json:"scaled,omitempty"`
+}
+
+// Validate validates this autoscale decision
+func (m *AutoscaleDecision) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *AutoscaleDecision) validateAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.At) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("at", "body", "date-time", m.At.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this autoscale decision based on context it is used
+func (m *AutoscaleDecision) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *AutoscaleDecision) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *AutoscaleDecision) UnmarshalBinary(b []byte) error {
+	var res AutoscaleDecision
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
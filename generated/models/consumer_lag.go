@@ -0,0 +1,125 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// ConsumerLag consumer lag
+//
+// swagger:model ConsumerLag
+type ConsumerLag struct {
+
+	// consumer group
+	ConsumerGroup string `json:"consumer_group,omitempty"`
+
+	// partitions
+	Partitions []*PartitionLag `json:"partitions"`
+
+	// topic
+	Topic string `json:"topic,omitempty"`
+
+	// Sum of the lag of the partitions with a committed offset.
+	TotalLag int64 `json:"total_lag"`
+}
+
+// Validate validates this consumer lag
+func (m *ConsumerLag) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validatePartitions(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ConsumerLag) validatePartitions(formats strfmt.Registry) error {
+	if swag.IsZero(m.Partitions) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Partitions); i++ {
+		if swag.IsZero(m.Partitions[i]) { // not required
+			continue
+		}
+
+		if m.Partitions[i] != nil {
+			if err := m.Partitions[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("partitions" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("partitions" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this consumer lag based on the context it is used
+func (m *ConsumerLag) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidatePartitions(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ConsumerLag) contextValidatePartitions(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Partitions); i++ {
+
+		if m.Partitions[i] != nil {
+			if err := m.Partitions[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("partitions" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("partitions" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ConsumerLag) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ConsumerLag) UnmarshalBinary(b []byte) error {
+	var res ConsumerLag
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
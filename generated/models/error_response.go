@@ -8,6 +8,7 @@ package models
 import (
 	"context"
 	"encoding/json"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -29,6 +30,9 @@ type ErrorResponse struct {
 	// Enum: [conflict not_found bad_request internal_error]
 	Code string `json:"code,omitempty"`
 
+	// Problems with specific fields of the request, if any.
+	Details []*ErrorResponseDetailsItems0 `json:"details"`
+
 	// message
 	// Example: Request is invalid
 	Message string `json:"message,omitempty"`
@@ -42,6 +46,10 @@ func (m *ErrorResponse) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateDetails(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -96,8 +104,63 @@ func (m *ErrorResponse) validateCode(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this error response based on context it is used
+func (m *ErrorResponse) validateDetails(formats strfmt.Registry) error {
+	if swag.IsZero(m.Details) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Details); i++ {
+		if swag.IsZero(m.Details[i]) { // not required
+			continue
+		}
+
+		if m.Details[i] != nil {
+			if err := m.Details[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("details" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("details" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this error response based on the context it is used
 func (m *ErrorResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateDetails(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ErrorResponse) contextValidateDetails(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Details); i++ {
+
+		if m.Details[i] != nil {
+			if err := m.Details[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("details" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("details" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
@@ -118,3 +181,45 @@ func (m *ErrorResponse) UnmarshalBinary(b []byte) error {
 	*m = res
 	return nil
 }
+
+// ErrorResponseDetailsItems0 error response details items0
+//
+// swagger:model ErrorResponseDetailsItems0
+type ErrorResponseDetailsItems0 struct {
+
+	// field
+	// Example: configs.env_vars.SINK_HTTP_SERVICE_URL
+	Field string `json:"field,omitempty"`
+
+	// message
+	// Example: must be set
+	Message string `json:"message,omitempty"`
+}
+
+// Validate validates this error response details items0
+func (m *ErrorResponseDetailsItems0) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this error response details items0 based on context it is used
+func (m *ErrorResponseDetailsItems0) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ErrorResponseDetailsItems0) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ErrorResponseDetailsItems0) UnmarshalBinary(b []byte) error {
+	var res ErrorResponseDetailsItems0
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
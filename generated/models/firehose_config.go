@@ -27,7 +27,10 @@ type FirehoseConfig struct {
 	// Required: true
 	ConsumerGroupID *string `json:"consumer_group_id"`
 
-	// env vars
+	// Env vars for the firehose. Values can be 'secret://<name>/<key>' references which
+	// are resolved by the server from the secrets of the project of the firehose.
+	// Resolved values are never returned in responses.
+	//
 	EnvVars map[string]string `json:"env_vars,omitempty"`
 
 	// input schema proto class
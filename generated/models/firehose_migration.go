@@ -0,0 +1,235 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// FirehoseMigration firehose migration
+//
+// swagger:model FirehoseMigration
+type FirehoseMigration struct {
+
+	// created by
+	CreatedBy string `json:"created_by,omitempty"`
+
+	// error
+	Error string `json:"error,omitempty"`
+
+	// finished at
+	// Format: date-time
+	FinishedAt strfmt.DateTime `json:"finished_at,omitempty"`
+
+	// from cluster
+	FromCluster string `json:"from_cluster,omitempty"`
+
+	// id
+	ID string `json:"id,omitempty"`
+
+	// Offsets committed by the consumer group before the migration, by topic and partition.
+	Offsets map[string]map[string]int64 `json:"offsets,omitempty"`
+
+	// started at
+	// Format: date-time
+	StartedAt strfmt.DateTime `json:"started_at,omitempty"`
+
+	// status
+	// Enum: [RUNNING SUCCEEDED ROLLED_BACK FAILED]
+	Status string `json:"status,omitempty"`
+
+	// steps
+	Steps []*FirehoseMigrationStep `json:"steps"`
+
+	// to cluster
+	ToCluster string `json:"to_cluster,omitempty"`
+
+	// urn
+	Urn string `json:"urn,omitempty"`
+}
+
+// Validate validates this firehose migration
+func (m *FirehoseMigration) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateFinishedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStartedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSteps(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *FirehoseMigration) validateFinishedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.FinishedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("finished_at", "body", "date-time", m.FinishedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *FirehoseMigration) validateStartedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.StartedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("started_at", "body", "date-time", m.StartedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var firehoseMigrationTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["RUNNING","SUCCEEDED","ROLLED_BACK","FAILED"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		firehoseMigrationTypeStatusPropEnum = append(firehoseMigrationTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// FirehoseMigrationStatusRUNNING captures enum value "RUNNING"
+	FirehoseMigrationStatusRUNNING string = "RUNNING"
+
+	// FirehoseMigrationStatusSUCCEEDED captures enum value "SUCCEEDED"
+	FirehoseMigrationStatusSUCCEEDED string = "SUCCEEDED"
+
+	// FirehoseMigrationStatusROLLEDBACK captures enum value "ROLLED_BACK"
+	FirehoseMigrationStatusROLLEDBACK string = "ROLLED_BACK"
+
+	// FirehoseMigrationStatusFAILED captures enum value "FAILED"
+	FirehoseMigrationStatusFAILED string = "FAILED"
+)
+
+// prop value enum
+func (m *FirehoseMigration) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, firehoseMigrationTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *FirehoseMigration) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *FirehoseMigration) validateSteps(formats strfmt.Registry) error {
+	if swag.IsZero(m.Steps) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Steps); i++ {
+		if swag.IsZero(m.Steps[i]) { // not required
+			continue
+		}
+
+		if m.Steps[i] != nil {
+			if err := m.Steps[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("steps" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("steps" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this firehose migration based on the context it is used
+func (m *FirehoseMigration) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateSteps(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *FirehoseMigration) contextValidateSteps(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Steps); i++ {
+
+		if m.Steps[i] != nil {
+			if err := m.Steps[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("steps" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("steps" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *FirehoseMigration) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *FirehoseMigration) UnmarshalBinary(b []byte) error {
+	var res FirehoseMigration
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
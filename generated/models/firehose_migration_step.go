@@ -0,0 +1,219 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// FirehoseMigrationStep firehose migration step
+//
+// swagger:model FirehoseMigrationStep
+type FirehoseMigrationStep struct {
+
+	// error
+	Error string `json:"error,omitempty"`
+
+	// finished at
+	// Format: date-time
+	FinishedAt strfmt.DateTime `json:"finished_at,omitempty"`
+
+	// name
+	// Enum: [stop record_offsets delete create start rollback]
+	Name string `json:"name,omitempty"`
+
+	// started at
+	// Format: date-time
+	StartedAt strfmt.DateTime `json:"started_at,omitempty"`
+
+	// status
+	// Enum: [PENDING RUNNING SUCCEEDED FAILED SKIPPED]
+	Status string `json:"status,omitempty"`
+}
+
+// Validate validates this firehose migration step
+func (m *FirehoseMigrationStep) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateFinishedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStartedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *FirehoseMigrationStep) validateFinishedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.FinishedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("finished_at", "body", "date-time", m.FinishedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var firehoseMigrationStepTypeNamePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["stop","record_offsets","delete","create","start","rollback"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		firehoseMigrationStepTypeNamePropEnum = append(firehoseMigrationStepTypeNamePropEnum, v)
+	}
+}
+
+const (
+
+	// FirehoseMigrationStepNameStop captures enum value "stop"
+	FirehoseMigrationStepNameStop string = "stop"
+
+	// FirehoseMigrationStepNameRecordOffsets captures enum value "record_offsets"
+	FirehoseMigrationStepNameRecordOffsets string = "record_offsets"
+
+	// FirehoseMigrationStepNameDelete captures enum value "delete"
+	FirehoseMigrationStepNameDelete string = "delete"
+
+	// FirehoseMigrationStepNameCreate captures enum value "create"
+	FirehoseMigrationStepNameCreate string = "create"
+
+	// FirehoseMigrationStepNameStart captures enum value "start"
+	FirehoseMigrationStepNameStart string = "start"
+
+	// FirehoseMigrationStepNameRollback captures enum value "rollback"
+	FirehoseMigrationStepNameRollback string = "rollback"
+)
+
+// prop value enum
+func (m *FirehoseMigrationStep) validateNameEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, firehoseMigrationStepTypeNamePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *FirehoseMigrationStep) validateName(formats strfmt.Registry) error {
+	if swag.IsZero(m.Name) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateNameEnum("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *FirehoseMigrationStep) validateStartedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.StartedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("started_at", "body", "date-time", m.StartedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var firehoseMigrationStepTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["PENDING","RUNNING","SUCCEEDED","FAILED","SKIPPED"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		firehoseMigrationStepTypeStatusPropEnum = append(firehoseMigrationStepTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// FirehoseMigrationStepStatusPENDING captures enum value "PENDING"
+	FirehoseMigrationStepStatusPENDING string = "PENDING"
+
+	// FirehoseMigrationStepStatusRUNNING captures enum value "RUNNING"
+	FirehoseMigrationStepStatusRUNNING string = "RUNNING"
+
+	// FirehoseMigrationStepStatusSUCCEEDED captures enum value "SUCCEEDED"
+	FirehoseMigrationStepStatusSUCCEEDED string = "SUCCEEDED"
+
+	// FirehoseMigrationStepStatusFAILED captures enum value "FAILED"
+	FirehoseMigrationStepStatusFAILED string = "FAILED"
+
+	// FirehoseMigrationStepStatusSKIPPED captures enum value "SKIPPED"
+	FirehoseMigrationStepStatusSKIPPED string = "SKIPPED"
+)
+
+// prop value enum
+func (m *FirehoseMigrationStep) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, firehoseMigrationStepTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *FirehoseMigrationStep) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this firehose migration step based on context it is used
+func (m *FirehoseMigrationStep) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *FirehoseMigrationStep) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *FirehoseMigrationStep) UnmarshalBinary(b []byte) error {
+	var res FirehoseMigrationStep
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,114 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// FirehoseRollback firehose rollback
+//
+// swagger:model FirehoseRollback
+type FirehoseRollback struct {
+
+	// Changes to the description & configs of the firehose, in the same format as history.
+	Diff interface{} `json:"diff,omitempty"`
+
+	// firehose
+	Firehose *Firehose `json:"firehose,omitempty"`
+
+	// reason
+	// Example: rollback to revision 3
+	Reason string `json:"reason,omitempty"`
+
+	// Number of the revision the firehose was rolled back to.
+	Revision int64 `json:"revision,omitempty"`
+}
+
+// Validate validates this firehose rollback
+func (m *FirehoseRollback) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateFirehose(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *FirehoseRollback) validateFirehose(formats strfmt.Registry) error {
+	if swag.IsZero(m.Firehose) { // not required
+		return nil
+	}
+
+	if m.Firehose != nil {
+		if err := m.Firehose.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("firehose")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("firehose")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this firehose rollback based on the context it is used
+func (m *FirehoseRollback) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateFirehose(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *FirehoseRollback) contextValidateFirehose(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Firehose != nil {
+		if err := m.Firehose.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("firehose")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("firehose")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *FirehoseRollback) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *FirehoseRollback) UnmarshalBinary(b []byte) error {
+	var res FirehoseRollback
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,75 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// FirehoseTemplateConfig Partial firehose configs. String values can contain '${variable}'
+// placeholders which are replaced when the template is rendered.
+//
+// swagger:model FirehoseTemplateConfig
+type FirehoseTemplateConfig struct {
+
+	// bootstrap servers
+	BootstrapServers string `json:"bootstrap_servers,omitempty"`
+
+	// consumer group id
+	ConsumerGroupID string `json:"consumer_group_id,omitempty"`
+
+	// env vars
+	EnvVars map[string]string `json:"env_vars,omitempty"`
+
+	// input schema proto class
+	InputSchemaProtoClass string `json:"input_schema_proto_class,omitempty"`
+
+	// replicas
+	Replicas float64 `json:"replicas,omitempty"`
+
+	// sink type
+	SinkType string `json:"sink_type,omitempty"`
+
+	// stop date
+	StopDate string `json:"stop_date,omitempty"`
+
+	// stream name
+	StreamName string `json:"stream_name,omitempty"`
+
+	// topic name
+	TopicName string `json:"topic_name,omitempty"`
+}
+
+// Validate validates this firehose template config
+func (m *FirehoseTemplateConfig) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this firehose template config based on context it is used
+func (m *FirehoseTemplateConfig) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *FirehoseTemplateConfig) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *FirehoseTemplateConfig) UnmarshalBinary(b []byte) error {
+	var res FirehoseTemplateConfig
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
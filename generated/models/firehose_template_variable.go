@@ -0,0 +1,81 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// FirehoseTemplateVariable firehose template variable
+//
+// swagger:model FirehoseTemplateVariable
+type FirehoseTemplateVariable struct {
+
+	// default
+	Default string `json:"default,omitempty"`
+
+	// description
+	Description string `json:"description,omitempty"`
+
+	// name
+	// Example: topic
+	// Required: true
+	Name *string `json:"name"`
+
+	// required
+	Required bool `json:"required,omitempty"`
+}
+
+// Validate validates this firehose template variable
+func (m *FirehoseTemplateVariable) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *FirehoseTemplateVariable) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this firehose template variable based on context it is used
+func (m *FirehoseTemplateVariable) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *FirehoseTemplateVariable) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *FirehoseTemplateVariable) UnmarshalBinary(b []byte) error {
+	var res FirehoseTemplateVariable
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,259 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Operation operation
+//
+// swagger:model Operation
+type Operation struct {
+
+	// action
+	// Example: scale
+	Action string `json:"action,omitempty"`
+
+	// actor
+	Actor string `json:"actor,omitempty"`
+
+	// actor id
+	ActorID string `json:"actor_id,omitempty"`
+
+	// created at
+	// Format: date-time
+	CreatedAt strfmt.DateTime `json:"created_at,omitempty"`
+
+	// error
+	Error string `json:"error,omitempty"`
+
+	// finished at
+	// Format: date-time
+	FinishedAt strfmt.DateTime `json:"finished_at,omitempty"`
+
+	// id
+	ID string `json:"id,omitempty"`
+
+	// params
+	Params interface{} `json:"params,omitempty"`
+
+	// project
+	Project string `json:"project,omitempty"`
+
+	// request id
+	RequestID string `json:"request_id,omitempty"`
+
+	// status
+	// Enum: [RUNNING SUCCEEDED FAILED]
+	Status string `json:"status,omitempty"`
+
+	// transitions
+	Transitions []*OperationTransition `json:"transitions"`
+
+	// updated at
+	// Format: date-time
+	UpdatedAt strfmt.DateTime `json:"updated_at,omitempty"`
+
+	// urn
+	Urn string `json:"urn,omitempty"`
+}
+
+// Validate validates this operation
+func (m *Operation) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFinishedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTransitions(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUpdatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Operation) validateCreatedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.CreatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Operation) validateFinishedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.FinishedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("finished_at", "body", "date-time", m.FinishedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var operationTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["RUNNING","SUCCEEDED","FAILED"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		operationTypeStatusPropEnum = append(operationTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// OperationStatusRUNNING captures enum value "RUNNING"
+	OperationStatusRUNNING string = "RUNNING"
+
+	// OperationStatusSUCCEEDED captures enum value "SUCCEEDED"
+	OperationStatusSUCCEEDED string = "SUCCEEDED"
+
+	// OperationStatusFAILED captures enum value "FAILED"
+	OperationStatusFAILED string = "FAILED"
+)
+
+// prop value enum
+func (m *Operation) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, operationTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Operation) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Operation) validateTransitions(formats strfmt.Registry) error {
+	if swag.IsZero(m.Transitions) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Transitions); i++ {
+		if swag.IsZero(m.Transitions[i]) { // not required
+			continue
+		}
+
+		if m.Transitions[i] != nil {
+			if err := m.Transitions[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("transitions" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("transitions" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Operation) validateUpdatedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.UpdatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("updated_at", "body", "date-time", m.UpdatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this operation based on the context it is used
+func (m *Operation) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateTransitions(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Operation) contextValidateTransitions(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Transitions); i++ {
+
+		if m.Transitions[i] != nil {
+			if err := m.Transitions[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("transitions" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("transitions" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Operation) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Operation) UnmarshalBinary(b []byte) error {
+	var res Operation
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,62 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// PartitionLag partition lag
+//
+// swagger:model PartitionLag
+type PartitionLag struct {
+
+	// Offset committed by the consumer group. -1 if no offset is committed.
+	CommittedOffset int64 `json:"committed_offset"`
+
+	// Lag of the consumer group on the partition. -1 if no offset is committed.
+	Lag int64 `json:"lag"`
+
+	// log end offset
+	LogEndOffset int64 `json:"log_end_offset"`
+
+	// partition
+	Partition int64 `json:"partition"`
+
+	// topic
+	Topic string `json:"topic,omitempty"`
+}
+
+// Validate validates this partition lag
+func (m *PartitionLag) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this partition lag based on context it is used
+func (m *PartitionLag) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PartitionLag) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PartitionLag) UnmarshalBinary(b []byte) error {
+	var res PartitionLag
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
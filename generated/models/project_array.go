@@ -21,6 +21,12 @@ type ProjectArray struct {
 
 	// items
 	Items []*Project `json:"items"`
+
+	// Token to fetch the next page. Empty if this is the last page.
+	NextPageToken string `json:"next_page_token,omitempty"`
+
+	// Total number of items across all pages.
+	TotalCount int64 `json:"total_count,omitempty"`
 }
 
 // Validate validates this project array
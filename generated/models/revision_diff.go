@@ -19,20 +19,34 @@ import (
 // swagger:model RevisionDiff
 type RevisionDiff struct {
 
-	// diff
+	// Difference from the previous revision. An object in 'delta' format, a string in
+	// 'unified' format and an array of operations in 'jsonpatch' format.
+	//
 	Diff interface{} `json:"diff,omitempty"`
 
+	// id
+	ID string `json:"id,omitempty"`
+
 	// labels
 	Labels interface{} `json:"labels,omitempty"`
 
 	// reason
 	Reason string `json:"reason,omitempty"`
 
+	// Number of the revision, 1 being the oldest.
+	Revision int64 `json:"revision,omitempty"`
+
 	// updated at
 	// Example: 2022-06-23T16:49:15.885541Z
 	// Read Only: true
 	// Format: date-time
 	UpdatedAt strfmt.DateTime `json:"updated_at,omitempty"`
+
+	// updated by
+	UpdatedBy string `json:"updated_by,omitempty"`
+
+	// updated by email
+	UpdatedByEmail string `json:"updated_by_email,omitempty"`
 }
 
 // Validate validates this revision diff
@@ -0,0 +1,232 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Schedule schedule
+//
+// swagger:model Schedule
+type Schedule struct {
+
+	// action
+	// Example: stop
+	Action string `json:"action,omitempty"`
+
+	// created at
+	// Format: date-time
+	CreatedAt strfmt.DateTime `json:"created_at,omitempty"`
+
+	// created by
+	CreatedBy string `json:"created_by,omitempty"`
+
+	// created by email
+	CreatedByEmail string `json:"created_by_email,omitempty"`
+
+	// cron
+	// Example: 0 22 * * *
+	Cron string `json:"cron,omitempty"`
+
+	// enabled
+	Enabled bool `json:"enabled,omitempty"`
+
+	// id
+	ID string `json:"id,omitempty"`
+
+	// Scheduled time of the last run.
+	// Format: date-time
+	LastRunAt strfmt.DateTime `json:"last_run_at,omitempty"`
+
+	// Scheduled time of the next run. Not set for disabled schedules.
+	// Format: date-time
+	NextRunAt strfmt.DateTime `json:"next_run_at,omitempty"`
+
+	// params
+	Params interface{} `json:"params,omitempty"`
+
+	// project
+	Project string `json:"project,omitempty"`
+
+	// Most recent runs, latest first.
+	Runs []*ScheduleRun `json:"runs"`
+
+	// updated at
+	// Format: date-time
+	UpdatedAt strfmt.DateTime `json:"updated_at,omitempty"`
+
+	// updated by
+	UpdatedBy string `json:"updated_by,omitempty"`
+
+	// Email of the user who last updated the schedule. Runs are applied on their behalf.
+	UpdatedByEmail string `json:"updated_by_email,omitempty"`
+
+	// urn
+	Urn string `json:"urn,omitempty"`
+}
+
+// Validate validates this schedule
+func (m *Schedule) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLastRunAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNextRunAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRuns(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUpdatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Schedule) validateCreatedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.CreatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Schedule) validateLastRunAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.LastRunAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("last_run_at", "body", "date-time", m.LastRunAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Schedule) validateNextRunAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.NextRunAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("next_run_at", "body", "date-time", m.NextRunAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Schedule) validateRuns(formats strfmt.Registry) error {
+	if swag.IsZero(m.Runs) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Runs); i++ {
+		if swag.IsZero(m.Runs[i]) { // not required
+			continue
+		}
+
+		if m.Runs[i] != nil {
+			if err := m.Runs[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("runs" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("runs" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Schedule) validateUpdatedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.UpdatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("updated_at", "body", "date-time", m.UpdatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this schedule based on the context it is used
+func (m *Schedule) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateRuns(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Schedule) contextValidateRuns(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Runs); i++ {
+
+		if m.Runs[i] != nil {
+			if err := m.Runs[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("runs" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("runs" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Schedule) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Schedule) UnmarshalBinary(b []byte) error {
+	var res Schedule
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
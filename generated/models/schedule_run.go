@@ -0,0 +1,151 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ScheduleRun schedule run
+//
+// swagger:model ScheduleRun
+type ScheduleRun struct {
+
+	// error
+	Error string `json:"error,omitempty"`
+
+	// operation id
+	OperationID string `json:"operation_id,omitempty"`
+
+	// scheduled at
+	// Format: date-time
+	ScheduledAt strfmt.DateTime `json:"scheduled_at,omitempty"`
+
+	// started at
+	// Format: date-time
+	StartedAt strfmt.DateTime `json:"started_at,omitempty"`
+
+	// status
+	// Enum: [SUCCEEDED FAILED]
+	Status string `json:"status,omitempty"`
+}
+
+// Validate validates this schedule run
+func (m *ScheduleRun) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateScheduledAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStartedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ScheduleRun) validateScheduledAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.ScheduledAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("scheduled_at", "body", "date-time", m.ScheduledAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ScheduleRun) validateStartedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.StartedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("started_at", "body", "date-time", m.StartedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var scheduleRunTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["SUCCEEDED","FAILED"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		scheduleRunTypeStatusPropEnum = append(scheduleRunTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// ScheduleRunStatusSUCCEEDED captures enum value "SUCCEEDED"
+	ScheduleRunStatusSUCCEEDED string = "SUCCEEDED"
+
+	// ScheduleRunStatusFAILED captures enum value "FAILED"
+	ScheduleRunStatusFAILED string = "FAILED"
+)
+
+// prop value enum
+func (m *ScheduleRun) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, scheduleRunTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ScheduleRun) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this schedule run based on context it is used
+func (m *ScheduleRun) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ScheduleRun) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ScheduleRun) UnmarshalBinary(b []byte) error {
+	var res ScheduleRun
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
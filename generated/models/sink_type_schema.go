@@ -0,0 +1,277 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// SinkTypeSchema sink type schema
+//
+// swagger:model SinkTypeSchema
+type SinkTypeSchema struct {
+
+	// fields
+	Fields []*SinkTypeSchemaFieldsItems0 `json:"fields"`
+
+	// sink type
+	SinkType FirehoseSinkType `json:"sink_type,omitempty"`
+}
+
+// Validate validates this sink type schema
+func (m *SinkTypeSchema) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateFields(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSinkType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *SinkTypeSchema) validateFields(formats strfmt.Registry) error {
+	if swag.IsZero(m.Fields) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Fields); i++ {
+		if swag.IsZero(m.Fields[i]) { // not required
+			continue
+		}
+
+		if m.Fields[i] != nil {
+			if err := m.Fields[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("fields" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("fields" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *SinkTypeSchema) validateSinkType(formats strfmt.Registry) error {
+	if swag.IsZero(m.SinkType) { // not required
+		return nil
+	}
+
+	if err := m.SinkType.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("sink_type")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("sink_type")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this sink type schema based on the context it is used
+func (m *SinkTypeSchema) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateFields(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateSinkType(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *SinkTypeSchema) contextValidateFields(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Fields); i++ {
+
+		if m.Fields[i] != nil {
+			if err := m.Fields[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("fields" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("fields" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *SinkTypeSchema) contextValidateSinkType(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.SinkType.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("sink_type")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("sink_type")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SinkTypeSchema) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SinkTypeSchema) UnmarshalBinary(b []byte) error {
+	var res SinkTypeSchema
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
+
+// SinkTypeSchemaFieldsItems0 sink type schema fields items0
+//
+// swagger:model SinkTypeSchemaFieldsItems0
+type SinkTypeSchemaFieldsItems0 struct {
+
+	// allowed
+	Allowed []string `json:"allowed"`
+
+	// default
+	Default string `json:"default,omitempty"`
+
+	// description
+	Description string `json:"description,omitempty"`
+
+	// key
+	// Example: SINK_HTTP_SERVICE_URL
+	Key string `json:"key,omitempty"`
+
+	// required
+	Required bool `json:"required,omitempty"`
+
+	// type
+	// Enum: [string integer boolean url json enum]
+	Type string `json:"type,omitempty"`
+}
+
+// Validate validates this sink type schema fields items0
+func (m *SinkTypeSchemaFieldsItems0) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var sinkTypeSchemaFieldsItems0TypeTypePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["string","integer","boolean","url","json","enum"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		sinkTypeSchemaFieldsItems0TypeTypePropEnum = append(sinkTypeSchemaFieldsItems0TypeTypePropEnum, v)
+	}
+}
+
+const (
+
+	// SinkTypeSchemaFieldsItems0TypeString captures enum value "string"
+	SinkTypeSchemaFieldsItems0TypeString string = "string"
+
+	// SinkTypeSchemaFieldsItems0TypeInteger captures enum value "integer"
+	SinkTypeSchemaFieldsItems0TypeInteger string = "integer"
+
+	// SinkTypeSchemaFieldsItems0TypeBoolean captures enum value "boolean"
+	SinkTypeSchemaFieldsItems0TypeBoolean string = "boolean"
+
+	// SinkTypeSchemaFieldsItems0TypeURL captures enum value "url"
+	SinkTypeSchemaFieldsItems0TypeURL string = "url"
+
+	// SinkTypeSchemaFieldsItems0TypeJSON captures enum value "json"
+	SinkTypeSchemaFieldsItems0TypeJSON string = "json"
+
+	// SinkTypeSchemaFieldsItems0TypeEnum captures enum value "enum"
+	SinkTypeSchemaFieldsItems0TypeEnum string = "enum"
+)
+
+// prop value enum
+func (m *SinkTypeSchemaFieldsItems0) validateTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, sinkTypeSchemaFieldsItems0TypeTypePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *SinkTypeSchemaFieldsItems0) validateType(formats strfmt.Registry) error {
+	if swag.IsZero(m.Type) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateTypeEnum("type", "body", m.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this sink type schema fields items0 based on context it is used
+func (m *SinkTypeSchemaFieldsItems0) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SinkTypeSchemaFieldsItems0) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SinkTypeSchemaFieldsItems0) UnmarshalBinary(b []byte) error {
+	var res SinkTypeSchemaFieldsItems0
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,191 @@
+// Package audit records the mutating operations performed through the API
+// and provides the sinks to which the records are written.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/odpf/dex/pkg/errors"
+)
+
+// Results of an audited operation.
+const (
+	ResultSuccess = "success"
+	ResultFailure = "failure"
+)
+
+const queueSize = 1000
+
+var errNotQueryable = errors.ErrInvalid.
+	WithMsgf("audit log cannot be queried with the configured sinks. configure a 'file' sink")
+
+// Entry represents a single audited operation.
+type Entry struct {
+	ID         string          `json:"id"`
+	Timestamp  time.Time       `json:"timestamp"`
+	Actor      string          `json:"actor"`
+	ActorID    string          `json:"actor_id,omitempty"`
+	Project    string          `json:"project"`
+	URN        string          `json:"urn,omitempty"`
+	Action     string          `json:"action"`
+	Params     json.RawMessage `json:"params,omitempty"`
+	RequestID  string          `json:"request_id,omitempty"`
+	Result     string          `json:"result"`
+	StatusCode int             `json:"status_code,omitempty"`
+	Error      string          `json:"error,omitempty"`
+	LatencyMS  int64           `json:"latency_ms"`
+}
+
+// Filter represents the criteria for querying the audit log. Empty fields
+// are ignored.
+type Filter struct {
+	Project string
+	URN     string
+	Action  string
+	Actor   string
+	From    time.Time
+	To      time.Time
+	Limit   int
+}
+
+func (f Filter) match(e Entry) bool {
+	switch {
+	case f.Project != "" && e.Project != f.Project,
+		f.URN != "" && e.URN != f.URN,
+		f.Action != "" && e.Action != f.Action,
+		f.Actor != "" && e.Actor != f.Actor,
+		!f.From.IsZero() && e.Timestamp.Before(f.From),
+		!f.To.IsZero() && e.Timestamp.After(f.To):
+		return false
+	}
+	return true
+}
+
+// Sink is the destination of audit entries.
+type Sink interface {
+	Write(ctx context.Context, entry Entry) error
+	Close() error
+}
+
+// Reader is implemented by the sinks that support querying the entries.
+type Reader interface {
+	// List returns the entries matching the filter, most recent first.
+	List(ctx context.Context, filter Filter) ([]Entry, error)
+}
+
+// Config contains the configurations for the audit log.
+type Config struct {
+	Sinks []SinkConfig `mapstructure:"sinks"`
+}
+
+// SinkConfig contains the configuration for a sink. Type can be one of
+// 'stdout', 'file' or 'webhook'.
+type SinkConfig struct {
+	Type    string            `mapstructure:"type"`
+	Path    string            `mapstructure:"path"`
+	URL     string            `mapstructure:"url"`
+	Headers map[string]string `mapstructure:"headers"`
+	Timeout time.Duration     `mapstructure:"timeout"`
+}
+
+// Service writes audit entries to the sinks in the background so that the
+// requests are not blocked by slow sinks.
+type Service struct {
+	sinks  []Sink
+	reader Reader
+	queue  chan Entry
+	wg     sync.WaitGroup
+}
+
+// New returns a Service that writes to the sinks described by cfg.
+func New(cfg Config) (*Service, error) {
+	var sinks []Sink
+	for _, sc := range cfg.Sinks {
+		sink, err := newSink(sc)
+		if err != nil {
+			for _, s := range sinks {
+				_ = s.Close()
+			}
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+	return NewWithSinks(sinks...), nil
+}
+
+// NewWithSinks returns a Service that writes to the given sinks. The first
+// sink that implements Reader is used for querying.
+func NewWithSinks(sinks ...Sink) *Service {
+	svc := &Service{
+		sinks: sinks,
+		queue: make(chan Entry, queueSize),
+	}
+	for _, s := range sinks {
+		if r, ok := s.(Reader); ok && svc.reader == nil {
+			svc.reader = r
+		}
+	}
+
+	svc.wg.Add(1)
+	go svc.run()
+	return svc
+}
+
+// Record queues the entry to be written to all the sinks. Entries are
+// dropped (and logged) if the queue is full.
+func (svc *Service) Record(entry Entry) {
+	if svc == nil || len(svc.sinks) == 0 {
+		return
+	}
+
+	select {
+	case svc.queue <- entry:
+	default:
+		log.Printf("error: audit queue is full, dropping entry: %s %s %s", entry.Action, entry.URN, entry.Result)
+	}
+}
+
+// List returns the entries matching the filter, most recent first.
+func (svc *Service) List(ctx context.Context, filter Filter) ([]Entry, error) {
+	if svc == nil || svc.reader == nil {
+		return nil, errNotQueryable
+	}
+	return svc.reader.List(ctx, filter)
+}
+
+// Close waits for the queued entries to be written and closes the sinks.
+func (svc *Service) Close() error {
+	if svc == nil {
+		return nil
+	}
+	close(svc.queue)
+	svc.wg.Wait()
+
+	var errs []error
+	for _, s := range svc.sinks {
+		if err := s.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to close audit sinks: %v", errs)
+	}
+	return nil
+}
+
+func (svc *Service) run() {
+	defer svc.wg.Done()
+
+	for entry := range svc.queue {
+		for _, s := range svc.sinks {
+			if err := s.Write(context.Background(), entry); err != nil {
+				log.Printf("error: failed to write audit entry '%s': %v", entry.ID, err)
+			}
+		}
+	}
+}
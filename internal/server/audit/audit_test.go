@@ -0,0 +1,102 @@
+package audit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/odpf/dex/internal/server/reqctx"
+	"github.com/odpf/dex/internal/server/utils"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+func TestMiddleware(t *testing.T) {
+	t.Parallel()
+
+	sink, err := NewFileSink(filepath.Join(t.TempDir(), "audit.log"))
+	require.NoError(t, err)
+	svc := NewWithSinks(sink)
+
+	router := chi.NewRouter()
+	router.Use(reqctx.WithRequestCtx())
+	router.Route("/api", func(r chi.Router) {
+		// rejects the requests before they are routed, like authorization.
+		deny := func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if strings.HasSuffix(r.URL.Path, "/start") {
+					utils.WriteErr(w, errors.ErrForbidden.WithMsgf("user does not have manage permission"))
+					return
+				}
+				next.ServeHTTP(w, r)
+			})
+		}
+		r.Use(Middleware(svc, FirehoseActions), deny)
+		r.Route("/projects/{projectSlug}/firehoses", func(r chi.Router) {
+			r.Post("/", func(w http.ResponseWriter, r *http.Request) {
+				utils.WriteJSON(w, http.StatusCreated, map[string]string{"urn": "orn:foo"})
+			})
+			r.Post("/{urn}/scale", func(w http.ResponseWriter, r *http.Request) {
+				utils.WriteErr(w, errors.ErrInvalid.WithMsgf("replicas must be positive"))
+			})
+			r.Post("/{urn}/logs", func(w http.ResponseWriter, r *http.Request) {})
+			r.Post("/{urn}/start", func(w http.ResponseWriter, r *http.Request) {})
+		})
+	})
+
+	do := func(method, path, body string) {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("X-Auth-Email", "user@example.com")
+		router.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	do(http.MethodPost, "/api/projects/foo/firehoses", `{"title":"x","configs":{"env_vars":{"PASSWORD":"secret"}}}`)
+	do(http.MethodPost, "/api/projects/foo/firehoses?dry_run=true", `{"title":"y"}`)
+	do(http.MethodPost, "/api/projects/foo/firehoses/orn:bar/scale", `{"replicas":-1}`)
+	do(http.MethodPost, "/api/projects/foo/firehoses/orn:bar/logs", `{}`)
+	do(http.MethodPost, "/api/projects/foo/firehoses/orn:bar/start", `{}`)
+	require.NoError(t, svc.Close())
+
+	entries, err := sink.List(context.Background(), Filter{Project: "foo"})
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+
+	byAction := map[string]Entry{}
+	for _, e := range entries {
+		byAction[e.Action] = e
+	}
+
+	created := byAction["create"]
+	assert.Equal(t, "orn:foo", created.URN)
+	assert.Equal(t, "user@example.com", created.Actor)
+	assert.Equal(t, ResultSuccess, created.Result)
+	assert.Contains(t, string(created.Params), redactedValue)
+	assert.NotContains(t, string(created.Params), "secret")
+
+	scaled := byAction["scale"]
+	assert.Equal(t, "orn:bar", scaled.URN)
+	assert.Equal(t, ResultFailure, scaled.Result)
+	assert.Equal(t, http.StatusBadRequest, scaled.StatusCode)
+	assert.Equal(t, "replicas must be positive", scaled.Error)
+
+	denied := byAction["start"]
+	assert.Equal(t, "orn:bar", denied.URN)
+	assert.Equal(t, ResultFailure, denied.Result)
+	assert.Equal(t, http.StatusForbidden, denied.StatusCode)
+}
+
+func TestService_ListWithoutReader(t *testing.T) {
+	t.Parallel()
+
+	svc := NewWithSinks(&writerSink{w: &strings.Builder{}})
+	defer svc.Close()
+
+	_, err := svc.List(context.Background(), Filter{})
+	assert.ErrorIs(t, err, errors.ErrInvalid)
+}
@@ -0,0 +1,84 @@
+package audit
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/odpf/dex/internal/server/utils"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+const (
+	defaultListLimit = 100
+	maxListLimit     = 1000
+)
+
+// HandleList returns the handler for listing audit entries of a project.
+// Supports filtering by time-range (from, to), urn, action & actor.
+func HandleList(svc *Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		filter, err := parseFilter(r)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		entries, err := svc.List(r.Context(), filter)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+		if entries == nil {
+			entries = []Entry{}
+		}
+
+		utils.WriteJSON(w, http.StatusOK, utils.NewListResponse(entries))
+	}
+}
+
+func parseFilter(r *http.Request) (Filter, error) {
+	query := r.URL.Query()
+
+	filter := Filter{
+		Project: chi.URLParam(r, "projectSlug"),
+		URN:     strings.TrimSpace(query.Get("urn")),
+		Action:  strings.TrimSpace(query.Get("action")),
+		Actor:   strings.TrimSpace(query.Get("actor")),
+		Limit:   defaultListLimit,
+	}
+
+	var err error
+	if filter.From, err = parseTime(query.Get("from")); err != nil {
+		return filter, errors.ErrInvalid.WithMsgf("from must be a RFC3339 timestamp")
+	}
+	if filter.To, err = parseTime(query.Get("to")); err != nil {
+		return filter, errors.ErrInvalid.WithMsgf("to must be a RFC3339 timestamp")
+	}
+	if !filter.From.IsZero() && !filter.To.IsZero() && filter.To.Before(filter.From) {
+		return filter, errors.ErrInvalid.WithMsgf("to must not be before from")
+	}
+
+	if s := strings.TrimSpace(query.Get("limit")); s != "" {
+		limit, err := strconv.Atoi(s)
+		if err != nil || limit <= 0 {
+			return filter, errors.ErrInvalid.WithMsgf("limit must be a positive integer")
+		} else if limit > maxListLimit {
+			limit = maxListLimit
+		}
+		filter.Limit = limit
+	}
+
+	return filter, nil
+}
+
+func parseTime(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
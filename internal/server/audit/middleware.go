@@ -0,0 +1,223 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/xid"
+
+	"github.com/odpf/dex/internal/server/reqctx"
+)
+
+const (
+	maxCapturedBytes = 64 * 1024
+	redactedValue    = "[REDACTED]"
+)
+
+// Action identifies an audited route by the method and the suffix of its
+// route pattern.
+type Action struct {
+	Method  string
+	Pattern string
+	Name    string
+}
+
+// FirehoseActions are the mutating firehose operations that are audited.
+var FirehoseActions = []Action{
+	{Method: http.MethodPost, Pattern: "/firehoses", Name: "create"},
+	{Method: http.MethodPut, Pattern: "/firehoses/{urn}", Name: "update"},
+	{Method: http.MethodDelete, Pattern: "/firehoses/{urn}", Name: "delete"},
+	{Method: http.MethodPost, Pattern: "/firehoses/{urn}/start", Name: "start"},
+	{Method: http.MethodPost, Pattern: "/firehoses/{urn}/stop", Name: "stop"},
+	{Method: http.MethodPost, Pattern: "/firehoses/{urn}/scale", Name: "scale"},
+	{Method: http.MethodPost, Pattern: "/firehoses/{urn}/reset", Name: "reset"},
+	{Method: http.MethodPost, Pattern: "/firehoses/{urn}/upgrade", Name: "upgrade"},
+	{Method: http.MethodPost, Pattern: "/firehoses/{urn}/clone", Name: "clone"},
+	{Method: http.MethodPost, Pattern: "/firehoses/{urn}/migrate", Name: "migrate"},
+	{Method: http.MethodPost, Pattern: "/firehoses/{urn}/rollback", Name: "rollback"},
+	{Method: http.MethodPost, Pattern: "/firehoses/{urn}/schedules", Name: "schedule-create"},
+	{Method: http.MethodPut, Pattern: "/firehoses/{urn}/schedules/{scheduleID}", Name: "schedule-update"},
+	{Method: http.MethodDelete, Pattern: "/firehoses/{urn}/schedules/{scheduleID}", Name: "schedule-delete"},
+	{Method: http.MethodPut, Pattern: "/firehoses/{urn}/alertPolicy", Name: "alert-policy"},
+	{Method: http.MethodPut, Pattern: "/firehoses/{urn}/autoscalePolicy", Name: "autoscale-policy"},
+	{Method: http.MethodDelete, Pattern: "/firehoses/{urn}/autoscalePolicy", Name: "autoscale-policy-delete"},
+}
+
+// TemplateActions are the mutating firehose template operations that are
+// audited.
+var TemplateActions = []Action{
+	{Method: http.MethodPost, Pattern: "/firehoseTemplates", Name: "template-create"},
+	{Method: http.MethodPut, Pattern: "/firehoseTemplates/{name}", Name: "template-update"},
+	{Method: http.MethodDelete, Pattern: "/firehoseTemplates/{name}", Name: "template-delete"},
+}
+
+// Middleware returns an HTTP middleware that records an entry for each
+// request matching one of the actions. Dry-run requests are not recorded.
+func Middleware(svc *Service, actions []Action) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet || r.Method == http.MethodHead || isDryRun(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			startedAt := time.Now()
+			params := captureBody(r)
+			rec := &responseRecorder{ResponseWriter: w, status: http.StatusOK}
+
+			next.ServeHTTP(rec, r)
+
+			// the route is matched again since the request may be rejected
+			// (e.g., by authorization) before it is routed.
+			rCtx := chi.RouteContext(r.Context())
+			if rCtx == nil || rCtx.Routes == nil {
+				return
+			}
+			route := chi.NewRouteContext()
+			if !rCtx.Routes.Match(route, r.Method, r.URL.Path) {
+				return
+			}
+			action := matchAction(actions, r.Method, route.RoutePattern())
+			if action == "" {
+				return
+			}
+
+			reqCtx := reqctx.From(r.Context())
+			entry := Entry{
+				ID:         xid.New().String(),
+				Timestamp:  startedAt.UTC(),
+				Actor:      reqCtx.UserEmail,
+				ActorID:    reqCtx.UserID,
+				Project:    route.URLParam("projectSlug"),
+				URN:        route.URLParam("urn"),
+				Action:     action,
+				Params:     redact(params),
+				RequestID:  reqCtx.RequestID,
+				StatusCode: rec.status,
+				LatencyMS:  time.Since(startedAt).Milliseconds(),
+				Result:     ResultSuccess,
+			}
+
+			var resp struct {
+				URN     string `json:"urn"`
+				Message string `json:"message"`
+				Cause   string `json:"cause"`
+			}
+			_ = json.Unmarshal(rec.body.Bytes(), &resp)
+
+			if entry.URN == "" {
+				entry.URN = resp.URN
+			}
+			if rec.status >= http.StatusBadRequest {
+				entry.Result = ResultFailure
+				entry.Error = resp.Message
+				if entry.Error == "" {
+					entry.Error = resp.Cause
+				}
+			}
+
+			svc.Record(entry)
+		})
+	}
+}
+
+func matchAction(actions []Action, method, pattern string) string {
+	// chi reports the mount pattern without the trailing slash for the
+	// root route of a sub-router.
+	pattern = strings.TrimSuffix(pattern, "/")
+	for _, a := range actions {
+		if a.Method == method && strings.HasSuffix(pattern, a.Pattern) {
+			return a.Name
+		}
+	}
+	return ""
+}
+
+func isDryRun(r *http.Request) bool {
+	dryRun, _ := strconv.ParseBool(r.URL.Query().Get("dry_run"))
+	return dryRun
+}
+
+// captureBody reads (up to a limit) and restores the request body.
+func captureBody(r *http.Request) []byte {
+	if r.Body == nil {
+		return nil
+	}
+
+	buf, err := io.ReadAll(io.LimitReader(r.Body, maxCapturedBytes))
+	if err != nil {
+		return nil
+	}
+	r.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(buf), r.Body), r.Body}
+	return buf
+}
+
+// redact replaces the values of all the 'env_vars' maps in the JSON params
+// since they can contain credentials.
+func redact(params []byte) json.RawMessage {
+	if len(bytes.TrimSpace(params)) == 0 {
+		return nil
+	}
+
+	var v any
+	if err := json.Unmarshal(params, &v); err != nil {
+		return nil
+	}
+
+	b, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return nil
+	}
+	return b
+}
+
+func redactValue(v any) any {
+	switch val := v.(type) {
+	case map[string]any:
+		for k, child := range val {
+			if envVars, ok := child.(map[string]any); ok && k == "env_vars" {
+				for name := range envVars {
+					envVars[name] = redactedValue
+				}
+				continue
+			}
+			val[k] = redactValue(child)
+		}
+
+	case []any:
+		for i := range val {
+			val[i] = redactValue(val[i])
+		}
+	}
+	return v
+}
+
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (rr *responseRecorder) WriteHeader(status int) {
+	rr.status = status
+	rr.ResponseWriter.WriteHeader(status)
+}
+
+func (rr *responseRecorder) Write(b []byte) (int, error) {
+	if remaining := maxCapturedBytes - rr.body.Len(); remaining > 0 {
+		if len(b) < remaining {
+			remaining = len(b)
+		}
+		rr.body.Write(b[:remaining])
+	}
+	return rr.ResponseWriter.Write(b)
+}
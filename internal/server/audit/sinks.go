@@ -0,0 +1,177 @@
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Supported sink types.
+const (
+	SinkStdout  = "stdout"
+	SinkFile    = "file"
+	SinkWebhook = "webhook"
+
+	defaultWebhookTimeout = 5 * time.Second
+)
+
+func newSink(cfg SinkConfig) (Sink, error) {
+	switch strings.ToLower(strings.TrimSpace(cfg.Type)) {
+	case SinkStdout:
+		return &writerSink{w: os.Stdout}, nil
+
+	case SinkFile:
+		if cfg.Path == "" {
+			return nil, fmt.Errorf("audit sink 'file' requires path")
+		}
+		return NewFileSink(cfg.Path)
+
+	case SinkWebhook:
+		if cfg.URL == "" {
+			return nil, fmt.Errorf("audit sink 'webhook' requires url")
+		}
+		return NewWebhookSink(cfg.URL, cfg.Headers, cfg.Timeout), nil
+
+	default:
+		return nil, fmt.Errorf("audit sink type must be one of '%s', '%s' or '%s', not '%s'",
+			SinkStdout, SinkFile, SinkWebhook, cfg.Type)
+	}
+}
+
+// writerSink writes the entries as JSON lines to an io.Writer.
+type writerSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (ws *writerSink) Write(_ context.Context, entry Entry) error {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	_, err = ws.w.Write(append(b, '\n'))
+	return err
+}
+
+func (ws *writerSink) Close() error { return nil }
+
+// FileSink appends the entries as JSON lines to a file. The file is read
+// back to serve queries.
+type FileSink struct {
+	writerSink
+	path string
+	f    *os.File
+}
+
+func NewFileSink(path string) (*FileSink, error) {
+	const perm = 0o600
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, perm)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit file: %w", err)
+	}
+
+	return &FileSink{
+		writerSink: writerSink{w: f},
+		path:       path,
+		f:          f,
+	}, nil
+}
+
+func (fs *FileSink) List(ctx context.Context, filter Filter) ([]Entry, error) {
+	f, err := os.Open(fs.path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var entries []Entry
+	sc := bufio.NewScanner(f)
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for sc.Scan() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		var e Entry
+		if err := json.Unmarshal(sc.Bytes(), &e); err != nil {
+			continue // skip partially written lines.
+		}
+		if filter.match(e) {
+			entries = append(entries, e)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Timestamp.After(entries[j].Timestamp)
+	})
+	if filter.Limit > 0 && len(entries) > filter.Limit {
+		entries = entries[:filter.Limit]
+	}
+	return entries, nil
+}
+
+func (fs *FileSink) Close() error { return fs.f.Close() }
+
+// WebhookSink posts each entry as JSON to a URL.
+type WebhookSink struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+func NewWebhookSink(url string, headers map[string]string, timeout time.Duration) *WebhookSink {
+	if timeout <= 0 {
+		timeout = defaultWebhookTimeout
+	}
+
+	return &WebhookSink{
+		url:     url,
+		headers: headers,
+		client:  &http.Client{Timeout: timeout},
+	}
+}
+
+func (ws *WebhookSink) Write(ctx context.Context, entry Entry) error {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, ws.url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range ws.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := ws.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (ws *WebhookSink) Close() error { return nil }
@@ -0,0 +1,186 @@
+// Package authz provides the authorization checks for the project-scoped
+// HTTP APIs using Shield.
+package authz
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	shieldv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/shield/v1beta1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/odpf/dex/internal/server/reqctx"
+	"github.com/odpf/dex/internal/server/utils"
+	"github.com/odpf/dex/internal/server/v1/project"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+// Config contains the configurations for authorization.
+type Config struct {
+	// Enabled enables the permission checks. When disabled, all requests
+	// are allowed. Disabled by default so that upgraded deployments keep
+	// their access until the permissions are set up in Shield.
+	Enabled bool `mapstructure:"enabled" default:"false"`
+
+	// Namespace is the Shield namespace of the project resources.
+	Namespace string `mapstructure:"namespace" default:"project"`
+
+	// ViewPermission is required for read-only requests (GET, HEAD).
+	ViewPermission string `mapstructure:"view_permission" default:"view"`
+
+	// ManagePermission is required for all the mutating requests.
+	ManagePermission string `mapstructure:"manage_permission" default:"manage"`
+
+	// IdentityHeader is the header used to identify the user to Shield.
+	IdentityHeader string `mapstructure:"identity_header" default:"X-Shield-Email"`
+
+	// CacheTTL is the duration for which decisions are cached. Set to 0 to
+	// disable caching.
+	CacheTTL time.Duration `mapstructure:"cache_ttl" default:"30s"`
+}
+
+// Authorizer checks whether the user of the request has the permission
+// required for the requested operation on a project.
+type Authorizer struct {
+	cfg    Config
+	shield shieldv1beta1.ShieldServiceClient
+	cache  *decisionCache
+}
+
+func New(cfg Config, shield shieldv1beta1.ShieldServiceClient) *Authorizer {
+	return &Authorizer{
+		cfg:    cfg,
+		shield: shield,
+		cache:  newDecisionCache(cfg.CacheTTL),
+	}
+}
+
+// Middleware returns an HTTP middleware that enforces the permissions on
+// the project returned by projectSlug. Requests that are not scoped to a
+// project (i.e., projectSlug returns empty string) are allowed.
+func (az *Authorizer) Middleware(projectSlug func(r *http.Request) string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			slug := projectSlug(r)
+			if !az.cfg.Enabled || slug == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if err := az.Check(r, slug, az.PermissionFor(r)); err != nil {
+				utils.WriteErr(w, err)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// PermissionFor returns the permission required for the request. Reads
+// that ask for the sensitive values to be revealed need manage permission.
+func (az *Authorizer) PermissionFor(r *http.Request) string {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		if reveal, _ := strconv.ParseBool(r.URL.Query().Get("reveal")); reveal {
+			return az.cfg.ManagePermission
+		}
+		return az.cfg.ViewPermission
+	default:
+		return az.cfg.ManagePermission
+	}
+}
+
+// CheckView returns nil if the user of the request has the view permission
+// on the project. This is used by the handlers of routes that are not
+// project-scoped.
+func (az *Authorizer) CheckView(r *http.Request, projectSlug string) error {
+	return az.Check(r, projectSlug, az.cfg.ViewPermission)
+}
+
+// CheckManage returns nil if the user of the request has the manage
+// permission on the project. This is used by the handlers that act on
+// projects other than the one in the URL.
+func (az *Authorizer) CheckManage(r *http.Request, projectSlug string) error {
+	return az.Check(r, projectSlug, az.cfg.ManagePermission)
+}
+
+// CheckManageFor returns nil if the user in ctx has the manage permission
+// on the project. This is used by the actions run in the background on
+// behalf of a user (e.g., scheduled runs), since the permissions of the user
+// may have been revoked after the action was set up.
+func (az *Authorizer) CheckManageFor(ctx context.Context, projectSlug string) error {
+	return az.check(ctx, projectSlug, az.cfg.ManagePermission, func() (*shieldv1beta1.Project, error) {
+		return project.FindProjectBySlug(ctx, az.shield, projectSlug)
+	})
+}
+
+// Check returns nil if the user of the request has the permission on the
+// project. Returns ErrUnauthorized if the user is not known and ErrForbidden
+// if the user does not have the permission.
+func (az *Authorizer) Check(r *http.Request, projectSlug, permission string) error {
+	return az.check(r.Context(), projectSlug, permission, func() (*shieldv1beta1.Project, error) {
+		return project.FindProject(r, az.shield, projectSlug)
+	})
+}
+
+func (az *Authorizer) check(ctx context.Context, projectSlug, permission string,
+	findProject func() (*shieldv1beta1.Project, error),
+) error {
+	if !az.cfg.Enabled {
+		return nil
+	}
+
+	user := strings.TrimSpace(reqctx.From(ctx).UserEmail)
+	if user == "" {
+		return errors.ErrUnauthorized.WithMsgf("user identity is required")
+	}
+
+	key := decisionKey{User: user, Project: projectSlug, Permission: permission}
+	if allowed, found := az.cache.get(key); found {
+		return denyUnless(allowed, key)
+	}
+
+	prj, err := findProject()
+	if err != nil {
+		return err
+	}
+
+	allowed, err := az.checkShield(ctx, user, prj, permission)
+	if err != nil {
+		return err
+	}
+	az.cache.put(key, allowed)
+
+	return denyUnless(allowed, key)
+}
+
+func (az *Authorizer) checkShield(ctx context.Context, user string, prj *shieldv1beta1.Project, permission string) (bool, error) {
+	ctx = metadata.AppendToOutgoingContext(ctx, az.cfg.IdentityHeader, user)
+	resp, err := az.shield.CheckResourcePermission(ctx, &shieldv1beta1.CheckResourcePermissionRequest{
+		ObjectId:        prj.GetId(),
+		ObjectNamespace: az.cfg.Namespace,
+		Permission:      permission,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.PermissionDenied, codes.Unauthenticated, codes.NotFound:
+			return false, nil
+		default:
+			return false, errors.ErrInternal.WithCausef("permission check failed: %v", err)
+		}
+	}
+	return resp.GetStatus(), nil
+}
+
+func denyUnless(allowed bool, key decisionKey) error {
+	if allowed {
+		return nil
+	}
+	return errors.ErrForbidden.
+		WithMsgf("user '%s' does not have '%s' permission on project '%s'", key.User, key.Permission, key.Project)
+}
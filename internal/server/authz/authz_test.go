@@ -0,0 +1,144 @@
+package authz
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	shieldv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/shield/v1beta1"
+	"google.golang.org/grpc"
+
+	"github.com/odpf/dex/internal/server/reqctx"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+type fakeShield struct {
+	shieldv1beta1.ShieldServiceClient
+
+	allowed map[string]bool
+	checks  int
+}
+
+func (fs *fakeShield) ListProjects(_ context.Context, _ *shieldv1beta1.ListProjectsRequest, _ ...grpc.CallOption) (*shieldv1beta1.ListProjectsResponse, error) {
+	return &shieldv1beta1.ListProjectsResponse{
+		Projects: []*shieldv1beta1.Project{{Id: "p1", Slug: "foo"}},
+	}, nil
+}
+
+func (fs *fakeShield) CheckResourcePermission(_ context.Context, req *shieldv1beta1.CheckResourcePermissionRequest, _ ...grpc.CallOption) (*shieldv1beta1.CheckResourcePermissionResponse, error) {
+	fs.checks++
+	return &shieldv1beta1.CheckResourcePermissionResponse{
+		Status: req.GetObjectId() == "p1" && fs.allowed[req.GetPermission()],
+	}, nil
+}
+
+func TestAuthorizer_Middleware(t *testing.T) {
+	t.Parallel()
+
+	cfg := Config{
+		Enabled:          true,
+		Namespace:        "project",
+		ViewPermission:   "view",
+		ManagePermission: "manage",
+		IdentityHeader:   "X-Shield-Email",
+		CacheTTL:         time.Minute,
+	}
+
+	serveTarget := func(az *Authorizer, method, target, slug, user string) int {
+		handler := reqctx.WithRequestCtx()(
+			az.Middleware(func(r *http.Request) string { return slug })(
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusOK)
+				}),
+			),
+		)
+
+		req := httptest.NewRequest(method, target, nil)
+		if user != "" {
+			req.Header.Set("X-Auth-Email", user)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	serve := func(az *Authorizer, method, slug, user string) int {
+		return serveTarget(az, method, "/api/projects/foo/firehoses", slug, user)
+	}
+
+	t.Run("ViewerCanReadButNotManage", func(t *testing.T) {
+		shield := &fakeShield{allowed: map[string]bool{"view": true}}
+		az := New(cfg, shield)
+
+		assert.Equal(t, http.StatusOK, serve(az, http.MethodGet, "foo", "a@b.com"))
+		assert.Equal(t, http.StatusForbidden, serve(az, http.MethodPost, "foo", "a@b.com"))
+		assert.Equal(t, http.StatusForbidden, serve(az, http.MethodDelete, "foo", "a@b.com"))
+	})
+
+	t.Run("RevealNeedsManage", func(t *testing.T) {
+		target := "/api/projects/foo/firehoses/orn:bar?reveal=true"
+
+		viewer := New(cfg, &fakeShield{allowed: map[string]bool{"view": true}})
+		assert.Equal(t, http.StatusForbidden, serveTarget(viewer, http.MethodGet, target, "foo", "a@b.com"))
+
+		manager := New(cfg, &fakeShield{allowed: map[string]bool{"view": true, "manage": true}})
+		assert.Equal(t, http.StatusOK, serveTarget(manager, http.MethodGet, target, "foo", "a@b.com"))
+	})
+
+	t.Run("MissingIdentity", func(t *testing.T) {
+		az := New(cfg, &fakeShield{allowed: map[string]bool{"view": true}})
+		assert.Equal(t, http.StatusUnauthorized, serve(az, http.MethodGet, "foo", ""))
+	})
+
+	t.Run("UnknownProject", func(t *testing.T) {
+		az := New(cfg, &fakeShield{allowed: map[string]bool{"view": true}})
+		assert.Equal(t, http.StatusNotFound, serve(az, http.MethodGet, "bar", "a@b.com"))
+	})
+
+	t.Run("NotProjectScoped", func(t *testing.T) {
+		shield := &fakeShield{}
+		az := New(cfg, shield)
+		assert.Equal(t, http.StatusOK, serve(az, http.MethodGet, "", ""))
+		assert.Zero(t, shield.checks)
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		disabled := cfg
+		disabled.Enabled = false
+
+		az := New(disabled, &fakeShield{})
+		assert.Equal(t, http.StatusOK, serve(az, http.MethodDelete, "foo", ""))
+	})
+
+	t.Run("DecisionsAreCached", func(t *testing.T) {
+		now := time.Now()
+		shield := &fakeShield{allowed: map[string]bool{"manage": true}}
+		az := New(cfg, shield)
+		az.cache.now = func() time.Time { return now }
+
+		assert.Equal(t, http.StatusOK, serve(az, http.MethodPut, "foo", "a@b.com"))
+		assert.Equal(t, http.StatusOK, serve(az, http.MethodPut, "foo", "a@b.com"))
+		assert.Equal(t, 1, shield.checks)
+
+		// revoked permission is honoured after the ttl.
+		shield.allowed["manage"] = false
+		now = now.Add(2 * time.Minute)
+		assert.Equal(t, http.StatusForbidden, serve(az, http.MethodPut, "foo", "a@b.com"))
+		assert.Equal(t, 2, shield.checks)
+	})
+	t.Run("CheckManageFor", func(t *testing.T) {
+		shield := &fakeShield{allowed: map[string]bool{"view": true}}
+		az := New(cfg, shield)
+
+		ctx := reqctx.With(context.Background(), reqctx.ReqCtx{UserEmail: "a@b.com"})
+		assert.ErrorIs(t, az.CheckManageFor(ctx, "foo"), errors.ErrForbidden)
+		assert.ErrorIs(t, az.CheckManageFor(context.Background(), "foo"), errors.ErrUnauthorized)
+
+		shield.allowed["manage"] = true
+		ctx = reqctx.With(context.Background(), reqctx.ReqCtx{UserEmail: "c@d.com"})
+		assert.NoError(t, az.CheckManageFor(ctx, "foo"))
+	})
+}
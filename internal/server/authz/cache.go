@@ -0,0 +1,80 @@
+package authz
+
+import (
+	"sync"
+	"time"
+)
+
+// maxCacheEntries bounds the decision cache. Expired entries are evicted
+// when the limit is reached.
+const maxCacheEntries = 10000
+
+type decisionKey struct {
+	User       string
+	Project    string
+	Permission string
+}
+
+type decision struct {
+	Allowed   bool
+	ExpiresAt time.Time
+}
+
+// decisionCache is a short-lived cache of authorization decisions. It avoids
+// calling Shield for every request made by the same user in quick succession.
+type decisionCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	now     func() time.Time
+	entries map[decisionKey]decision
+}
+
+func newDecisionCache(ttl time.Duration) *decisionCache {
+	return &decisionCache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: map[decisionKey]decision{},
+	}
+}
+
+func (dc *decisionCache) get(key decisionKey) (allowed, found bool) {
+	if dc.ttl <= 0 {
+		return false, false
+	}
+
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+
+	d, ok := dc.entries[key]
+	if !ok || dc.now().After(d.ExpiresAt) {
+		return false, false
+	}
+	return d.Allowed, true
+}
+
+func (dc *decisionCache) put(key decisionKey, allowed bool) {
+	if dc.ttl <= 0 {
+		return
+	}
+
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+
+	now := dc.now()
+	if len(dc.entries) >= maxCacheEntries {
+		for k, d := range dc.entries {
+			if now.After(d.ExpiresAt) {
+				delete(dc.entries, k)
+			}
+		}
+
+		if len(dc.entries) >= maxCacheEntries {
+			dc.entries = map[decisionKey]decision{}
+		}
+	}
+
+	dc.entries[key] = decision{
+		Allowed:   allowed,
+		ExpiresAt: now.Add(dc.ttl),
+	}
+}
@@ -0,0 +1,386 @@
+// Package autoscale scales firehoses between the replica bounds of their
+// policies based on the consumer lag reported by a metrics source. Each
+// evaluation is claimed in the store before it is run so that the policies
+// are evaluated once per interval even if multiple replicas of the server
+// share the store.
+package autoscale
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/odpf/dex/internal/server/sqlstore"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+// maxDecisions is the number of most recent decisions retained for a policy.
+const maxDecisions = 50
+
+// tolerance is the relative deviation of the lag from the target within
+// which the replicas are not changed.
+const tolerance = 0.1
+
+// Policy represents the autoscaling policy of a firehose.
+type Policy struct {
+	Project         string    `json:"project"`
+	URN             string    `json:"urn"`
+	MinReplicas     int       `json:"min_replicas"`
+	MaxReplicas     int       `json:"max_replicas"`
+	TargetLag       int64     `json:"target_lag"`
+	CooldownSeconds int64     `json:"cooldown_seconds"`
+	Enabled         bool      `json:"enabled"`
+	UpdatedBy       string    `json:"updated_by,omitempty"`
+	UpdatedByEmail  string    `json:"updated_by_email,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+
+	// LastScaledAt is the time of the last decision that scaled the
+	// firehose.
+	LastScaledAt *time.Time `json:"last_scaled_at,omitempty"`
+
+	// Decisions are the most recent decisions, latest first.
+	Decisions []Decision `json:"decisions"`
+}
+
+// Decision is the outcome of an evaluation of a policy. Evaluations that
+// keep the replicas unchanged or that are held until the cooldown ends are
+// recorded with Scaled set to false. Consecutive such decisions with the
+// same replicas are collapsed into the latest one.
+type Decision struct {
+	At              time.Time `json:"at"`
+	Lag             int64     `json:"lag"`
+	CurrentReplicas int       `json:"current_replicas"`
+	DesiredReplicas int       `json:"desired_replicas"`
+	Scaled          bool      `json:"scaled"`
+	Reason          string    `json:"reason"`
+	Error           string    `json:"error,omitempty"`
+	OperationID     string    `json:"operation_id,omitempty"`
+}
+
+// Target is the current state of the firehose of a policy.
+type Target struct {
+	URN           string
+	ConsumerGroup string
+	Topic         string
+	Replicas      int
+	Running       bool
+}
+
+// DescribeFunc returns the current state of the firehose of the policy.
+type DescribeFunc func(ctx context.Context, p Policy) (*Target, error)
+
+// ScaleFunc scales the firehose of the policy to the given replicas. The id
+// of the operation tracking the action, if any, is returned.
+type ScaleFunc func(ctx context.Context, p Policy, replicas int, reason string) (string, error)
+
+// Filter represents the criteria for listing policies. Empty fields are
+// ignored.
+type Filter struct {
+	Project string
+}
+
+func (f Filter) match(p Policy) bool {
+	return f.Project == "" || p.Project == f.Project
+}
+
+// Store persists the policies.
+type Store interface {
+	// Put creates or replaces the policy of the firehose. The last scaling
+	// and the decisions are not changed.
+	Put(ctx context.Context, p Policy) error
+
+	Delete(ctx context.Context, urn string) error
+	Get(ctx context.Context, urn string) (*Policy, error)
+
+	// List returns the policies matching the filter, oldest first.
+	List(ctx context.Context, filter Filter) ([]Policy, error)
+
+	// Claim records evalAt as the last evaluation of the policy if it is
+	// later than the recorded one. Returns false if the evaluation was
+	// already claimed.
+	Claim(ctx context.Context, urn string, evalAt time.Time) (bool, error)
+
+	// AddDecision records the decision, and the time of scaling if the
+	// firehose was scaled.
+	AddDecision(ctx context.Context, urn string, d Decision) error
+
+	Close() error
+}
+
+// Config contains the configurations for the autoscaler.
+type Config struct {
+	// Driver of the store. Can be one of 'memory', 'sqlite' or 'postgres'.
+	// The 'sqlite' and 'postgres' stores can be shared by multiple replicas
+	// of the server.
+	Driver string `mapstructure:"driver" default:"memory"`
+
+	// DSN of the database for the 'sqlite' & 'postgres' drivers.
+	DSN string `mapstructure:"dsn"`
+
+	// Replicas is the number of replicas of the server that can run at a
+	// time. The autoscaler is not started with the 'memory' driver if it is
+	// more than one, since each replica would see only the policies that
+	// were put on it.
+	Replicas int `mapstructure:"replicas" default:"1"`
+
+	// EvaluationInterval is the interval at which the policies are
+	// evaluated.
+	EvaluationInterval time.Duration `mapstructure:"evaluation_interval" default:"1m"`
+
+	Metrics MetricsConfig `mapstructure:"metrics"`
+}
+
+// Service manages the policies and evaluates them in the background.
+type Service struct {
+	store    Store
+	metrics  MetricsSource
+	interval time.Duration
+	now      func() time.Time
+
+	wg     sync.WaitGroup
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// New returns a Service with the store and the metrics source described by
+// cfg.
+func New(cfg Config) (*Service, error) {
+	if err := sqlstore.CheckReplicas(cfg.Driver, cfg.Replicas); err != nil {
+		return nil, fmt.Errorf("autoscale %w", err)
+	}
+
+	metrics, err := NewMetricsSource(cfg.Metrics)
+	if err != nil {
+		return nil, err
+	}
+
+	store, err := NewStore(cfg.Driver, cfg.DSN)
+	if err != nil {
+		return nil, err
+	}
+	return NewWithStore(store, metrics, cfg.EvaluationInterval), nil
+}
+
+// NewWithStore returns a Service that uses the given store and metrics
+// source. The policies are not evaluated if metrics is nil.
+func NewWithStore(store Store, metrics MetricsSource, interval time.Duration) *Service {
+	if interval <= 0 {
+		interval = time.Minute
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Service{
+		store:    store,
+		metrics:  metrics,
+		interval: interval,
+		now:      time.Now,
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+}
+
+// Start evaluates the policies in the background until the service is
+// closed. It is a no-op if no metrics source is configured.
+func (svc *Service) Start(describe DescribeFunc, scale ScaleFunc) {
+	if svc.metrics == nil {
+		log.Printf("warn: autoscale metrics source is not configured, policies will not be evaluated")
+		return
+	}
+
+	svc.wg.Add(1)
+	go func() {
+		defer svc.wg.Done()
+
+		ticker := time.NewTicker(svc.interval)
+		defer ticker.Stop()
+
+		for {
+			svc.evaluate(svc.ctx, describe, scale)
+
+			select {
+			case <-svc.ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Put validates and records the policy of the firehose.
+func (svc *Service) Put(ctx context.Context, p Policy) (*Policy, error) {
+	if err := validate(p); err != nil {
+		return nil, err
+	}
+
+	now := svc.now()
+	p.CreatedAt = now
+	p.UpdatedAt = now
+	p.LastScaledAt = nil
+	p.Decisions = []Decision{}
+
+	existing, err := svc.store.Get(ctx, p.URN)
+	if err == nil {
+		p.CreatedAt = existing.CreatedAt
+		p.LastScaledAt = existing.LastScaledAt
+		p.Decisions = existing.Decisions
+	} else if !errors.Is(err, errors.ErrNotFound) {
+		return nil, err
+	}
+
+	if err := svc.store.Put(ctx, p); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// Get returns the policy of the firehose.
+func (svc *Service) Get(ctx context.Context, urn string) (*Policy, error) {
+	return svc.store.Get(ctx, urn)
+}
+
+// List returns the policies matching the filter, oldest first.
+func (svc *Service) List(ctx context.Context, filter Filter) ([]Policy, error) {
+	return svc.store.List(ctx, filter)
+}
+
+// Delete removes the policy of the firehose.
+func (svc *Service) Delete(ctx context.Context, urn string) error {
+	return svc.store.Delete(ctx, urn)
+}
+
+// Close stops evaluating the policies and closes the store.
+func (svc *Service) Close() error {
+	svc.cancel()
+	svc.wg.Wait()
+	return svc.store.Close()
+}
+
+// evaluate scales the firehoses whose consumer lag deviates from the target
+// of their policies.
+func (svc *Service) evaluate(ctx context.Context, describe DescribeFunc, scale ScaleFunc) {
+	list, err := svc.store.List(ctx, Filter{})
+	if err != nil {
+		log.Printf("error: failed to list autoscale policies: %v", err)
+		return
+	}
+
+	now := svc.now()
+	evalAt := now.Truncate(svc.interval)
+	for _, p := range list {
+		if !p.Enabled {
+			continue
+		}
+
+		claimed, err := svc.store.Claim(ctx, p.URN, evalAt)
+		if err != nil {
+			log.Printf("error: failed to claim evaluation of autoscale policy of '%s': %v", p.URN, err)
+			continue
+		} else if !claimed {
+			// another replica is evaluating this policy.
+			continue
+		}
+
+		target, err := describe(ctx, p)
+		if err != nil {
+			log.Printf("error: failed to describe '%s' for autoscaling: %v", p.URN, err)
+			continue
+		} else if !target.Running {
+			continue
+		}
+
+		lag, err := svc.metrics.ConsumerLag(ctx, *target)
+		if err != nil {
+			log.Printf("error: failed to get consumer lag of '%s': %v", p.URN, err)
+			continue
+		}
+
+		d := decide(p, *target, lag, now)
+		if d.Scaled {
+			d.OperationID, err = scale(ctx, p, d.DesiredReplicas, d.Reason)
+			if err != nil {
+				d.Scaled = false
+				d.Error = err.Error()
+				log.Printf("error: autoscaling '%s' to %d replicas failed: %v", p.URN, d.DesiredReplicas, err)
+			}
+		}
+
+		if err := svc.store.AddDecision(ctx, p.URN, d); err != nil {
+			log.Printf("error: failed to record autoscale decision of '%s': %v", p.URN, err)
+		}
+	}
+}
+
+// decide returns the decision for the lag of the firehose. Scaled is false
+// if the replicas need not be changed or if the change is held until the
+// cooldown ends.
+func decide(p Policy, t Target, lag int64, now time.Time) Decision {
+	ratio := float64(lag) / float64(p.TargetLag)
+
+	desired := t.Replicas
+	if math.Abs(ratio-1) > tolerance {
+		base := t.Replicas
+		if base < 1 {
+			base = 1
+		}
+		desired = int(math.Ceil(float64(base) * ratio))
+	}
+
+	if desired < p.MinReplicas {
+		desired = p.MinReplicas
+	} else if desired > p.MaxReplicas {
+		desired = p.MaxReplicas
+	}
+
+	d := Decision{
+		At:              now,
+		Lag:             lag,
+		CurrentReplicas: t.Replicas,
+		DesiredReplicas: desired,
+		Scaled:          desired != t.Replicas,
+	}
+
+	switch {
+	case ratio > 1+tolerance:
+		d.Reason = fmt.Sprintf("lag %d is above target %d", lag, p.TargetLag)
+	case ratio < 1-tolerance:
+		d.Reason = fmt.Sprintf("lag %d is below target %d", lag, p.TargetLag)
+	case d.Scaled:
+		d.Reason = fmt.Sprintf("replicas are outside the bounds [%d, %d]", p.MinReplicas, p.MaxReplicas)
+	default:
+		d.Reason = fmt.Sprintf("lag %d is within the tolerance of target %d", lag, p.TargetLag)
+	}
+
+	if !d.Scaled {
+		if math.Abs(ratio-1) > tolerance {
+			d.Reason += fmt.Sprintf(", replicas are at the bound %d", desired)
+		}
+		return d
+	}
+
+	if p.LastScaledAt != nil {
+		cooldownEnd := p.LastScaledAt.Add(time.Duration(p.CooldownSeconds) * time.Second)
+		if now.Before(cooldownEnd) {
+			d.Scaled = false
+			d.Reason += fmt.Sprintf(", held until cooldown ends at %s", cooldownEnd.UTC().Format(time.RFC3339))
+		}
+	}
+	return d
+}
+
+func validate(p Policy) error {
+	switch {
+	case p.MinReplicas < 1:
+		return errors.ErrInvalid.WithMsgf("min_replicas must be at least 1")
+	case p.MaxReplicas < p.MinReplicas:
+		return errors.ErrInvalid.WithMsgf("max_replicas must not be less than min_replicas")
+	case p.TargetLag <= 0:
+		return errors.ErrInvalid.WithMsgf("target_lag must be a positive integer")
+	case p.CooldownSeconds < 0:
+		return errors.ErrInvalid.WithMsgf("cooldown_seconds must not be negative")
+	}
+	return nil
+}
@@ -0,0 +1,252 @@
+package autoscale
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/odpf/dex/internal/server/sqlstore"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+func TestDecide(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2022, 10, 10, 10, 0, 0, 0, time.UTC)
+	recently := now.Add(-time.Minute)
+	policy := Policy{MinReplicas: 1, MaxReplicas: 10, TargetLag: 1000, CooldownSeconds: 300}
+
+	table := []struct {
+		title        string
+		policy       Policy
+		replicas     int
+		lag          int64
+		wantReplicas int
+		wantScaled   bool
+	}{
+		{title: "WithinTolerance", policy: policy, replicas: 2, lag: 1050, wantReplicas: 2},
+		{title: "ScaleUp", policy: policy, replicas: 2, lag: 3000, wantReplicas: 6, wantScaled: true},
+		{title: "ScaleUpToMax", policy: policy, replicas: 4, lag: 100000, wantReplicas: 10, wantScaled: true},
+		{title: "AlreadyAtMax", policy: policy, replicas: 10, lag: 100000, wantReplicas: 10},
+		{title: "ScaleDownToMin", policy: policy, replicas: 4, lag: 0, wantReplicas: 1, wantScaled: true},
+		{title: "OutsideBounds", policy: policy, replicas: 12, lag: 1000, wantReplicas: 10, wantScaled: true},
+		{
+			title: "HeldByCooldown",
+			policy: func() Policy {
+				p := policy
+				p.LastScaledAt = &recently
+				return p
+			}(),
+			replicas:     2,
+			lag:          3000,
+			wantReplicas: 6,
+		},
+	}
+
+	for _, tt := range table {
+		tt := tt
+		t.Run(tt.title, func(t *testing.T) {
+			t.Parallel()
+
+			d := decide(tt.policy, Target{Replicas: tt.replicas}, tt.lag, now)
+			assert.Equal(t, tt.wantReplicas, d.DesiredReplicas)
+			assert.Equal(t, tt.wantScaled, d.Scaled)
+			assert.NotEmpty(t, d.Reason)
+		})
+	}
+}
+
+func TestStores(t *testing.T) {
+	t.Parallel()
+
+	stores := map[string]func(t *testing.T) Store{
+		"Memory": func(t *testing.T) Store { return NewMemoryStore() },
+		"SQLite": func(t *testing.T) Store {
+			store, err := NewStore(sqlstore.DriverSQLite, filepath.Join(t.TempDir(), "autoscale.db"))
+			require.NoError(t, err)
+			return store
+		},
+	}
+
+	for name, newStore := range stores {
+		newStore := newStore
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			store := newStore(t)
+			defer store.Close()
+			ctx := context.Background()
+
+			base := time.Date(2022, 10, 10, 10, 0, 0, 0, time.UTC)
+			p := Policy{Project: "foo", URN: "urn1", MinReplicas: 1, MaxReplicas: 4, TargetLag: 100, CreatedAt: base}
+			require.NoError(t, store.Put(ctx, p))
+			require.NoError(t, store.Put(ctx, Policy{Project: "bar", URN: "urn2", CreatedAt: base.Add(time.Minute)}))
+
+			claimed, err := store.Claim(ctx, "urn1", base)
+			require.NoError(t, err)
+			assert.True(t, claimed)
+
+			claimed, err = store.Claim(ctx, "urn1", base)
+			require.NoError(t, err)
+			assert.False(t, claimed, "same evaluation must not be claimed twice")
+
+			for i := 0; i < maxDecisions+1; i++ {
+				d := Decision{At: base.Add(time.Duration(i) * time.Minute), DesiredReplicas: i, Scaled: i == 0, Reason: fmt.Sprint(i)}
+				require.NoError(t, store.AddDecision(ctx, "urn1", d))
+			}
+
+			p.MaxReplicas = 8
+			require.NoError(t, store.Put(ctx, p))
+
+			got, err := store.Get(ctx, "urn1")
+			require.NoError(t, err)
+			assert.Equal(t, 8, got.MaxReplicas)
+			require.NotNil(t, got.LastScaledAt, "put must not reset the last scaling")
+			assert.True(t, got.LastScaledAt.Equal(base))
+			require.Len(t, got.Decisions, maxDecisions)
+			assert.Equal(t, fmt.Sprint(maxDecisions), got.Decisions[0].Reason)
+
+			res, err := store.List(ctx, Filter{Project: "foo"})
+			require.NoError(t, err)
+			require.Len(t, res, 1)
+			assert.Equal(t, "urn1", res[0].URN)
+
+			require.NoError(t, store.Delete(ctx, "urn1"))
+			_, err = store.Get(ctx, "urn1")
+			assert.ErrorIs(t, err, errors.ErrNotFound)
+			assert.ErrorIs(t, store.Delete(ctx, "urn1"), errors.ErrNotFound)
+		})
+	}
+}
+
+func TestPrometheusSource(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("query") {
+		case `sum(kafka_consumergroup_lag{consumergroup="group-1", topic="topic-1"})`:
+			_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1665396000,"1234"]}]}}`))
+		case `sum(kafka_consumergroup_lag{consumergroup="x\"} or vector(1) or {a=\"", topic="topic-1"})`:
+			_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1665396000,"99"]}]}}`))
+		default:
+			_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[]}}`))
+		}
+	}))
+	defer srv.Close()
+
+	src, err := NewPrometheusSource(srv.URL, "", time.Second)
+	require.NoError(t, err)
+
+	lag, err := src.ConsumerLag(context.Background(), Target{ConsumerGroup: "group-1", Topic: "topic-1"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1234), lag)
+
+	_, err = src.ConsumerLag(context.Background(), Target{ConsumerGroup: "unknown", Topic: "topic-1"})
+	assert.Error(t, err, "missing lag must not be reported as zero")
+
+	// the labels must not be able to alter the query.
+	lag, err = src.ConsumerLag(context.Background(), Target{ConsumerGroup: `x"} or vector(1) or {a="`, Topic: "topic-1"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(99), lag)
+}
+
+func TestService(t *testing.T) {
+	t.Parallel()
+
+	base := time.Date(2022, 10, 10, 10, 0, 0, 0, time.UTC)
+	store := NewMemoryStore()
+
+	// two services sharing a store behave like replicas of the server.
+	metrics := StaticSource{Lag: 5000}
+	replicas := []*Service{NewWithStore(store, metrics, time.Minute), NewWithStore(store, metrics, time.Minute)}
+	for _, svc := range replicas {
+		svc.now = func() time.Time { return base }
+	}
+
+	_, err := replicas[0].Put(context.Background(), Policy{URN: "urn1", MinReplicas: 2, MaxReplicas: 1, TargetLag: 1})
+	assert.ErrorIs(t, err, errors.ErrInvalid)
+
+	_, err = replicas[0].Put(context.Background(), Policy{
+		Project: "foo", URN: "urn1", MinReplicas: 1, MaxReplicas: 4, TargetLag: 1000, CooldownSeconds: 600, Enabled: true,
+	})
+	require.NoError(t, err)
+
+	current := 1
+	describe := func(ctx context.Context, p Policy) (*Target, error) {
+		return &Target{URN: p.URN, Replicas: current, Running: true}, nil
+	}
+
+	var scaled []int
+	scale := func(ctx context.Context, p Policy, replicas int, reason string) (string, error) {
+		scaled = append(scaled, replicas)
+		current = replicas
+		return "op1", nil
+	}
+
+	for _, svc := range replicas {
+		svc.evaluate(context.Background(), describe, scale)
+	}
+	assert.Equal(t, []int{4}, scaled, "policy must be evaluated by only one replica")
+
+	// the lag is still above the target, but the firehose is at the max.
+	for _, svc := range replicas {
+		svc.now = func() time.Time { return base.Add(time.Minute) }
+		svc.evaluate(context.Background(), describe, scale)
+	}
+	assert.Equal(t, []int{4}, scaled)
+
+	// scaling down is held until the cooldown ends.
+	for _, svc := range replicas {
+		svc.metrics = StaticSource{Lag: 0}
+		svc.now = func() time.Time { return base.Add(2 * time.Minute) }
+		svc.evaluate(context.Background(), describe, scale)
+	}
+	assert.Equal(t, []int{4}, scaled)
+
+	got, err := replicas[1].Get(context.Background(), "urn1")
+	require.NoError(t, err)
+	require.Len(t, got.Decisions, 3)
+	assert.False(t, got.Decisions[0].Scaled)
+	assert.Equal(t, 1, got.Decisions[0].DesiredReplicas)
+	assert.Contains(t, got.Decisions[0].Reason, "held until cooldown ends")
+	assert.False(t, got.Decisions[1].Scaled)
+	assert.Equal(t, 4, got.Decisions[1].DesiredReplicas)
+	assert.True(t, got.Decisions[2].Scaled)
+	assert.Equal(t, "op1", got.Decisions[2].OperationID)
+	assert.True(t, got.LastScaledAt.Equal(base))
+
+	// repeated holds are collapsed into the latest one.
+	for _, svc := range replicas {
+		svc.now = func() time.Time { return base.Add(3 * time.Minute) }
+		svc.evaluate(context.Background(), describe, scale)
+	}
+	got, err = replicas[1].Get(context.Background(), "urn1")
+	require.NoError(t, err)
+	require.Len(t, got.Decisions, 3)
+	assert.True(t, got.Decisions[0].At.Equal(base.Add(3*time.Minute)))
+
+	// scaled down once the cooldown ends.
+	for _, svc := range replicas {
+		svc.now = func() time.Time { return base.Add(11 * time.Minute) }
+		svc.evaluate(context.Background(), describe, scale)
+	}
+	assert.Equal(t, []int{4, 1}, scaled)
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	_, err := New(Config{Driver: sqlstore.DriverMemory, Replicas: 2})
+	assert.Error(t, err, "memory store must not be used by multiple replicas")
+
+	svc, err := New(Config{Driver: sqlstore.DriverSQLite, DSN: filepath.Join(t.TempDir(), "autoscale.db"), Replicas: 2})
+	require.NoError(t, err)
+	assert.NoError(t, svc.Close())
+}
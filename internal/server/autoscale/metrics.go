@@ -0,0 +1,174 @@
+package autoscale
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// Supported metrics sources.
+const (
+	SourcePrometheus = "prometheus"
+	SourceStatic     = "static"
+)
+
+// defaultLagQuery is the PromQL query for the lag exported by the kafka
+// exporter.
+const defaultLagQuery = `sum(kafka_consumergroup_lag{consumergroup={{ quote .ConsumerGroup }}, topic={{ quote .Topic }}})`
+
+// queryFuncs are the functions available in the query template. 'quote'
+// returns the value as a PromQL string literal, escaping the quotes and
+// backslashes in it.
+var queryFuncs = template.FuncMap{"quote": strconv.Quote}
+
+// MetricsSource reports the consumer lag of firehoses.
+type MetricsSource interface {
+	// ConsumerLag returns the total lag of the consumer group of the
+	// firehose across the partitions of its topic.
+	ConsumerLag(ctx context.Context, t Target) (int64, error)
+}
+
+// MetricsConfig contains the configurations for the source of the consumer
+// lag.
+type MetricsConfig struct {
+	// Source of the consumer lag. Can be 'prometheus' or 'static'. The
+	// policies are not evaluated if it is not set.
+	Source string `mapstructure:"source"`
+
+	// PrometheusURL is the base URL of the Prometheus HTTP API.
+	PrometheusURL string `mapstructure:"prometheus_url"`
+
+	// Query is the template of the PromQL query returning the lag of a
+	// firehose. '.URN', '.ConsumerGroup' and '.Topic' of the firehose can
+	// be used in the template. Use 'quote' to insert them as string
+	// literals (e.g., 'consumergroup={{ quote .ConsumerGroup }}').
+	Query string `mapstructure:"query"`
+
+	// Timeout of the requests to Prometheus.
+	Timeout time.Duration `mapstructure:"timeout" default:"10s"`
+
+	// StaticLag is the lag reported for all the firehoses by the 'static'
+	// source. Meant for testing the policies.
+	StaticLag int64 `mapstructure:"static_lag"`
+}
+
+// NewMetricsSource returns the metrics source described by cfg. Returns nil
+// if no source is configured.
+func NewMetricsSource(cfg MetricsConfig) (MetricsSource, error) {
+	switch strings.ToLower(strings.TrimSpace(cfg.Source)) {
+	case "":
+		return nil, nil
+
+	case SourceStatic:
+		return StaticSource{Lag: cfg.StaticLag}, nil
+
+	case SourcePrometheus:
+		return NewPrometheusSource(cfg.PrometheusURL, cfg.Query, cfg.Timeout)
+
+	default:
+		return nil, fmt.Errorf("autoscale metrics source must be '%s' or '%s', not '%s'",
+			SourcePrometheus, SourceStatic, cfg.Source)
+	}
+}
+
+// StaticSource reports the same lag for all the firehoses.
+type StaticSource struct {
+	Lag int64
+}
+
+func (ss StaticSource) ConsumerLag(_ context.Context, _ Target) (int64, error) {
+	return ss.Lag, nil
+}
+
+// PrometheusSource queries the consumer lag using the Prometheus HTTP API.
+type PrometheusSource struct {
+	baseURL string
+	query   *template.Template
+	client  *http.Client
+}
+
+// NewPrometheusSource returns a source that evaluates the query template
+// against the Prometheus server at baseURL.
+func NewPrometheusSource(baseURL, query string, timeout time.Duration) (*PrometheusSource, error) {
+	if _, err := url.ParseRequestURI(baseURL); err != nil {
+		return nil, fmt.Errorf("invalid prometheus_url '%s': %w", baseURL, err)
+	}
+
+	if strings.TrimSpace(query) == "" {
+		query = defaultLagQuery
+	}
+	tpl, err := template.New("query").Option("missingkey=error").Funcs(queryFuncs).Parse(query)
+	if err != nil {
+		return nil, fmt.Errorf("invalid autoscale lag query: %w", err)
+	}
+
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	return &PrometheusSource{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		query:   tpl,
+		client:  &http.Client{Timeout: timeout},
+	}, nil
+}
+
+func (ps *PrometheusSource) ConsumerLag(ctx context.Context, t Target) (int64, error) {
+	var buf bytes.Buffer
+	if err := ps.query.Execute(&buf, t); err != nil {
+		return 0, err
+	}
+
+	endpoint := ps.baseURL + "/api/v1/query?" + url.Values{"query": {buf.String()}}.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := ps.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	var body struct {
+		Status string `json:"status"`
+		Error  string `json:"error"`
+		Data   struct {
+			ResultType string `json:"resultType"`
+			Result     []struct {
+				Value []any `json:"value"`
+			} `json:"result"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return 0, fmt.Errorf("failed to decode prometheus response (status %d): %w", resp.StatusCode, err)
+	} else if body.Status != "success" {
+		return 0, fmt.Errorf("prometheus query failed: %s", body.Error)
+	} else if body.Data.ResultType != "vector" {
+		return 0, fmt.Errorf("prometheus query must return a vector, not '%s'", body.Data.ResultType)
+	} else if len(body.Data.Result) == 0 {
+		// scaling down on a missing metric could stall the firehose.
+		return 0, fmt.Errorf("no lag reported for consumer group '%s'", t.ConsumerGroup)
+	}
+
+	value := body.Data.Result[0].Value
+	if len(value) != 2 {
+		return 0, fmt.Errorf("unexpected prometheus sample: %v", value)
+	}
+	s, _ := value[1].(string)
+	lag, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid lag value '%v': %w", value[1], err)
+	} else if math.IsNaN(lag) || math.IsInf(lag, 0) {
+		return 0, fmt.Errorf("invalid lag value '%s'", s)
+	}
+	return int64(lag), nil
+}
@@ -0,0 +1,333 @@
+package autoscale
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/odpf/dex/internal/server/sqlstore"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+var errNotFound = errors.ErrNotFound.WithMsgf("no autoscale policy for given firehose")
+
+// NewStore returns the store of the driver.
+func NewStore(driver, dsn string) (Store, error) {
+	if sqlstore.IsMemory(driver) {
+		return NewMemoryStore(), nil
+	}
+
+	db, err := sqlstore.Open(driver, dsn, createTableQuery)
+	if err != nil {
+		return nil, fmt.Errorf("autoscale store: %w", err)
+	}
+	return &sqlStore{db: db}, nil
+}
+
+type memoryEntry struct {
+	policy     Policy
+	lastEvalAt time.Time
+}
+
+// memoryStore keeps the policies in memory. Policies are lost on restart.
+type memoryStore struct {
+	mu       sync.RWMutex
+	policies map[string]memoryEntry
+}
+
+// NewMemoryStore returns a store that keeps the policies in memory.
+func NewMemoryStore() Store {
+	return &memoryStore{policies: map[string]memoryEntry{}}
+}
+
+func (ms *memoryStore) Put(_ context.Context, p Policy) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	entry, found := ms.policies[p.URN]
+	if found {
+		p.LastScaledAt = entry.policy.LastScaledAt
+		p.Decisions = entry.policy.Decisions
+	}
+	entry.policy = clonePolicy(p)
+	ms.policies[p.URN] = entry
+	return nil
+}
+
+func (ms *memoryStore) Delete(_ context.Context, urn string) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if _, found := ms.policies[urn]; !found {
+		return errNotFound
+	}
+	delete(ms.policies, urn)
+	return nil
+}
+
+func (ms *memoryStore) Get(_ context.Context, urn string) (*Policy, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+
+	entry, found := ms.policies[urn]
+	if !found {
+		return nil, errNotFound
+	}
+	p := clonePolicy(entry.policy)
+	return &p, nil
+}
+
+func (ms *memoryStore) List(_ context.Context, filter Filter) ([]Policy, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+
+	var res []Policy
+	for _, entry := range ms.policies {
+		if filter.match(entry.policy) {
+			res = append(res, clonePolicy(entry.policy))
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].CreatedAt.Equal(res[j].CreatedAt) {
+			return res[i].URN < res[j].URN
+		}
+		return res[i].CreatedAt.Before(res[j].CreatedAt)
+	})
+	return res, nil
+}
+
+func (ms *memoryStore) Claim(_ context.Context, urn string, evalAt time.Time) (bool, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	entry, found := ms.policies[urn]
+	if !found {
+		return false, errNotFound
+	} else if !evalAt.After(entry.lastEvalAt) {
+		return false, nil
+	}
+	entry.lastEvalAt = evalAt
+	ms.policies[urn] = entry
+	return true, nil
+}
+
+func (ms *memoryStore) AddDecision(_ context.Context, urn string, d Decision) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	entry, found := ms.policies[urn]
+	if !found {
+		return errNotFound
+	}
+	if d.Scaled {
+		at := d.At
+		entry.policy.LastScaledAt = &at
+	}
+	entry.policy.Decisions = prependDecision(entry.policy.Decisions, d)
+	ms.policies[urn] = entry
+	return nil
+}
+
+func (ms *memoryStore) Close() error { return nil }
+
+func clonePolicy(p Policy) Policy {
+	p.Decisions = append([]Decision{}, p.Decisions...)
+	return p
+}
+
+// prependDecision adds the decision as the latest one. If neither it nor
+// the latest one scaled the firehose and both are for the same replicas,
+// the latest one is replaced so that repeated holds do not push the
+// scalings out of the history.
+func prependDecision(decisions []Decision, d Decision) []Decision {
+	if len(decisions) > 0 && isRepeatedHold(decisions[0], d) {
+		return append([]Decision{d}, decisions[1:]...)
+	}
+
+	decisions = append([]Decision{d}, decisions...)
+	if len(decisions) > maxDecisions {
+		decisions = decisions[:maxDecisions]
+	}
+	return decisions
+}
+
+func isRepeatedHold(prev, d Decision) bool {
+	return !prev.Scaled && !d.Scaled && prev.Error == "" && d.Error == "" &&
+		prev.CurrentReplicas == d.CurrentReplicas && prev.DesiredReplicas == d.DesiredReplicas
+}
+
+// sqlStore keeps the policies in a SQLite or Postgres database. The policy
+// and its decisions are stored as JSON along with the columns used for
+// filtering and claiming the evaluations.
+type sqlStore struct {
+	db *sql.DB
+}
+
+const createTableQuery = `
+CREATE TABLE IF NOT EXISTS autoscale_policies (
+	urn               VARCHAR(255) PRIMARY KEY,
+	project           TEXT NOT NULL,
+	created_at        BIGINT NOT NULL,
+	last_evaluated_at BIGINT NOT NULL DEFAULT 0,
+	last_scaled_at    BIGINT NOT NULL DEFAULT 0,
+	data              TEXT NOT NULL,
+	decisions         TEXT NOT NULL DEFAULT '[]'
+);
+`
+
+func (ss *sqlStore) Put(ctx context.Context, p Policy) error {
+	data, err := marshalDefinition(p)
+	if err != nil {
+		return err
+	}
+
+	const query = `INSERT INTO autoscale_policies (urn, project, created_at, data) VALUES ($1, $2, $3, $4)
+ON CONFLICT (urn) DO UPDATE SET project = excluded.project, data = excluded.data`
+	_, err = ss.db.ExecContext(ctx, query, p.URN, p.Project, p.CreatedAt.UnixNano(), data)
+	return err
+}
+
+func (ss *sqlStore) Delete(ctx context.Context, urn string) error {
+	res, err := ss.db.ExecContext(ctx, `DELETE FROM autoscale_policies WHERE urn = $1`, urn)
+	if err != nil {
+		return err
+	} else if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return errNotFound
+	}
+	return nil
+}
+
+const selectQuery = `SELECT last_scaled_at, data, decisions FROM autoscale_policies`
+
+func (ss *sqlStore) Get(ctx context.Context, urn string) (*Policy, error) {
+	p, err := scanPolicy(ss.db.QueryRowContext(ctx, selectQuery+` WHERE urn = $1`, urn))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errNotFound
+		}
+		return nil, err
+	}
+	return p, nil
+}
+
+func (ss *sqlStore) List(ctx context.Context, filter Filter) ([]Policy, error) {
+	query := selectQuery
+	var args []any
+	if filter.Project != "" {
+		query += ` WHERE project = $1`
+		args = append(args, filter.Project)
+	}
+	query += ` ORDER BY created_at, urn`
+
+	rows, err := ss.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []Policy
+	for rows.Next() {
+		p, err := scanPolicy(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, *p)
+	}
+	return res, rows.Err()
+}
+
+func (ss *sqlStore) Claim(ctx context.Context, urn string, evalAt time.Time) (bool, error) {
+	// the conditional update makes sure that only one of the replicas
+	// sharing the database claims the evaluation.
+	const query = `UPDATE autoscale_policies SET last_evaluated_at = $1 WHERE urn = $2 AND last_evaluated_at < $3`
+	res, err := ss.db.ExecContext(ctx, query, evalAt.UnixNano(), urn, evalAt.UnixNano())
+	if err != nil {
+		return false, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (ss *sqlStore) AddDecision(ctx context.Context, urn string, d Decision) error {
+	tx, err := ss.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var rawDecisions string
+	const selectDecisions = `SELECT decisions FROM autoscale_policies WHERE urn = $1`
+	if err := tx.QueryRowContext(ctx, selectDecisions, urn).Scan(&rawDecisions); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errNotFound
+		}
+		return err
+	}
+
+	var decisions []Decision
+	if err := json.Unmarshal([]byte(rawDecisions), &decisions); err != nil {
+		return err
+	}
+
+	updated, err := json.Marshal(prependDecision(decisions, d))
+	if err != nil {
+		return err
+	}
+
+	if d.Scaled {
+		const query = `UPDATE autoscale_policies SET decisions = $1, last_scaled_at = $2 WHERE urn = $3`
+		_, err = tx.ExecContext(ctx, query, string(updated), d.At.UnixNano(), urn)
+	} else {
+		const query = `UPDATE autoscale_policies SET decisions = $1 WHERE urn = $2`
+		_, err = tx.ExecContext(ctx, query, string(updated), urn)
+	}
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (ss *sqlStore) Close() error { return ss.db.Close() }
+
+func scanPolicy(row sqlstore.RowScanner) (*Policy, error) {
+	var lastScaledAt int64
+	var data, rawDecisions string
+	if err := row.Scan(&lastScaledAt, &data, &rawDecisions); err != nil {
+		return nil, err
+	}
+
+	var p Policy
+	if err := json.Unmarshal([]byte(data), &p); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(rawDecisions), &p.Decisions); err != nil {
+		return nil, err
+	}
+	if lastScaledAt > 0 {
+		t := time.Unix(0, lastScaledAt).UTC()
+		p.LastScaledAt = &t
+	}
+	return &p, nil
+}
+
+// marshalDefinition returns the policy as JSON without the fields that are
+// stored in their own columns.
+func marshalDefinition(p Policy) (string, error) {
+	p.LastScaledAt = nil
+	p.Decisions = nil
+
+	data, err := json.Marshal(p)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
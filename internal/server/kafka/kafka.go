@@ -0,0 +1,432 @@
+// Package kafka reads the state of the consumer groups of firehoses from the
+// source Kafka clusters.
+package kafka
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/xdg-go/scram"
+)
+
+// Config contains the configurations for connecting to the source Kafka
+// clusters of firehoses.
+type Config struct {
+	// ClientID is sent to the brokers with every request.
+	ClientID string `mapstructure:"client_id" default:"dex"`
+
+	// Timeout for dialing and reading from the brokers.
+	Timeout time.Duration `mapstructure:"timeout" default:"10s"`
+
+	// Clusters are the Kafka clusters that can be connected to. A firehose
+	// is read from the cluster that has all of its bootstrap servers, using
+	// the credentials of that cluster. Brokers that are not of any cluster
+	// are refused so that the credentials are never sent to the brokers set
+	// by a firehose.
+	Clusters []ClusterConfig `mapstructure:"clusters"`
+}
+
+// ClusterConfig contains the brokers of a Kafka cluster and the
+// configurations for connecting to them.
+type ClusterConfig struct {
+	Name string `mapstructure:"name"`
+
+	// Brokers are the addresses (host:port) of the brokers of the cluster,
+	// as they are set in the bootstrap servers of the firehoses.
+	Brokers []string `mapstructure:"brokers"`
+
+	// SASL configures the authentication with the brokers.
+	SASL SASLConfig `mapstructure:"sasl"`
+
+	// TLS configures the encryption of the connections to the brokers.
+	TLS TLSConfig `mapstructure:"tls"`
+}
+
+// SASLConfig contains the credentials for SASL authentication.
+type SASLConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// Mechanism can be one of 'PLAIN', 'SCRAM-SHA-256' or 'SCRAM-SHA-512'.
+	Mechanism string `mapstructure:"mechanism" default:"PLAIN"`
+
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+}
+
+// TLSConfig contains the configurations for TLS connections. The system
+// roots are used if no CA file is given.
+type TLSConfig struct {
+	Enabled            bool   `mapstructure:"enabled"`
+	CAFile             string `mapstructure:"ca_file"`
+	CertFile           string `mapstructure:"cert_file"`
+	KeyFile            string `mapstructure:"key_file"`
+	InsecureSkipVerify bool   `mapstructure:"insecure_skip_verify"`
+}
+
+// Offsets maps the partitions of each topic to an offset.
+type Offsets map[string]map[int32]int64
+
+// PartitionLag is the lag of a consumer group on a partition.
+type PartitionLag struct {
+	Topic           string
+	Partition       int32
+	CommittedOffset int64
+	LogEndOffset    int64
+
+	// Lag is -1 if the consumer group has no committed offset for the
+	// partition.
+	Lag int64
+}
+
+// ErrUnknownBrokers is returned if the brokers are not of any of the
+// configured clusters.
+var ErrUnknownBrokers = errors.New("brokers are not of any configured kafka cluster")
+
+// ErrUnknownTopic is returned if no topic on the cluster matches the topic.
+var ErrUnknownTopic = errors.New("topic does not exist on kafka cluster")
+
+// Client connects to Kafka clusters on demand. The brokers are given with
+// every call since each firehose may consume from a different cluster.
+type Client struct {
+	cfg      Config
+	clusters []cluster
+}
+
+type cluster struct {
+	name      string
+	brokers   map[string]bool
+	sasl      SASLConfig
+	tlsConfig *tls.Config
+}
+
+// New returns a new client with the given configs.
+func New(cfg Config) (*Client, error) {
+	if cfg.ClientID == "" {
+		cfg.ClientID = "dex"
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 10 * time.Second
+	}
+
+	c := &Client{cfg: cfg}
+	known := map[string]string{}
+	for _, clusterCfg := range cfg.Clusters {
+		cl, err := newCluster(clusterCfg)
+		if err != nil {
+			return nil, err
+		}
+
+		for b := range cl.brokers {
+			if other, found := known[b]; found {
+				return nil, fmt.Errorf("kafka broker '%s' is in both '%s' and '%s' clusters", b, other, cl.name)
+			}
+			known[b] = cl.name
+		}
+		c.clusters = append(c.clusters, *cl)
+	}
+	return c, nil
+}
+
+func newCluster(cfg ClusterConfig) (*cluster, error) {
+	cl := &cluster{name: cfg.Name, brokers: map[string]bool{}, sasl: cfg.SASL}
+	for _, b := range cfg.Brokers {
+		if b = normalizeBroker(b); b != "" {
+			cl.brokers[b] = true
+		}
+	}
+	if len(cl.brokers) == 0 {
+		return nil, fmt.Errorf("kafka cluster '%s' must have brokers", cfg.Name)
+	}
+
+	if cl.sasl.Enabled {
+		cl.sasl.Mechanism = strings.ToUpper(strings.TrimSpace(cl.sasl.Mechanism))
+		switch cl.sasl.Mechanism {
+		case "":
+			cl.sasl.Mechanism = sarama.SASLTypePlaintext
+		case sarama.SASLTypePlaintext, sarama.SASLTypeSCRAMSHA256, sarama.SASLTypeSCRAMSHA512:
+		default:
+			return nil, fmt.Errorf("kafka sasl mechanism of '%s' must be one of '%s', '%s' or '%s', not '%s'",
+				cfg.Name, sarama.SASLTypePlaintext, sarama.SASLTypeSCRAMSHA256, sarama.SASLTypeSCRAMSHA512, cl.sasl.Mechanism)
+		}
+	}
+
+	if cfg.TLS.Enabled {
+		tlsConfig, err := newTLSConfig(cfg.TLS)
+		if err != nil {
+			return nil, err
+		}
+		cl.tlsConfig = tlsConfig
+	}
+	return cl, nil
+}
+
+// clusterOf returns the cluster that has all the brokers.
+func (c *Client) clusterOf(brokers []string) (*cluster, error) {
+	for i := range c.clusters {
+		cl := &c.clusters[i]
+
+		all := true
+		for _, b := range brokers {
+			if !cl.brokers[normalizeBroker(b)] {
+				all = false
+				break
+			}
+		}
+		if all {
+			return cl, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrUnknownBrokers, strings.Join(brokers, ","))
+}
+
+func normalizeBroker(b string) string {
+	return strings.ToLower(strings.TrimSpace(b))
+}
+
+// CommittedOffsets returns the offsets committed by the consumer group for
+// the topics matching the given topic. Like firehose, the topic is treated
+// as a pattern if no topic exists with the exact name. Partitions without
+// a committed offset are reported with offset -1.
+func (c *Client) CommittedOffsets(ctx context.Context, brokers []string, group, topic string) (Offsets, error) {
+	client, topics, err := c.connect(brokers, group, topic)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	return fetchCommittedOffsets(ctx, client, group, topics)
+}
+
+// ConsumerLag returns the committed offset, the log-end offset and the lag
+// of the consumer group for each partition of the topics matching the given
+// topic, sorted by topic and partition.
+func (c *Client) ConsumerLag(ctx context.Context, brokers []string, group, topic string) ([]PartitionLag, error) {
+	client, topics, err := c.connect(brokers, group, topic)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	committed, err := fetchCommittedOffsets(ctx, client, group, topics)
+	if err != nil {
+		return nil, err
+	}
+
+	var lags []PartitionLag
+	for t, partitions := range committed {
+		for p, offset := range partitions {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+
+			end, err := client.GetOffset(t, p, sarama.OffsetNewest)
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch log-end offset of '%s/%d': %w", t, p, err)
+			}
+
+			lag := int64(-1)
+			if offset >= 0 {
+				// the committed offset can be ahead of a stale log-end
+				// offset read from another broker.
+				lag = end - offset
+				if lag < 0 {
+					lag = 0
+				}
+			}
+
+			lags = append(lags, PartitionLag{
+				Topic:           t,
+				Partition:       p,
+				CommittedOffset: offset,
+				LogEndOffset:    end,
+				Lag:             lag,
+			})
+		}
+	}
+
+	sort.Slice(lags, func(i, j int) bool {
+		if lags[i].Topic == lags[j].Topic {
+			return lags[i].Partition < lags[j].Partition
+		}
+		return lags[i].Topic < lags[j].Topic
+	})
+	return lags, nil
+}
+
+// connect returns a client connected to the brokers along with the topics
+// matching the given topic. The client must be closed by the caller.
+func (c *Client) connect(brokers []string, group, topic string) (sarama.Client, []string, error) {
+	if len(brokers) == 0 || group == "" || topic == "" {
+		return nil, nil, fmt.Errorf("brokers, consumer group and topic must be set")
+	}
+
+	cl, err := c.clusterOf(brokers)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	client, err := sarama.NewClient(brokers, c.saramaConfig(cl))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to connect to kafka: %w", err)
+	}
+
+	topics, err := matchTopics(client, topic)
+	if err != nil {
+		_ = client.Close()
+		return nil, nil, err
+	}
+	return client, topics, nil
+}
+
+func fetchCommittedOffsets(ctx context.Context, client sarama.Client, group string, topics []string) (Offsets, error) {
+	req := &sarama.OffsetFetchRequest{ConsumerGroup: group, Version: 1}
+	for _, t := range topics {
+		partitions, err := client.Partitions(t)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read partitions of '%s': %w", t, err)
+		}
+		for _, p := range partitions {
+			req.AddPartition(t, p)
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	coordinator, err := client.Coordinator(group)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find coordinator of '%s': %w", group, err)
+	}
+
+	resp, err := coordinator.FetchOffset(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch offsets of '%s': %w", group, err)
+	} else if resp.Err != sarama.ErrNoError {
+		return nil, fmt.Errorf("failed to fetch offsets of '%s': %w", group, resp.Err)
+	}
+
+	offsets := Offsets{}
+	for t, blocks := range resp.Blocks {
+		offsets[t] = map[int32]int64{}
+		for p, block := range blocks {
+			if block.Err != sarama.ErrNoError {
+				return nil, fmt.Errorf("failed to fetch offset of '%s/%d': %w", t, p, block.Err)
+			}
+			offsets[t][p] = block.Offset
+		}
+	}
+	return offsets, nil
+}
+
+func (c *Client) saramaConfig(cl *cluster) *sarama.Config {
+	cfg := sarama.NewConfig()
+	cfg.ClientID = c.cfg.ClientID
+	cfg.Net.DialTimeout = c.cfg.Timeout
+	cfg.Net.ReadTimeout = c.cfg.Timeout
+	cfg.Net.WriteTimeout = c.cfg.Timeout
+	cfg.Metadata.Retry.Max = 1
+
+	if cl.tlsConfig != nil {
+		cfg.Net.TLS.Enable = true
+		cfg.Net.TLS.Config = cl.tlsConfig
+	}
+
+	if sasl := cl.sasl; sasl.Enabled {
+		cfg.Net.SASL.Enable = true
+		cfg.Net.SASL.Mechanism = sarama.SASLMechanism(sasl.Mechanism)
+		cfg.Net.SASL.User = sasl.Username
+		cfg.Net.SASL.Password = sasl.Password
+
+		switch sasl.Mechanism {
+		case sarama.SASLTypeSCRAMSHA256:
+			cfg.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+				return &scramClient{hashGen: scram.SHA256}
+			}
+		case sarama.SASLTypeSCRAMSHA512:
+			cfg.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+				return &scramClient{hashGen: scram.SHA512}
+			}
+		}
+	}
+	return cfg
+}
+
+func newTLSConfig(cfg TLSConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: cfg.InsecureSkipVerify, // nolint:gosec
+	}
+
+	if cfg.CAFile != "" {
+		caCert, err := os.ReadFile(cfg.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read kafka ca file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("no certificates found in kafka ca file '%s'", cfg.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if cfg.CertFile != "" || cfg.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load kafka client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+func matchTopics(client sarama.Client, topic string) ([]string, error) {
+	all, err := client.Topics()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list topics: %w", err)
+	}
+
+	for _, t := range all {
+		if t == topic {
+			return []string{t}, nil
+		}
+	}
+
+	re, err := regexp.Compile("^(?:" + topic + ")$")
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTopic, topic)
+	}
+
+	var matched []string
+	for _, t := range all {
+		if re.MatchString(t) {
+			matched = append(matched, t)
+		}
+	}
+	if len(matched) == 0 {
+		return nil, fmt.Errorf("%w: no topics match '%s'", ErrUnknownTopic, topic)
+	}
+	sort.Strings(matched)
+	return matched, nil
+}
+
+// SplitBrokers splits a comma-separated list of brokers (i.e., the format
+// of bootstrap servers in firehose configs).
+func SplitBrokers(servers string) []string {
+	var brokers []string
+	for _, b := range strings.Split(servers, ",") {
+		if b = strings.TrimSpace(b); b != "" {
+			brokers = append(brokers, b)
+		}
+	}
+	return brokers
+}
@@ -0,0 +1,185 @@
+package kafka
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_CommittedOffsets(t *testing.T) {
+	t.Parallel()
+
+	broker := sarama.NewMockBroker(t, 1)
+	defer broker.Close()
+
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(t).
+			SetBroker(broker.Addr(), broker.BrokerID()).
+			SetController(broker.BrokerID()).
+			SetLeader("bookings-v1", 0, broker.BrokerID()).
+			SetLeader("bookings-v1", 1, broker.BrokerID()).
+			SetLeader("payments", 0, broker.BrokerID()),
+		"FindCoordinatorRequest": sarama.NewMockFindCoordinatorResponse(t).
+			SetCoordinator(sarama.CoordinatorGroup, "foo-bar-0001", broker),
+		"OffsetFetchRequest": sarama.NewMockOffsetFetchResponse(t).
+			SetOffset("foo-bar-0001", "bookings-v1", 0, 42, "", sarama.ErrNoError).
+			SetOffset("foo-bar-0001", "bookings-v1", 1, -1, "", sarama.ErrNoError),
+	})
+
+	client, err := New(Config{Clusters: []ClusterConfig{{Name: "main", Brokers: []string{broker.Addr()}}}})
+	require.NoError(t, err)
+
+	t.Run("ExactTopic", func(t *testing.T) {
+		offsets, err := client.CommittedOffsets(context.Background(), []string{broker.Addr()}, "foo-bar-0001", "bookings-v1")
+		require.NoError(t, err)
+		assert.Equal(t, Offsets{"bookings-v1": {0: 42, 1: -1}}, offsets)
+	})
+
+	t.Run("TopicPattern", func(t *testing.T) {
+		offsets, err := client.CommittedOffsets(context.Background(), []string{broker.Addr()}, "foo-bar-0001", "bookings-.*")
+		require.NoError(t, err)
+		assert.Equal(t, Offsets{"bookings-v1": {0: 42, 1: -1}}, offsets)
+	})
+
+	t.Run("UnknownTopic", func(t *testing.T) {
+		_, err := client.CommittedOffsets(context.Background(), []string{broker.Addr()}, "foo-bar-0001", "orders")
+		assert.ErrorIs(t, err, ErrUnknownTopic)
+
+		_, err = client.CommittedOffsets(context.Background(), []string{broker.Addr()}, "foo-bar-0001", "bookings-(")
+		assert.ErrorIs(t, err, ErrUnknownTopic)
+	})
+
+	t.Run("UnknownBrokers", func(t *testing.T) {
+		_, err := client.CommittedOffsets(context.Background(), []string{broker.Addr(), "evil.example.com:9092"}, "foo-bar-0001", "bookings-v1")
+		assert.ErrorIs(t, err, ErrUnknownBrokers)
+	})
+}
+
+func TestClient_ConsumerLag(t *testing.T) {
+	t.Parallel()
+
+	broker := sarama.NewMockBroker(t, 1)
+	defer broker.Close()
+
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(t).
+			SetBroker(broker.Addr(), broker.BrokerID()).
+			SetController(broker.BrokerID()).
+			SetLeader("bookings-v1", 0, broker.BrokerID()).
+			SetLeader("bookings-v1", 1, broker.BrokerID()),
+		"FindCoordinatorRequest": sarama.NewMockFindCoordinatorResponse(t).
+			SetCoordinator(sarama.CoordinatorGroup, "foo-bar-0001", broker),
+		"OffsetFetchRequest": sarama.NewMockOffsetFetchResponse(t).
+			SetOffset("foo-bar-0001", "bookings-v1", 0, 42, "", sarama.ErrNoError).
+			SetOffset("foo-bar-0001", "bookings-v1", 1, -1, "", sarama.ErrNoError),
+		"OffsetRequest": sarama.NewMockOffsetResponse(t).
+			SetOffset("bookings-v1", 0, sarama.OffsetNewest, 100).
+			SetOffset("bookings-v1", 1, sarama.OffsetNewest, 7),
+	})
+
+	client, err := New(Config{Clusters: []ClusterConfig{{Name: "main", Brokers: []string{broker.Addr()}}}})
+	require.NoError(t, err)
+
+	lags, err := client.ConsumerLag(context.Background(), []string{broker.Addr()}, "foo-bar-0001", "bookings-v1")
+	require.NoError(t, err)
+	assert.Equal(t, []PartitionLag{
+		{Topic: "bookings-v1", Partition: 0, CommittedOffset: 42, LogEndOffset: 100, Lag: 58},
+		{Topic: "bookings-v1", Partition: 1, CommittedOffset: -1, LogEndOffset: 7, Lag: -1},
+	}, lags)
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	table := []struct {
+		title   string
+		cfg     Config
+		wantErr bool
+		check   func(t *testing.T, cfg *sarama.Config)
+	}{
+		{
+			title: "Plain",
+			cfg:   Config{Clusters: []ClusterConfig{{Name: "main", Brokers: []string{"localhost:9092"}, SASL: SASLConfig{Enabled: true, Username: "dex", Password: "secret"}}}},
+			check: func(t *testing.T, cfg *sarama.Config) {
+				assert.True(t, cfg.Net.SASL.Enable)
+				assert.Equal(t, sarama.SASLMechanism(sarama.SASLTypePlaintext), cfg.Net.SASL.Mechanism)
+				assert.Equal(t, "dex", cfg.Net.SASL.User)
+				assert.Nil(t, cfg.Net.SASL.SCRAMClientGeneratorFunc)
+			},
+		},
+		{
+			title: "SCRAM",
+			cfg:   Config{Clusters: []ClusterConfig{{Name: "main", Brokers: []string{"localhost:9092"}, SASL: SASLConfig{Enabled: true, Mechanism: "scram-sha-512", Username: "dex", Password: "secret"}}}},
+			check: func(t *testing.T, cfg *sarama.Config) {
+				assert.Equal(t, sarama.SASLMechanism(sarama.SASLTypeSCRAMSHA512), cfg.Net.SASL.Mechanism)
+				require.NotNil(t, cfg.Net.SASL.SCRAMClientGeneratorFunc)
+				assert.NoError(t, cfg.Net.SASL.SCRAMClientGeneratorFunc().Begin("dex", "secret", ""))
+			},
+		},
+		{
+			title:   "InvalidMechanism",
+			cfg:     Config{Clusters: []ClusterConfig{{Name: "main", Brokers: []string{"localhost:9092"}, SASL: SASLConfig{Enabled: true, Mechanism: "GSSAPI"}}}},
+			wantErr: true,
+		},
+		{
+			title: "TLS",
+			cfg:   Config{Clusters: []ClusterConfig{{Name: "main", Brokers: []string{"localhost:9092"}, TLS: TLSConfig{Enabled: true, InsecureSkipVerify: true}}}},
+			check: func(t *testing.T, cfg *sarama.Config) {
+				assert.True(t, cfg.Net.TLS.Enable)
+				require.NotNil(t, cfg.Net.TLS.Config)
+				assert.True(t, cfg.Net.TLS.Config.InsecureSkipVerify)
+				assert.False(t, cfg.Net.SASL.Enable)
+			},
+		},
+		{
+			title:   "MissingCAFile",
+			cfg:     Config{Clusters: []ClusterConfig{{Name: "main", Brokers: []string{"localhost:9092"}, TLS: TLSConfig{Enabled: true, CAFile: filepath.Join(t.TempDir(), "ca.pem")}}}},
+			wantErr: true,
+		},
+		{
+			title:   "NoBrokers",
+			cfg:     Config{Clusters: []ClusterConfig{{Name: "main"}}},
+			wantErr: true,
+		},
+		{
+			title: "SharedBroker",
+			cfg: Config{Clusters: []ClusterConfig{
+				{Name: "main", Brokers: []string{"localhost:9092"}},
+				{Name: "other", Brokers: []string{"LOCALHOST:9092"}},
+			}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range table {
+		tt := tt
+		t.Run(tt.title, func(t *testing.T) {
+			t.Parallel()
+
+			client, err := New(tt.cfg)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			cl, err := client.clusterOf([]string{"localhost:9092"})
+			require.NoError(t, err)
+
+			cfg := client.saramaConfig(cl)
+			require.NoError(t, cfg.Validate())
+			tt.check(t, cfg)
+		})
+	}
+}
+
+func TestSplitBrokers(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{"a:9092", "b:9092"}, SplitBrokers(" a:9092, ,b:9092 "))
+	assert.Nil(t, SplitBrokers(""))
+}
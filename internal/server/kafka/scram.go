@@ -0,0 +1,29 @@
+package kafka
+
+import (
+	"github.com/xdg-go/scram"
+)
+
+// scramClient implements sarama.SCRAMClient for the SCRAM-SHA-256 and
+// SCRAM-SHA-512 mechanisms.
+type scramClient struct {
+	hashGen scram.HashGeneratorFcn
+	conv    *scram.ClientConversation
+}
+
+func (sc *scramClient) Begin(userName, password, authzID string) error {
+	client, err := sc.hashGen.NewClient(userName, password, authzID)
+	if err != nil {
+		return err
+	}
+	sc.conv = client.NewConversation()
+	return nil
+}
+
+func (sc *scramClient) Step(challenge string) (string, error) {
+	return sc.conv.Step(challenge)
+}
+
+func (sc *scramClient) Done() bool {
+	return sc.conv.Done()
+}
@@ -0,0 +1,140 @@
+// Package mask hides the values of sensitive env vars in the responses.
+package mask
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/odpf/dex/internal/server/secret"
+)
+
+// Placeholder replaces the sensitive values.
+const Placeholder = "********"
+
+// minTextValueLen is the minimum length of a value for it to be masked in
+// free-form text. Shorter values would mask unrelated parts of the text.
+const minTextValueLen = 4
+
+// DefaultPatterns are used when no patterns are configured.
+var DefaultPatterns = []string{"*_PASSWORD", "*_SECRET", "*CREDENTIAL*", "*_TOKEN", "*_API_KEY"}
+
+// Config contains the configurations for masking.
+type Config struct {
+	// Patterns are the glob patterns (e.g., '*_PASSWORD') of the env var
+	// keys whose values are masked. Patterns are case-insensitive.
+	Patterns []string `mapstructure:"patterns"`
+}
+
+// Masker masks the values of the env vars matching the patterns. A nil
+// Masker does not mask anything.
+type Masker struct {
+	patterns []string
+}
+
+// New returns a Masker as per the given config.
+func New(cfg Config) (*Masker, error) {
+	patterns := cfg.Patterns
+	if len(patterns) == 0 {
+		patterns = DefaultPatterns
+	}
+
+	m := &Masker{}
+	for _, p := range patterns {
+		p = strings.ToUpper(strings.TrimSpace(p))
+		if _, err := path.Match(p, ""); err != nil {
+			return nil, fmt.Errorf("mask pattern '%s' is not valid: %w", p, err)
+		}
+		m.patterns = append(m.patterns, p)
+	}
+	return m, nil
+}
+
+// IsSensitive returns true if the key matches any of the patterns.
+func (m *Masker) IsSensitive(key string) bool {
+	if m == nil {
+		return false
+	}
+
+	key = strings.ToUpper(key)
+	for _, p := range m.patterns {
+		if ok, _ := path.Match(p, key); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// EnvVars replaces the values of the sensitive env vars with the placeholder.
+// Secret references are not masked since they do not contain the secret.
+func (m *Masker) EnvVars(envVars map[string]string) {
+	for k, v := range envVars {
+		if v != "" && !secret.IsRef(v) && m.IsSensitive(k) {
+			envVars[k] = Placeholder
+		}
+	}
+}
+
+// Restore replaces the placeholders in the updated env vars with the
+// existing values so that an entity read with masked values can be written
+// back without losing the sensitive values.
+func (m *Masker) Restore(updated, existing map[string]string) {
+	for k, v := range updated {
+		if existingVal, found := existing[k]; found && v == Placeholder && m.IsSensitive(k) {
+			updated[k] = existingVal
+		}
+	}
+}
+
+// Changed returns the sorted keys of the sensitive env vars that are added,
+// changed or removed in updated. These changes are not visible when both
+// the versions are masked.
+func (m *Masker) Changed(updated, existing map[string]string) []string {
+	var keys []string
+	for k, v := range updated {
+		if existingVal, found := existing[k]; (!found || v != existingVal) && m.IsSensitive(k) {
+			keys = append(keys, k)
+		}
+	}
+	for k := range existing {
+		if _, found := updated[k]; !found && m.IsSensitive(k) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Values returns the values of the sensitive env vars, longest first, for
+// masking them in free-form text. Env vars in extraKeys are considered
+// sensitive irrespective of the patterns.
+func (m *Masker) Values(envVars map[string]string, extraKeys ...string) []string {
+	extra := map[string]bool{}
+	for _, k := range extraKeys {
+		extra[k] = true
+	}
+
+	var values []string
+	for k, v := range envVars {
+		if len(v) >= minTextValueLen && !secret.IsRef(v) && (extra[k] || m.IsSensitive(k)) {
+			values = append(values, v)
+		}
+	}
+
+	// longest first so that a value containing another is fully masked.
+	sort.Slice(values, func(i, j int) bool {
+		return len(values[i]) > len(values[j])
+	})
+	return values
+}
+
+// Text replaces all the occurrences of the values in data with the
+// placeholder.
+func Text(data []byte, values []string) []byte {
+	for _, v := range values {
+		data = bytes.ReplaceAll(data, []byte(v), []byte(Placeholder))
+	}
+	return data
+}
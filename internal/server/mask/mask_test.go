@@ -0,0 +1,82 @@
+package mask
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMasker(t *testing.T) {
+	t.Parallel()
+
+	m, err := New(Config{})
+	require.NoError(t, err)
+
+	envVars := map[string]string{
+		"SINK_JDBC_PASSWORD":            "s3cret-pass",
+		"SINK_BIGQUERY_CREDENTIAL_PATH": "/etc/creds.json",
+		"sink_http_secret":              "lowercase",
+		"SINK_REDIS_PASSWORD":           "secret://redis/password",
+		"SINK_JDBC_USERNAME":            "dex",
+	}
+
+	t.Run("Values", func(t *testing.T) {
+		values := m.Values(envVars, "SINK_JDBC_USERNAME")
+		assert.ElementsMatch(t, []string{"s3cret-pass", "/etc/creds.json", "lowercase"}, values)
+		assert.Equal(t, "/etc/creds.json", values[0], "longest must be first")
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		got := Text([]byte("connecting with s3cret-pass to db"), m.Values(envVars))
+		assert.Equal(t, "connecting with ******** to db", string(got))
+	})
+
+	t.Run("EnvVars", func(t *testing.T) {
+		masked := map[string]string{}
+		for k, v := range envVars {
+			masked[k] = v
+		}
+		m.EnvVars(masked)
+
+		assert.Equal(t, map[string]string{
+			"SINK_JDBC_PASSWORD":            Placeholder,
+			"SINK_BIGQUERY_CREDENTIAL_PATH": Placeholder,
+			"sink_http_secret":              Placeholder,
+			"SINK_REDIS_PASSWORD":           "secret://redis/password",
+			"SINK_JDBC_USERNAME":            "dex",
+		}, masked)
+	})
+
+	t.Run("Restore", func(t *testing.T) {
+		updated := map[string]string{
+			"SINK_JDBC_PASSWORD": Placeholder,
+			"sink_http_secret":   "rotated",
+			"SINK_JDBC_USERNAME": Placeholder,
+		}
+		m.Restore(updated, envVars)
+
+		assert.Equal(t, map[string]string{
+			"SINK_JDBC_PASSWORD": envVars["SINK_JDBC_PASSWORD"],
+			"sink_http_secret":   "rotated",
+			"SINK_JDBC_USERNAME": Placeholder,
+		}, updated)
+	})
+
+	t.Run("Changed", func(t *testing.T) {
+		updated := map[string]string{
+			"SINK_JDBC_PASSWORD":  "rotated",
+			"sink_http_secret":    envVars["sink_http_secret"],
+			"SINK_JDBC_USERNAME":  "changed",
+			"SINK_REDIS_PASSWORD": envVars["SINK_REDIS_PASSWORD"],
+			"SINK_NEW_TOKEN":      "t0ken",
+		}
+		assert.Equal(t, []string{"SINK_BIGQUERY_CREDENTIAL_PATH", "SINK_JDBC_PASSWORD", "SINK_NEW_TOKEN"}, m.Changed(updated, envVars))
+		assert.Empty(t, m.Changed(envVars, envVars))
+	})
+
+	t.Run("InvalidPattern", func(t *testing.T) {
+		_, err := New(Config{Patterns: []string{"[A-"}})
+		assert.Error(t, err)
+	})
+}
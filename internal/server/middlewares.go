@@ -153,6 +153,18 @@ func currentRouteGetter(router chi.Router) func(r *http.Request) string {
 	}
 }
 
+// projectSlugGetter returns a function that returns the value of the
+// projectSlug path param of the route matching the request.
+func projectSlugGetter(router chi.Router) func(r *http.Request) string {
+	return func(r *http.Request) string {
+		rCtx := chi.NewRouteContext()
+		if !router.Match(rCtx, r.Method, r.URL.Path) {
+			return ""
+		}
+		return rCtx.URLParam("projectSlug")
+	}
+}
+
 func is2xx(status int) bool {
 	const max2xxCode = 299
 	return status >= http.StatusOK && status < max2xxCode
@@ -0,0 +1,29 @@
+package operation
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/odpf/dex/internal/server/authz"
+	"github.com/odpf/dex/internal/server/utils"
+)
+
+// HandleGet returns the handler for getting an operation by its id. Since
+// the route is not project-scoped, the user must have the view permission
+// on the project of the operation.
+func HandleGet(svc *Service, authorizer *authz.Authorizer) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		op, err := svc.Get(r.Context(), chi.URLParam(r, "operationID"))
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		if err := authorizer.CheckView(r, op.Project); err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+		utils.WriteJSON(w, http.StatusOK, op)
+	}
+}
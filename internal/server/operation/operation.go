@@ -0,0 +1,331 @@
+// Package operation records the mutating requests on firehoses as operations
+// and tracks the state of the resource until the changes are applied.
+package operation
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/rs/xid"
+
+	"github.com/odpf/dex/pkg/errors"
+)
+
+// Statuses of an operation.
+const (
+	StatusRunning   = "RUNNING"
+	StatusSucceeded = "SUCCEEDED"
+	StatusFailed    = "FAILED"
+)
+
+const msgNotTracked = "operation was not tracked to completion (server restarted or timed out)"
+
+// Operation represents a mutating request and its progress.
+type Operation struct {
+	ID          string          `json:"id"`
+	Project     string          `json:"project"`
+	URN         string          `json:"urn"`
+	Action      string          `json:"action"`
+	Params      json.RawMessage `json:"params,omitempty"`
+	Actor       string          `json:"actor,omitempty"`
+	ActorID     string          `json:"actor_id,omitempty"`
+	RequestID   string          `json:"request_id,omitempty"`
+	Status      string          `json:"status"`
+	Error       string          `json:"error,omitempty"`
+	Transitions []Transition    `json:"transitions"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+	FinishedAt  *time.Time      `json:"finished_at,omitempty"`
+
+	// Checkpoint is the state of an operation run by the server itself (see
+	// Create) that is needed to recover it if the server stops. It is never
+	// returned in the responses since it can contain sensitive values.
+	Checkpoint json.RawMessage `json:"-"`
+}
+
+// Transition is a change in the state of the resource observed while the
+// operation is running.
+type Transition struct {
+	Status    string    `json:"status"`
+	State     string    `json:"state,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// Observation is the state of the resource as seen by a PollFunc. Done is
+// set once the operation is complete. Err is set if it failed.
+type Observation struct {
+	Status string
+	State  string
+	Done   bool
+	Err    error
+}
+
+// PollFunc returns the current state of the resource of an operation.
+type PollFunc func(ctx context.Context) (Observation, error)
+
+// Filter represents the criteria for listing operations. Empty fields are
+// ignored.
+type Filter struct {
+	Project string
+	URN     string
+	Action  string
+	Status  string
+	Limit   int
+}
+
+func (f Filter) match(op Operation) bool {
+	return (f.Project == "" || op.Project == f.Project) &&
+		(f.URN == "" || op.URN == f.URN) &&
+		(f.Action == "" || op.Action == f.Action) &&
+		(f.Status == "" || op.Status == f.Status)
+}
+
+// Store persists the operations.
+type Store interface {
+	Create(ctx context.Context, op Operation) error
+	Update(ctx context.Context, op Operation) error
+	Get(ctx context.Context, id string) (*Operation, error)
+
+	// List returns the operations matching the filter, most recent first.
+	List(ctx context.Context, filter Filter) ([]Operation, error)
+
+	// Claim updates the operation only if it was last updated at updatedAt
+	// (i.e., no one else updated it since it was read). Returns false if it
+	// was updated in the meantime.
+	Claim(ctx context.Context, op Operation, updatedAt time.Time) (bool, error)
+
+	Close() error
+}
+
+// Config contains the configurations for tracking operations.
+type Config struct {
+	// Driver of the store. Can be one of 'memory', 'sqlite' or 'postgres'.
+	// The firehose migrations are persisted as operations too. So, with
+	// 'memory', a migration interrupted by a restart is not rolled back and
+	// the migrations are visible only on the replica running them.
+	Driver string `mapstructure:"driver" default:"memory"`
+
+	// DSN of the database for the 'sqlite' & 'postgres' drivers.
+	DSN string `mapstructure:"dsn"`
+
+	// PollInterval is the interval at which the resource is polled while
+	// an operation is running.
+	PollInterval time.Duration `mapstructure:"poll_interval" default:"5s"`
+
+	// Timeout after which a running operation is marked as failed.
+	Timeout time.Duration `mapstructure:"timeout" default:"30m"`
+}
+
+// Service records the operations and tracks them in the background until
+// they are complete.
+type Service struct {
+	store        Store
+	pollInterval time.Duration
+	timeout      time.Duration
+	now          func() time.Time
+
+	wg     sync.WaitGroup
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// New returns a Service with the store described by cfg.
+func New(cfg Config) (*Service, error) {
+	store, err := NewStore(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return NewWithStore(store, cfg.PollInterval, cfg.Timeout), nil
+}
+
+// NewWithStore returns a Service that uses the given store.
+func NewWithStore(store Store, pollInterval, timeout time.Duration) *Service {
+	if pollInterval <= 0 {
+		pollInterval = 5 * time.Second
+	}
+	if timeout <= 0 {
+		timeout = 30 * time.Minute
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Service{
+		store:        store,
+		pollInterval: pollInterval,
+		timeout:      timeout,
+		now:          time.Now,
+		ctx:          ctx,
+		cancel:       cancel,
+	}
+}
+
+// Start records the operation and tracks it in the background using poll
+// until it is complete. The ID, status and timestamps are set by Start.
+func (svc *Service) Start(ctx context.Context, op Operation, poll PollFunc) (*Operation, error) {
+	created, err := svc.Create(ctx, op)
+	if err != nil {
+		return nil, err
+	}
+
+	svc.wg.Add(1)
+	go svc.track(*created, poll)
+	return created, nil
+}
+
+// Create records an operation that is run by the caller, which reports its
+// progress using Progress. The ID, status and timestamps are set by Create.
+func (svc *Service) Create(ctx context.Context, op Operation) (*Operation, error) {
+	now := svc.now()
+	op.ID = xid.New().String()
+	op.Status = StatusRunning
+	op.Transitions = []Transition{}
+	op.CreatedAt = now
+	op.UpdatedAt = now
+
+	if err := svc.store.Create(ctx, op); err != nil {
+		return nil, err
+	}
+	return &op, nil
+}
+
+// Progress records the observation of an operation created using Create and
+// saves it along with its checkpoint, even if the state did not change.
+func (svc *Service) Progress(op *Operation, obs Observation) {
+	svc.transition(op, obs)
+	if obs.Done {
+		svc.finish(op, obs.Err)
+	} else {
+		svc.save(op)
+	}
+}
+
+// Claim marks the running operation read by the caller as updated now so
+// that it can be recovered by the caller. Returns false if it was updated
+// by someone else since it was read.
+func (svc *Service) Claim(ctx context.Context, op *Operation) (bool, error) {
+	prev := op.UpdatedAt
+	op.UpdatedAt = svc.now()
+	return svc.store.Claim(ctx, *op, prev)
+}
+
+// Get returns the operation with the given id.
+func (svc *Service) Get(ctx context.Context, id string) (*Operation, error) {
+	op, err := svc.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	svc.expire(op)
+	return op, nil
+}
+
+// List returns the operations matching the filter, most recent first.
+func (svc *Service) List(ctx context.Context, filter Filter) ([]Operation, error) {
+	ops, err := svc.store.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	for i := range ops {
+		svc.expire(&ops[i])
+	}
+	return ops, nil
+}
+
+// Close stops tracking the running operations and closes the store.
+func (svc *Service) Close() error {
+	svc.cancel()
+	svc.wg.Wait()
+	return svc.store.Close()
+}
+
+func (svc *Service) track(op Operation, poll PollFunc) {
+	defer svc.wg.Done()
+
+	ctx, cancel := context.WithTimeout(svc.ctx, svc.timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(svc.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		obs, err := poll(ctx)
+		if err != nil {
+			log.Printf("error: failed to poll operation '%s': %v", op.ID, err)
+		} else {
+			svc.observe(&op, obs)
+			if op.Status != StatusRunning {
+				return
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			if svc.ctx.Err() == nil {
+				// timed out. operations left running on shutdown are expired
+				// when read after the timeout.
+				svc.finish(&op, errors.New(msgNotTracked))
+			}
+			return
+
+		case <-ticker.C:
+		}
+	}
+}
+
+// observe records the observation if the state of the resource changed.
+func (svc *Service) observe(op *Operation, obs Observation) {
+	changed := svc.transition(op, obs)
+	if obs.Done {
+		svc.finish(op, obs.Err)
+	} else if changed {
+		svc.save(op)
+	}
+}
+
+// transition adds a transition to the operation if the state of the
+// resource changed. Returns true if it was added.
+func (svc *Service) transition(op *Operation, obs Observation) bool {
+	changed := len(op.Transitions) == 0
+	if !changed {
+		last := op.Transitions[len(op.Transitions)-1]
+		changed = last.Status != obs.Status || last.State != obs.State
+	}
+	if changed {
+		op.Transitions = append(op.Transitions, Transition{
+			Status:    obs.Status,
+			State:     obs.State,
+			Timestamp: svc.now(),
+		})
+	}
+	return changed
+}
+
+func (svc *Service) finish(op *Operation, err error) {
+	now := svc.now()
+	op.FinishedAt = &now
+	op.Status = StatusSucceeded
+	if err != nil {
+		op.Status = StatusFailed
+		op.Error = err.Error()
+	}
+	svc.save(op)
+}
+
+func (svc *Service) save(op *Operation) {
+	op.UpdatedAt = svc.now()
+	if err := svc.store.Update(context.Background(), *op); err != nil {
+		log.Printf("error: failed to update operation '%s': %v", op.ID, err)
+	}
+}
+
+// expire marks the operation as failed if it was not updated within the
+// timeout (i.e., the server tracking it was stopped). Operations with a
+// checkpoint are left to be recovered by the server instead.
+func (svc *Service) expire(op *Operation) {
+	if op.Status != StatusRunning || op.Checkpoint != nil ||
+		svc.now().Sub(op.UpdatedAt) <= svc.timeout+svc.pollInterval {
+		return
+	}
+	svc.finish(op, errors.New(msgNotTracked))
+}
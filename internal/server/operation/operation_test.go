@@ -0,0 +1,203 @@
+package operation
+
+import (
+	"context"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/odpf/dex/internal/server/sqlstore"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+func TestStores(t *testing.T) {
+	t.Parallel()
+
+	stores := map[string]func(t *testing.T) Store{
+		"Memory": func(t *testing.T) Store { return NewMemoryStore() },
+		"SQLite": func(t *testing.T) Store {
+			store, err := NewStore(Config{Driver: sqlstore.DriverSQLite, DSN: filepath.Join(t.TempDir(), "ops.db")})
+			require.NoError(t, err)
+			return store
+		},
+	}
+
+	for name, newStore := range stores {
+		newStore := newStore
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			store := newStore(t)
+			defer store.Close()
+			ctx := context.Background()
+
+			base := time.Date(2022, 10, 10, 10, 0, 0, 0, time.UTC)
+			ops := []Operation{
+				{ID: "op1", Project: "foo", URN: "urn1", Action: "scale", Status: StatusRunning, CreatedAt: base},
+				{ID: "op2", Project: "foo", URN: "urn2", Action: "stop", Status: StatusRunning, CreatedAt: base.Add(time.Minute)},
+				{ID: "op3", Project: "bar", URN: "urn1", Action: "start", Status: StatusRunning, CreatedAt: base.Add(2 * time.Minute)},
+			}
+			for _, op := range ops {
+				require.NoError(t, store.Create(ctx, op))
+			}
+
+			updated := ops[0]
+			updated.Status = StatusSucceeded
+			updated.Transitions = []Transition{{Status: "STATUS_COMPLETED", State: "RUNNING", Timestamp: base}}
+			require.NoError(t, store.Update(ctx, updated))
+
+			got, err := store.Get(ctx, "op1")
+			require.NoError(t, err)
+			assert.Equal(t, StatusSucceeded, got.Status)
+			assert.Len(t, got.Transitions, 1)
+
+			_, err = store.Get(ctx, "missing")
+			assert.ErrorIs(t, err, errors.ErrNotFound)
+			assert.ErrorIs(t, store.Update(ctx, Operation{ID: "missing"}), errors.ErrNotFound)
+
+			list, err := store.List(ctx, Filter{URN: "urn1"})
+			require.NoError(t, err)
+			require.Len(t, list, 2)
+			assert.Equal(t, "op3", list[0].ID)
+			assert.Equal(t, "op1", list[1].ID)
+
+			list, err = store.List(ctx, Filter{Project: "foo", Limit: 1})
+			require.NoError(t, err)
+			require.Len(t, list, 1)
+			assert.Equal(t, "op2", list[0].ID)
+
+			list, err = store.List(ctx, Filter{Action: "start", Status: StatusRunning})
+			require.NoError(t, err)
+			require.Len(t, list, 1)
+			assert.Equal(t, "op3", list[0].ID)
+
+			claimed := ops[2]
+			claimed.UpdatedAt = base.Add(time.Hour)
+			claimed.Checkpoint = []byte(`{"step":"delete"}`)
+			ok, err := store.Claim(ctx, claimed, ops[2].UpdatedAt)
+			require.NoError(t, err)
+			assert.True(t, ok)
+
+			got, err = store.Get(ctx, "op3")
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"step":"delete"}`, string(got.Checkpoint))
+
+			ok, err = store.Claim(ctx, claimed, ops[2].UpdatedAt)
+			require.NoError(t, err)
+			assert.False(t, ok, "operation updated since it was read must not be claimed")
+
+			_, err = store.Claim(ctx, Operation{ID: "missing"}, base)
+			assert.ErrorIs(t, err, errors.ErrNotFound)
+		})
+	}
+}
+
+func TestService(t *testing.T) {
+	t.Parallel()
+
+	t.Run("TracksUntilDone", func(t *testing.T) {
+		t.Parallel()
+
+		svc := NewWithStore(NewMemoryStore(), time.Millisecond, time.Minute)
+		defer svc.Close()
+
+		var polls int32
+		observations := []Observation{
+			{Status: "STATUS_PENDING", State: "RUNNING"},
+			{Status: "STATUS_PENDING", State: "RUNNING"},
+			{Status: "STATUS_COMPLETED", State: "RUNNING", Done: true},
+		}
+		op, err := svc.Start(context.Background(), Operation{Project: "foo", URN: "urn1", Action: "scale"},
+			func(ctx context.Context) (Observation, error) {
+				i := atomic.AddInt32(&polls, 1) - 1
+				return observations[i], nil
+			})
+		require.NoError(t, err)
+		assert.NotEmpty(t, op.ID)
+		assert.Equal(t, StatusRunning, op.Status)
+
+		require.Eventually(t, func() bool {
+			got, err := svc.Get(context.Background(), op.ID)
+			return err == nil && got.Status == StatusSucceeded
+		}, time.Second, time.Millisecond)
+
+		got, err := svc.Get(context.Background(), op.ID)
+		require.NoError(t, err)
+		require.Len(t, got.Transitions, 2)
+		assert.Equal(t, "STATUS_PENDING", got.Transitions[0].Status)
+		assert.Equal(t, "STATUS_COMPLETED", got.Transitions[1].Status)
+		assert.NotNil(t, got.FinishedAt)
+	})
+
+	t.Run("Failed", func(t *testing.T) {
+		t.Parallel()
+
+		svc := NewWithStore(NewMemoryStore(), time.Millisecond, time.Minute)
+		defer svc.Close()
+
+		op, err := svc.Start(context.Background(), Operation{Project: "foo", URN: "urn1", Action: "upgrade"},
+			func(ctx context.Context) (Observation, error) {
+				return Observation{Status: "STATUS_ERROR", Done: true, Err: errors.New("sync failed")}, nil
+			})
+		require.NoError(t, err)
+
+		require.Eventually(t, func() bool {
+			got, err := svc.Get(context.Background(), op.ID)
+			return err == nil && got.Status == StatusFailed && got.Error == "sync failed"
+		}, time.Second, time.Millisecond)
+	})
+
+	t.Run("ExpiresUntracked", func(t *testing.T) {
+		t.Parallel()
+
+		store := NewMemoryStore()
+		now := time.Now()
+		require.NoError(t, store.Create(context.Background(), Operation{
+			ID: "op1", Status: StatusRunning, CreatedAt: now.Add(-time.Hour),
+		}))
+
+		svc := NewWithStore(store, time.Second, time.Minute)
+		defer svc.Close()
+
+		got, err := svc.Get(context.Background(), "op1")
+		require.NoError(t, err)
+		assert.Equal(t, StatusFailed, got.Status)
+		assert.Equal(t, msgNotTracked, got.Error)
+	})
+
+	t.Run("RunByCaller", func(t *testing.T) {
+		t.Parallel()
+
+		svc := NewWithStore(NewMemoryStore(), time.Second, time.Minute)
+		defer svc.Close()
+
+		op, err := svc.Create(context.Background(), Operation{Project: "foo", URN: "urn1", Action: "migrate"})
+		require.NoError(t, err)
+
+		op.Checkpoint = []byte(`{"step":"stop"}`)
+		svc.Progress(op, Observation{Status: "RUNNING", State: "stop"})
+		svc.Progress(op, Observation{Status: "RUNNING", State: "stop"})
+
+		// left running by a stopped server, but recovered using the
+		// checkpoint instead of being expired.
+		svc.now = func() time.Time { return time.Now().Add(time.Hour) }
+		got, err := svc.Get(context.Background(), op.ID)
+		require.NoError(t, err)
+		assert.Equal(t, StatusRunning, got.Status)
+		assert.Len(t, got.Transitions, 1)
+
+		ok, err := svc.Claim(context.Background(), got)
+		require.NoError(t, err)
+		assert.True(t, ok)
+
+		svc.Progress(got, Observation{Status: "ROLLED_BACK", Done: true, Err: errors.New("interrupted")})
+		got, err = svc.Get(context.Background(), op.ID)
+		require.NoError(t, err)
+		assert.Equal(t, StatusFailed, got.Status)
+		assert.Len(t, got.Transitions, 2)
+	})
+}
@@ -0,0 +1,279 @@
+package operation
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/odpf/dex/internal/server/sqlstore"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+var errNotFound = errors.ErrNotFound.WithMsgf("no operation with given id")
+
+// NewStore returns the store described by cfg.
+func NewStore(cfg Config) (Store, error) {
+	if sqlstore.IsMemory(cfg.Driver) {
+		return NewMemoryStore(), nil
+	}
+
+	db, err := sqlstore.Open(cfg.Driver, cfg.DSN, createTableQuery)
+	if err != nil {
+		return nil, fmt.Errorf("operations store: %w", err)
+	}
+	return &sqlStore{db: db}, nil
+}
+
+// memoryStore keeps the operations in memory. Operations are lost on
+// restart.
+type memoryStore struct {
+	mu  sync.RWMutex
+	ops []Operation
+	idx map[string]int
+}
+
+// NewMemoryStore returns a store that keeps the operations in memory.
+func NewMemoryStore() Store {
+	return &memoryStore{idx: map[string]int{}}
+}
+
+func (ms *memoryStore) Create(_ context.Context, op Operation) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if _, found := ms.idx[op.ID]; found {
+		return errors.ErrConflict.WithMsgf("operation with id '%s' already exists", op.ID)
+	}
+	ms.idx[op.ID] = len(ms.ops)
+	ms.ops = append(ms.ops, cloneOperation(op))
+	return nil
+}
+
+func (ms *memoryStore) Update(_ context.Context, op Operation) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	i, found := ms.idx[op.ID]
+	if !found {
+		return errNotFound
+	}
+	ms.ops[i] = cloneOperation(op)
+	return nil
+}
+
+func (ms *memoryStore) Get(_ context.Context, id string) (*Operation, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+
+	i, found := ms.idx[id]
+	if !found {
+		return nil, errNotFound
+	}
+	op := cloneOperation(ms.ops[i])
+	return &op, nil
+}
+
+func (ms *memoryStore) List(_ context.Context, filter Filter) ([]Operation, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+
+	var res []Operation
+	for i := len(ms.ops) - 1; i >= 0; i-- {
+		if filter.match(ms.ops[i]) {
+			res = append(res, cloneOperation(ms.ops[i]))
+			if filter.Limit > 0 && len(res) >= filter.Limit {
+				break
+			}
+		}
+	}
+	return res, nil
+}
+
+func (ms *memoryStore) Claim(_ context.Context, op Operation, updatedAt time.Time) (bool, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	i, found := ms.idx[op.ID]
+	if !found {
+		return false, errNotFound
+	} else if !ms.ops[i].UpdatedAt.Equal(updatedAt) {
+		return false, nil
+	}
+	ms.ops[i] = cloneOperation(op)
+	return true, nil
+}
+
+func (ms *memoryStore) Close() error { return nil }
+
+func cloneOperation(op Operation) Operation {
+	op.Transitions = append([]Transition{}, op.Transitions...)
+	if op.Checkpoint != nil {
+		op.Checkpoint = append(json.RawMessage{}, op.Checkpoint...)
+	}
+	return op
+}
+
+// sqlStore keeps the operations in a SQLite or Postgres database. The
+// operation is stored as JSON along with the columns used for filtering.
+// The checkpoint is kept in its own column since it is not marshalled.
+type sqlStore struct {
+	db *sql.DB
+}
+
+const createTableQuery = `
+CREATE TABLE IF NOT EXISTS operations (
+	id         VARCHAR(64) PRIMARY KEY,
+	project    TEXT NOT NULL,
+	urn        TEXT NOT NULL,
+	action     VARCHAR(64) NOT NULL,
+	status     VARCHAR(32) NOT NULL,
+	created_at BIGINT NOT NULL,
+	updated_at BIGINT NOT NULL,
+	data       TEXT NOT NULL,
+	checkpoint TEXT
+);
+CREATE INDEX IF NOT EXISTS operations_urn_idx ON operations (urn, created_at);
+CREATE INDEX IF NOT EXISTS operations_project_idx ON operations (project, created_at);
+CREATE INDEX IF NOT EXISTS operations_status_idx ON operations (status, action);
+`
+
+func (ss *sqlStore) Create(ctx context.Context, op Operation) error {
+	data, err := json.Marshal(op)
+	if err != nil {
+		return err
+	}
+
+	const query = `INSERT INTO operations (id, project, urn, action, status, created_at, updated_at, data, checkpoint)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+	_, err = ss.db.ExecContext(ctx, query, op.ID, op.Project, op.URN, op.Action, op.Status,
+		op.CreatedAt.UnixNano(), op.UpdatedAt.UnixNano(), string(data), nullString(op.Checkpoint))
+	return err
+}
+
+func (ss *sqlStore) Update(ctx context.Context, op Operation) error {
+	data, err := json.Marshal(op)
+	if err != nil {
+		return err
+	}
+
+	// placeholders must be in order since sqlite binds them by position.
+	const query = `UPDATE operations SET status = $1, updated_at = $2, data = $3, checkpoint = $4 WHERE id = $5`
+	res, err := ss.db.ExecContext(ctx, query, op.Status, op.UpdatedAt.UnixNano(), string(data), nullString(op.Checkpoint), op.ID)
+	if err != nil {
+		return err
+	} else if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return errNotFound
+	}
+	return nil
+}
+
+func (ss *sqlStore) Claim(ctx context.Context, op Operation, updatedAt time.Time) (bool, error) {
+	data, err := json.Marshal(op)
+	if err != nil {
+		return false, err
+	}
+
+	const query = `UPDATE operations SET status = $1, updated_at = $2, data = $3, checkpoint = $4 WHERE id = $5 AND updated_at = $6`
+	res, err := ss.db.ExecContext(ctx, query, op.Status, op.UpdatedAt.UnixNano(), string(data), nullString(op.Checkpoint),
+		op.ID, updatedAt.UnixNano())
+	if err != nil {
+		return false, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	} else if n == 0 {
+		if _, err := ss.Get(ctx, op.ID); err != nil {
+			return false, err
+		}
+		return false, nil
+	}
+	return true, nil
+}
+
+func (ss *sqlStore) Get(ctx context.Context, id string) (*Operation, error) {
+	row := ss.db.QueryRowContext(ctx, `SELECT data, checkpoint FROM operations WHERE id = $1`, id)
+	op, err := scanOperation(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errNotFound
+		}
+		return nil, err
+	}
+	return op, nil
+}
+
+func (ss *sqlStore) List(ctx context.Context, filter Filter) ([]Operation, error) {
+	var conds []string
+	var args []any
+	if filter.Project != "" {
+		args = append(args, filter.Project)
+		conds = append(conds, fmt.Sprintf("project = $%d", len(args)))
+	}
+	if filter.URN != "" {
+		args = append(args, filter.URN)
+		conds = append(conds, fmt.Sprintf("urn = $%d", len(args)))
+	}
+	if filter.Action != "" {
+		args = append(args, filter.Action)
+		conds = append(conds, fmt.Sprintf("action = $%d", len(args)))
+	}
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		conds = append(conds, fmt.Sprintf("status = $%d", len(args)))
+	}
+
+	query := `SELECT data, checkpoint FROM operations`
+	if len(conds) > 0 {
+		query += ` WHERE ` + strings.Join(conds, " AND ")
+	}
+	query += ` ORDER BY created_at DESC, id DESC`
+	if filter.Limit > 0 {
+		args = append(args, filter.Limit)
+		query += fmt.Sprintf(" LIMIT $%d", len(args))
+	}
+
+	rows, err := ss.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []Operation
+	for rows.Next() {
+		op, err := scanOperation(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, *op)
+	}
+	return res, rows.Err()
+}
+
+func (ss *sqlStore) Close() error { return ss.db.Close() }
+
+func scanOperation(row sqlstore.RowScanner) (*Operation, error) {
+	var data string
+	var checkpoint sql.NullString
+	if err := row.Scan(&data, &checkpoint); err != nil {
+		return nil, err
+	}
+
+	var op Operation
+	if err := json.Unmarshal([]byte(data), &op); err != nil {
+		return nil, err
+	}
+	if checkpoint.Valid {
+		op.Checkpoint = json.RawMessage(checkpoint.String)
+	}
+	return &op, nil
+}
+
+func nullString(b []byte) sql.NullString {
+	return sql.NullString{String: string(b), Valid: b != nil}
+}
@@ -0,0 +1,183 @@
+package reqctx
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+
+	"github.com/odpf/dex/internal/server/utils"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+// Supported authentication modes.
+const (
+	// AuthModeHeader trusts the identity headers set by an upstream proxy
+	// (e.g., Shield).
+	AuthModeHeader = "header"
+
+	// AuthModeJWT validates the bearer token of the request and uses its
+	// claims as the identity.
+	AuthModeJWT = "jwt"
+)
+
+// AuthConfig contains the configurations for authenticating the requests.
+type AuthConfig struct {
+	// Mode can be one of 'header' or 'jwt'.
+	Mode string `mapstructure:"mode" default:"header"`
+
+	// HeaderFallback allows requests without a bearer token to be identified
+	// using the identity headers in 'jwt' mode.
+	HeaderFallback bool `mapstructure:"header_fallback" default:"false"`
+
+	JWT JWTConfig `mapstructure:"jwt"`
+}
+
+// JWTConfig contains the configurations for validating bearer tokens.
+type JWTConfig struct {
+	// Issuer is the expected 'iss' claim. If JWKSURL is not set, the keys
+	// are discovered using the OpenID configuration of the issuer. Required
+	// unless InsecureSkipIssuerCheck is set.
+	Issuer string `mapstructure:"issuer"`
+
+	// JWKSURL is the URL of the JSON Web Key Set used to verify tokens.
+	JWKSURL string `mapstructure:"jwks_url"`
+
+	// Audience is the expected 'aud' claim. Required unless
+	// InsecureSkipAudienceCheck is set.
+	Audience string `mapstructure:"audience"`
+
+	// InsecureSkipIssuerCheck and InsecureSkipAudienceCheck accept tokens
+	// with any issuer or audience, i.e., any token signed by the keys
+	// including the ones minted for other clients.
+	InsecureSkipIssuerCheck   bool `mapstructure:"insecure_skip_issuer_check" default:"false"`
+	InsecureSkipAudienceCheck bool `mapstructure:"insecure_skip_audience_check" default:"false"`
+
+	// EmailClaim is the claim that contains the email of the user.
+	EmailClaim string `mapstructure:"email_claim" default:"email"`
+}
+
+// Authenticator identifies the user of a request using the bearer token.
+type Authenticator struct {
+	verifier       *oidc.IDTokenVerifier
+	emailClaim     string
+	headerFallback bool
+}
+
+// NewAuthenticator returns an Authenticator for 'jwt' mode. Returns nil if
+// the mode is 'header' since the identity headers are used as is.
+func NewAuthenticator(ctx context.Context, cfg AuthConfig) (*Authenticator, error) {
+	switch strings.ToLower(strings.TrimSpace(cfg.Mode)) {
+	case AuthModeHeader, "":
+		return nil, nil
+
+	case AuthModeJWT:
+		verifier, err := newVerifier(ctx, cfg.JWT)
+		if err != nil {
+			return nil, err
+		}
+		return newAuthenticator(verifier, cfg), nil
+
+	default:
+		return nil, fmt.Errorf("auth mode must be one of '%s' or '%s', not '%s'",
+			AuthModeHeader, AuthModeJWT, cfg.Mode)
+	}
+}
+
+func newAuthenticator(verifier *oidc.IDTokenVerifier, cfg AuthConfig) *Authenticator {
+	emailClaim := cfg.JWT.EmailClaim
+	if emailClaim == "" {
+		emailClaim = "email"
+	}
+
+	return &Authenticator{
+		verifier:       verifier,
+		emailClaim:     emailClaim,
+		headerFallback: cfg.HeaderFallback,
+	}
+}
+
+func newVerifier(ctx context.Context, cfg JWTConfig) (*oidc.IDTokenVerifier, error) {
+	if cfg.Issuer == "" && !cfg.InsecureSkipIssuerCheck {
+		return nil, errors.New("jwt mode requires issuer unless insecure_skip_issuer_check is set")
+	} else if cfg.Audience == "" && !cfg.InsecureSkipAudienceCheck {
+		return nil, errors.New("jwt mode requires audience unless insecure_skip_audience_check is set")
+	}
+
+	oidcCfg := &oidc.Config{
+		ClientID:          cfg.Audience,
+		SkipClientIDCheck: cfg.InsecureSkipAudienceCheck,
+		SkipIssuerCheck:   cfg.InsecureSkipIssuerCheck,
+	}
+
+	if cfg.JWKSURL != "" {
+		keySet := oidc.NewRemoteKeySet(ctx, cfg.JWKSURL)
+		return oidc.NewVerifier(cfg.Issuer, keySet, oidcCfg), nil
+	} else if cfg.Issuer == "" {
+		return nil, errors.New("jwt mode requires at least one of issuer or jwks_url")
+	}
+
+	provider, err := oidc.NewProvider(ctx, cfg.Issuer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover keys of issuer '%s': %w", cfg.Issuer, err)
+	}
+	return provider.Verifier(oidcCfg), nil
+}
+
+// Middleware returns an HTTP middleware that replaces the identity in the
+// request context with the one in the bearer token. Requests with invalid
+// tokens are rejected. Requests without token are rejected unless header
+// fallback is enabled. A nil Authenticator leaves the identity as is.
+func (au *Authenticator) Middleware() func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if au == nil {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			rCtx := From(r.Context())
+
+			token, hasToken := bearerToken(r)
+			if !hasToken {
+				if !au.headerFallback {
+					utils.WriteErr(w, errors.ErrUnauthorized.WithMsgf("bearer token is required"))
+					return
+				}
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			idToken, err := au.verifier.Verify(r.Context(), token)
+			if err != nil {
+				utils.WriteErr(w, errors.ErrUnauthorized.
+					WithMsgf("bearer token is not valid").
+					WithCausef(err.Error()))
+				return
+			}
+
+			var claims map[string]any
+			if err := idToken.Claims(&claims); err != nil {
+				utils.WriteErr(w, errors.ErrUnauthorized.WithCausef(err.Error()))
+				return
+			}
+
+			rCtx.UserID = idToken.Subject
+			rCtx.UserEmail, _ = claims[au.emailClaim].(string)
+			next.ServeHTTP(w, r.WithContext(withReqCtx(r.Context(), rCtx)))
+		})
+	}
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	const prefix = "bearer "
+
+	header := strings.TrimSpace(r.Header.Get("Authorization"))
+	if len(header) <= len(prefix) || !strings.EqualFold(header[:len(prefix)], prefix) {
+		return "", false
+	}
+
+	token := strings.TrimSpace(header[len(prefix):])
+	return token, token != ""
+}
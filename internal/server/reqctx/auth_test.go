@@ -0,0 +1,156 @@
+package reqctx
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+const testIssuer = "https://issuer.example.com"
+
+func TestAuthenticator_Middleware(t *testing.T) {
+	t.Parallel()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: key}, nil)
+	require.NoError(t, err)
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	otherSigner, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: otherKey}, nil)
+	require.NoError(t, err)
+
+	makeToken := func(t *testing.T, sig jose.Signer, aud string, expiry time.Time) string {
+		t.Helper()
+
+		claims := jwt.Claims{
+			Issuer:   testIssuer,
+			Subject:  "user-1",
+			Audience: jwt.Audience{aud},
+			Expiry:   jwt.NewNumericDate(expiry),
+		}
+		token, err := jwt.Signed(sig).
+			Claims(claims).
+			Claims(map[string]any{"email": "user@example.com"}).
+			CompactSerialize()
+		require.NoError(t, err)
+		return token
+	}
+
+	newAuth := func(headerFallback bool) *Authenticator {
+		keySet := &oidc.StaticKeySet{PublicKeys: []crypto.PublicKey{&key.PublicKey}}
+		verifier := oidc.NewVerifier(testIssuer, keySet, &oidc.Config{ClientID: "dex-cli"})
+		return newAuthenticator(verifier, AuthConfig{Mode: AuthModeJWT, HeaderFallback: headerFallback})
+	}
+
+	serve := func(au *Authenticator, token, headerEmail string) (int, ReqCtx) {
+		var got ReqCtx
+		handler := WithRequestCtx()(au.Middleware()(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = From(r.Context())
+			}),
+		))
+
+		req := httptest.NewRequest(http.MethodGet, "/api/projects", nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		if headerEmail != "" {
+			req.Header.Set(headerUserEmail, headerEmail)
+		}
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code, got
+	}
+
+	validExpiry := time.Now().Add(time.Hour)
+
+	t.Run("ValidToken", func(t *testing.T) {
+		code, rCtx := serve(newAuth(false), makeToken(t, signer, "dex-cli", validExpiry), "spoofed@example.com")
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "user@example.com", rCtx.UserEmail)
+		assert.Equal(t, "user-1", rCtx.UserID)
+	})
+
+	t.Run("InvalidTokens", func(t *testing.T) {
+		tokens := map[string]string{
+			"Expired":       makeToken(t, signer, "dex-cli", time.Now().Add(-time.Hour)),
+			"WrongAudience": makeToken(t, signer, "other", validExpiry),
+			"WrongKey":      makeToken(t, otherSigner, "dex-cli", validExpiry),
+			"Garbage":       "not-a-jwt",
+		}
+
+		for name, token := range tokens {
+			code, _ := serve(newAuth(true), token, "")
+			assert.Equal(t, http.StatusUnauthorized, code, name)
+		}
+	})
+
+	t.Run("MissingTokenWithoutFallback", func(t *testing.T) {
+		code, _ := serve(newAuth(false), "", "user@example.com")
+		assert.Equal(t, http.StatusUnauthorized, code)
+	})
+
+	t.Run("MissingTokenWithFallback", func(t *testing.T) {
+		code, rCtx := serve(newAuth(true), "", "user@example.com")
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "user@example.com", rCtx.UserEmail)
+	})
+
+	t.Run("HeaderMode", func(t *testing.T) {
+		au, err := NewAuthenticator(context.Background(), AuthConfig{Mode: AuthModeHeader})
+		require.NoError(t, err)
+
+		code, rCtx := serve(au, "", "user@example.com")
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "user@example.com", rCtx.UserEmail)
+	})
+}
+
+func TestNewAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	table := []struct {
+		title   string
+		cfg     JWTConfig
+		wantErr bool
+	}{
+		{title: "IssuerAndAudience", cfg: JWTConfig{Issuer: testIssuer, Audience: "dex-cli", JWKSURL: "http://localhost/jwks"}},
+		{title: "MissingIssuer", cfg: JWTConfig{Audience: "dex-cli", JWKSURL: "http://localhost/jwks"}, wantErr: true},
+		{title: "MissingAudience", cfg: JWTConfig{Issuer: testIssuer, JWKSURL: "http://localhost/jwks"}, wantErr: true},
+		{
+			title: "SkippedChecks",
+			cfg:   JWTConfig{JWKSURL: "http://localhost/jwks", InsecureSkipIssuerCheck: true, InsecureSkipAudienceCheck: true},
+		},
+	}
+
+	for _, tt := range table {
+		tt := tt
+		t.Run(tt.title, func(t *testing.T) {
+			t.Parallel()
+
+			au, err := NewAuthenticator(context.Background(), AuthConfig{Mode: AuthModeJWT, JWT: tt.cfg})
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, au)
+		})
+	}
+}
@@ -22,3 +22,15 @@ func From(ctx context.Context) ReqCtx {
 	rCtx, _ := ctx.Value(reqCtxKey).(ReqCtx)
 	return rCtx
 }
+
+// With returns a copy of ctx with the given ReqCtx. Used for the work done
+// on behalf of a user outside of a request.
+func With(ctx context.Context, reqCtx ReqCtx) context.Context {
+	return withReqCtx(ctx, reqCtx)
+}
+
+// Detach returns a background context with the ReqCtx of the given context.
+// Used for the work that must continue after the request is finished.
+func Detach(ctx context.Context) context.Context {
+	return withReqCtx(context.Background(), From(ctx))
+}
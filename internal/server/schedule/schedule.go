@@ -0,0 +1,374 @@
+// Package schedule runs actions on firehoses at the times given by cron
+// expressions. Runs are claimed in the store before they are dispatched so
+// that each run is dispatched once even if multiple replicas of the server
+// share the store.
+package schedule
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.com/rs/xid"
+
+	"github.com/odpf/dex/internal/server/sqlstore"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+// Statuses of a run.
+const (
+	RunSucceeded = "SUCCEEDED"
+	RunFailed    = "FAILED"
+)
+
+// maxRuns is the number of most recent runs retained for a schedule.
+const maxRuns = 20
+
+// Schedule represents an action to be applied on a firehose at the times
+// given by the cron expression.
+type Schedule struct {
+	ID             string          `json:"id"`
+	Project        string          `json:"project"`
+	URN            string          `json:"urn"`
+	Cron           string          `json:"cron"`
+	Action         string          `json:"action"`
+	Params         json.RawMessage `json:"params,omitempty"`
+	Enabled        bool            `json:"enabled"`
+	CreatedBy      string          `json:"created_by,omitempty"`
+	CreatedByEmail string          `json:"created_by_email,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+
+	// UpdatedBy is the user who last created or updated the schedule. The
+	// runs are applied on behalf of this user.
+	UpdatedBy      string `json:"updated_by,omitempty"`
+	UpdatedByEmail string `json:"updated_by_email,omitempty"`
+
+	// LastRunAt is the scheduled time of the last run that was claimed.
+	LastRunAt *time.Time `json:"last_run_at,omitempty"`
+
+	// NextRunAt is the scheduled time of the next run. It is computed when
+	// the schedule is read and is not set for disabled schedules.
+	NextRunAt *time.Time `json:"next_run_at,omitempty"`
+
+	// Runs are the most recent runs, latest first.
+	Runs []Run `json:"runs"`
+}
+
+// Run is a dispatch of the action of a schedule.
+type Run struct {
+	ScheduledAt time.Time `json:"scheduled_at"`
+	StartedAt   time.Time `json:"started_at"`
+	Status      string    `json:"status"`
+	Error       string    `json:"error,omitempty"`
+	OperationID string    `json:"operation_id,omitempty"`
+}
+
+// DispatchFunc applies the action of the schedule. The id of the operation
+// tracking the action, if any, is returned.
+type DispatchFunc func(ctx context.Context, s Schedule) (string, error)
+
+// Filter represents the criteria for listing schedules. Empty fields are
+// ignored.
+type Filter struct {
+	Project string
+	URN     string
+}
+
+func (f Filter) match(s Schedule) bool {
+	return (f.Project == "" || s.Project == f.Project) &&
+		(f.URN == "" || s.URN == f.URN)
+}
+
+// Store persists the schedules.
+type Store interface {
+	Create(ctx context.Context, s Schedule) error
+
+	// Update replaces the definition of the schedule. The last run and the
+	// runs are not changed.
+	Update(ctx context.Context, s Schedule) error
+
+	Delete(ctx context.Context, id string) error
+	Get(ctx context.Context, id string) (*Schedule, error)
+
+	// List returns the schedules matching the filter, oldest first.
+	List(ctx context.Context, filter Filter) ([]Schedule, error)
+
+	// Claim records runAt as the last run of the schedule if it is later
+	// than the recorded one. Returns false if the run was already claimed.
+	Claim(ctx context.Context, id string, runAt time.Time) (bool, error)
+
+	// AddRun records the run of the schedule.
+	AddRun(ctx context.Context, id string, run Run) error
+
+	Close() error
+}
+
+// Config contains the configurations for the scheduler.
+type Config struct {
+	// Driver of the store. Can be one of 'memory', 'sqlite' or 'postgres'.
+	// The 'sqlite' and 'postgres' stores can be shared by multiple replicas
+	// of the server. Schedules in 'memory' are lost on restart.
+	Driver string `mapstructure:"driver" default:"memory"`
+
+	// DSN of the database for the 'sqlite' & 'postgres' drivers.
+	DSN string `mapstructure:"dsn"`
+
+	// Replicas is the number of replicas of the server that can run at a
+	// time. The scheduler is not started with the 'memory' driver if it is
+	// more than one, since each replica would see only the schedules that
+	// were created on it.
+	Replicas int `mapstructure:"replicas" default:"1"`
+
+	// CheckInterval is the interval at which the schedules are checked for
+	// due runs.
+	CheckInterval time.Duration `mapstructure:"check_interval" default:"30s"`
+}
+
+// Service manages the schedules and dispatches their runs in the background.
+type Service struct {
+	store         Store
+	checkInterval time.Duration
+	now           func() time.Time
+
+	wg     sync.WaitGroup
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// New returns a Service with the store described by cfg.
+func New(cfg Config) (*Service, error) {
+	if err := sqlstore.CheckReplicas(cfg.Driver, cfg.Replicas); err != nil {
+		return nil, fmt.Errorf("schedules %w", err)
+	}
+
+	store, err := NewStore(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return NewWithStore(store, cfg.CheckInterval), nil
+}
+
+// NewWithStore returns a Service that uses the given store.
+func NewWithStore(store Store, checkInterval time.Duration) *Service {
+	if checkInterval <= 0 {
+		checkInterval = 30 * time.Second
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Service{
+		store:         store,
+		checkInterval: checkInterval,
+		now:           time.Now,
+		ctx:           ctx,
+		cancel:        cancel,
+	}
+}
+
+// ParseCron parses the standard 5-field cron expression. Descriptors such as
+// '@daily' and a 'CRON_TZ=<zone>' prefix are supported.
+func ParseCron(expr string) (cron.Schedule, error) {
+	sched, err := cron.ParseStandard(strings.TrimSpace(expr))
+	if err != nil {
+		return nil, errors.ErrInvalid.WithMsgf("invalid cron expression: %v", err)
+	}
+	return sched, nil
+}
+
+// Start checks the schedules for due runs in the background and dispatches
+// them until the service is closed.
+func (svc *Service) Start(dispatch DispatchFunc) {
+	svc.wg.Add(1)
+	go func() {
+		defer svc.wg.Done()
+
+		ticker := time.NewTicker(svc.checkInterval)
+		defer ticker.Stop()
+
+		for {
+			svc.runDue(svc.ctx, dispatch)
+
+			select {
+			case <-svc.ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Create validates and records the schedule. The ID and timestamps are set
+// by Create.
+func (svc *Service) Create(ctx context.Context, s Schedule) (*Schedule, error) {
+	if _, err := ParseCron(s.Cron); err != nil {
+		return nil, err
+	}
+
+	now := svc.now()
+	s.ID = xid.New().String()
+	s.CreatedAt = now
+	s.UpdatedAt = now
+	s.UpdatedBy = s.CreatedBy
+	s.UpdatedByEmail = s.CreatedByEmail
+	s.LastRunAt = nil
+	s.Runs = []Run{}
+
+	if err := svc.store.Create(ctx, s); err != nil {
+		return nil, err
+	}
+	svc.setNextRun(&s)
+	return &s, nil
+}
+
+// Update replaces the cron expression, action, params, the enabled flag and
+// the updater of the schedule.
+func (svc *Service) Update(ctx context.Context, s Schedule) (*Schedule, error) {
+	if _, err := ParseCron(s.Cron); err != nil {
+		return nil, err
+	}
+
+	existing, err := svc.store.Get(ctx, s.ID)
+	if err != nil {
+		return nil, err
+	}
+	existing.Cron = s.Cron
+	existing.Action = s.Action
+	existing.Params = s.Params
+	existing.Enabled = s.Enabled
+	existing.UpdatedBy = s.UpdatedBy
+	existing.UpdatedByEmail = s.UpdatedByEmail
+	existing.UpdatedAt = svc.now()
+
+	if err := svc.store.Update(ctx, *existing); err != nil {
+		return nil, err
+	}
+	svc.setNextRun(existing)
+	return existing, nil
+}
+
+// Delete removes the schedule.
+func (svc *Service) Delete(ctx context.Context, id string) error {
+	return svc.store.Delete(ctx, id)
+}
+
+// Get returns the schedule with the given id.
+func (svc *Service) Get(ctx context.Context, id string) (*Schedule, error) {
+	s, err := svc.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	svc.setNextRun(s)
+	return s, nil
+}
+
+// List returns the schedules matching the filter, oldest first.
+func (svc *Service) List(ctx context.Context, filter Filter) ([]Schedule, error) {
+	list, err := svc.store.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	for i := range list {
+		svc.setNextRun(&list[i])
+	}
+	return list, nil
+}
+
+// Close stops dispatching the runs and closes the store.
+func (svc *Service) Close() error {
+	svc.cancel()
+	svc.wg.Wait()
+	return svc.store.Close()
+}
+
+// runDue dispatches the runs of the schedules that are due. Runs missed
+// while the server was down are coalesced into a single run.
+func (svc *Service) runDue(ctx context.Context, dispatch DispatchFunc) {
+	list, err := svc.store.List(ctx, Filter{})
+	if err != nil {
+		log.Printf("error: failed to list schedules: %v", err)
+		return
+	}
+
+	now := svc.now()
+	for _, s := range list {
+		if !s.Enabled {
+			continue
+		}
+
+		runAt, due := dueRun(s, now)
+		if !due {
+			continue
+		}
+
+		claimed, err := svc.store.Claim(ctx, s.ID, runAt)
+		if err != nil {
+			log.Printf("error: failed to claim run of schedule '%s': %v", s.ID, err)
+			continue
+		} else if !claimed {
+			// another replica is dispatching this run.
+			continue
+		}
+
+		run := Run{ScheduledAt: runAt, StartedAt: svc.now(), Status: RunSucceeded}
+		run.OperationID, err = dispatch(ctx, s)
+		if err != nil {
+			run.Status = RunFailed
+			run.Error = err.Error()
+			log.Printf("error: scheduled %s of '%s' failed (schedule '%s'): %v", s.Action, s.URN, s.ID, err)
+		}
+
+		if err := svc.store.AddRun(ctx, s.ID, run); err != nil {
+			log.Printf("error: failed to record run of schedule '%s': %v", s.ID, err)
+		}
+	}
+}
+
+func (svc *Service) setNextRun(s *Schedule) {
+	s.NextRunAt = nil
+	if !s.Enabled {
+		return
+	}
+
+	sched, err := ParseCron(s.Cron)
+	if err != nil {
+		return
+	}
+	next := sched.Next(lastRunBase(*s))
+	s.NextRunAt = &next
+}
+
+// dueRun returns the latest scheduled time of the schedule that is not after
+// now and has not been run yet.
+func dueRun(s Schedule, now time.Time) (time.Time, bool) {
+	sched, err := ParseCron(s.Cron)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	next := sched.Next(lastRunBase(s))
+	if next.IsZero() || next.After(now) {
+		return time.Time{}, false
+	}
+
+	for {
+		following := sched.Next(next)
+		if following.IsZero() || following.After(now) {
+			return next, true
+		}
+		next = following
+	}
+}
+
+// lastRunBase returns the time after which the runs of the schedule are
+// due. Changing the schedule does not trigger the runs missed before it.
+func lastRunBase(s Schedule) time.Time {
+	if s.LastRunAt != nil && s.LastRunAt.After(s.UpdatedAt) {
+		return *s.LastRunAt
+	}
+	return s.UpdatedAt
+}
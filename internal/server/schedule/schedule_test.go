@@ -0,0 +1,208 @@
+package schedule
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/odpf/dex/internal/server/sqlstore"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+func TestStores(t *testing.T) {
+	t.Parallel()
+
+	stores := map[string]func(t *testing.T) Store{
+		"Memory": func(t *testing.T) Store { return NewMemoryStore() },
+		"SQLite": func(t *testing.T) Store {
+			store, err := NewStore(Config{Driver: sqlstore.DriverSQLite, DSN: filepath.Join(t.TempDir(), "schedules.db")})
+			require.NoError(t, err)
+			return store
+		},
+	}
+
+	for name, newStore := range stores {
+		newStore := newStore
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			store := newStore(t)
+			defer store.Close()
+			ctx := context.Background()
+
+			base := time.Date(2022, 10, 10, 10, 0, 0, 0, time.UTC)
+			list := []Schedule{
+				{ID: "s1", Project: "foo", URN: "urn1", Cron: "0 22 * * *", Action: "stop", Enabled: true, CreatedAt: base},
+				{ID: "s2", Project: "foo", URN: "urn2", Cron: "0 6 * * *", Action: "start", Enabled: true, CreatedAt: base.Add(time.Minute)},
+				{ID: "s3", Project: "foo", URN: "urn1", Cron: "0 6 * * *", Action: "start", CreatedAt: base.Add(2 * time.Minute)},
+			}
+			for _, s := range list {
+				require.NoError(t, store.Create(ctx, s))
+			}
+
+			claimed, err := store.Claim(ctx, "s1", base.Add(time.Hour))
+			require.NoError(t, err)
+			assert.True(t, claimed)
+
+			claimed, err = store.Claim(ctx, "s1", base.Add(time.Hour))
+			require.NoError(t, err)
+			assert.False(t, claimed, "same run must not be claimed twice")
+
+			for i := 0; i < maxRuns+1; i++ {
+				require.NoError(t, store.AddRun(ctx, "s1", Run{ScheduledAt: base.Add(time.Duration(i) * time.Hour), Status: RunSucceeded}))
+			}
+
+			updated := list[0]
+			updated.Cron = "0 23 * * *"
+			require.NoError(t, store.Update(ctx, updated))
+
+			got, err := store.Get(ctx, "s1")
+			require.NoError(t, err)
+			assert.Equal(t, "0 23 * * *", got.Cron)
+			require.NotNil(t, got.LastRunAt, "update must not reset the last run")
+			assert.True(t, got.LastRunAt.Equal(base.Add(time.Hour)))
+			require.Len(t, got.Runs, maxRuns)
+			assert.True(t, got.Runs[0].ScheduledAt.Equal(base.Add(maxRuns*time.Hour)))
+
+			res, err := store.List(ctx, Filter{URN: "urn1"})
+			require.NoError(t, err)
+			require.Len(t, res, 2)
+			assert.Equal(t, "s1", res[0].ID)
+			assert.Equal(t, "s3", res[1].ID)
+
+			require.NoError(t, store.Delete(ctx, "s1"))
+			_, err = store.Get(ctx, "s1")
+			assert.ErrorIs(t, err, errors.ErrNotFound)
+			assert.ErrorIs(t, store.Delete(ctx, "s1"), errors.ErrNotFound)
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	_, err := New(Config{Driver: sqlstore.DriverMemory, Replicas: 2})
+	assert.Error(t, err, "memory store must not be used by multiple replicas")
+
+	svc, err := New(Config{Driver: sqlstore.DriverSQLite, DSN: filepath.Join(t.TempDir(), "schedules.db"), Replicas: 2})
+	require.NoError(t, err)
+	assert.NoError(t, svc.Close())
+
+	svc, err = New(Config{Driver: sqlstore.DriverMemory, Replicas: 1})
+	require.NoError(t, err)
+	assert.NoError(t, svc.Close())
+}
+
+func TestDueRun(t *testing.T) {
+	t.Parallel()
+
+	base := time.Date(2022, 10, 10, 10, 0, 0, 0, time.UTC)
+	lastRun := base.Add(12 * time.Hour)
+
+	table := []struct {
+		title   string
+		s       Schedule
+		now     time.Time
+		want    time.Time
+		wantDue bool
+	}{
+		{
+			title: "NotDueYet",
+			s:     Schedule{Cron: "0 22 * * *", UpdatedAt: base},
+			now:   base.Add(time.Hour),
+		},
+		{
+			title:   "Due",
+			s:       Schedule{Cron: "0 22 * * *", UpdatedAt: base},
+			now:     base.Add(12*time.Hour + time.Minute),
+			want:    base.Add(12 * time.Hour),
+			wantDue: true,
+		},
+		{
+			title: "AlreadyRun",
+			s:     Schedule{Cron: "0 22 * * *", UpdatedAt: base, LastRunAt: &lastRun},
+			now:   base.Add(13 * time.Hour),
+		},
+		{
+			title:   "MissedRunsAreCoalesced",
+			s:       Schedule{Cron: "0 22 * * *", UpdatedAt: base},
+			now:     base.Add(72*time.Hour + time.Minute),
+			want:    base.Add(60 * time.Hour),
+			wantDue: true,
+		},
+		{
+			title: "RunsBeforeUpdateAreSkipped",
+			s:     Schedule{Cron: "0 22 * * *", UpdatedAt: base.Add(13 * time.Hour)},
+			now:   base.Add(14 * time.Hour),
+		},
+	}
+
+	for _, tt := range table {
+		tt := tt
+		t.Run(tt.title, func(t *testing.T) {
+			t.Parallel()
+
+			got, due := dueRun(tt.s, tt.now)
+			assert.Equal(t, tt.wantDue, due)
+			if tt.wantDue {
+				assert.True(t, tt.want.Equal(got), "want %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestService(t *testing.T) {
+	t.Parallel()
+
+	base := time.Date(2022, 10, 10, 10, 0, 0, 0, time.UTC)
+	store := NewMemoryStore()
+
+	// two services sharing a store behave like replicas of the server.
+	replicas := []*Service{NewWithStore(store, time.Minute), NewWithStore(store, time.Minute)}
+	for _, svc := range replicas {
+		svc.now = func() time.Time { return base }
+	}
+
+	_, err := replicas[0].Create(context.Background(), Schedule{Cron: "invalid"})
+	assert.ErrorIs(t, err, errors.ErrInvalid)
+
+	s, err := replicas[0].Create(context.Background(), Schedule{
+		Project: "foo", URN: "urn1", Cron: "0 22 * * *", Action: "stop", Enabled: true,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, s.NextRunAt)
+	assert.True(t, s.NextRunAt.Equal(base.Add(12*time.Hour)))
+
+	var dispatched []string
+	dispatch := func(ctx context.Context, s Schedule) (string, error) {
+		dispatched = append(dispatched, s.ID)
+		if len(dispatched) > 1 {
+			return "", errors.New("entropy is down")
+		}
+		return "op1", nil
+	}
+
+	for _, svc := range replicas {
+		svc.now = func() time.Time { return base.Add(12*time.Hour + time.Second) }
+		svc.runDue(context.Background(), dispatch)
+	}
+	assert.Equal(t, []string{s.ID}, dispatched, "run must be dispatched by only one replica")
+
+	for _, svc := range replicas {
+		svc.now = func() time.Time { return base.Add(36*time.Hour + time.Second) }
+		svc.runDue(context.Background(), dispatch)
+	}
+
+	got, err := replicas[1].Get(context.Background(), s.ID)
+	require.NoError(t, err)
+	require.Len(t, got.Runs, 2)
+	assert.Equal(t, RunFailed, got.Runs[0].Status)
+	assert.Equal(t, "entropy is down", got.Runs[0].Error)
+	assert.Equal(t, RunSucceeded, got.Runs[1].Status)
+	assert.Equal(t, "op1", got.Runs[1].OperationID)
+	assert.True(t, got.NextRunAt.Equal(base.Add(60*time.Hour)))
+}
@@ -0,0 +1,343 @@
+package schedule
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/odpf/dex/internal/server/sqlstore"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+var errNotFound = errors.ErrNotFound.WithMsgf("no schedule with given id")
+
+// NewStore returns the store described by cfg.
+func NewStore(cfg Config) (Store, error) {
+	if sqlstore.IsMemory(cfg.Driver) {
+		return NewMemoryStore(), nil
+	}
+
+	db, err := sqlstore.Open(cfg.Driver, cfg.DSN, createTableQuery)
+	if err != nil {
+		return nil, fmt.Errorf("schedules store: %w", err)
+	}
+	return &sqlStore{db: db}, nil
+}
+
+// memoryStore keeps the schedules in memory. Schedules are lost on restart.
+type memoryStore struct {
+	mu        sync.RWMutex
+	schedules map[string]Schedule
+}
+
+// NewMemoryStore returns a store that keeps the schedules in memory.
+func NewMemoryStore() Store {
+	return &memoryStore{schedules: map[string]Schedule{}}
+}
+
+func (ms *memoryStore) Create(_ context.Context, s Schedule) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if _, found := ms.schedules[s.ID]; found {
+		return errors.ErrConflict.WithMsgf("schedule with id '%s' already exists", s.ID)
+	}
+	ms.schedules[s.ID] = cloneSchedule(s)
+	return nil
+}
+
+func (ms *memoryStore) Update(_ context.Context, s Schedule) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	existing, found := ms.schedules[s.ID]
+	if !found {
+		return errNotFound
+	}
+	s.LastRunAt = existing.LastRunAt
+	s.Runs = existing.Runs
+	ms.schedules[s.ID] = cloneSchedule(s)
+	return nil
+}
+
+func (ms *memoryStore) Delete(_ context.Context, id string) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if _, found := ms.schedules[id]; !found {
+		return errNotFound
+	}
+	delete(ms.schedules, id)
+	return nil
+}
+
+func (ms *memoryStore) Get(_ context.Context, id string) (*Schedule, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+
+	s, found := ms.schedules[id]
+	if !found {
+		return nil, errNotFound
+	}
+	s = cloneSchedule(s)
+	return &s, nil
+}
+
+func (ms *memoryStore) List(_ context.Context, filter Filter) ([]Schedule, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+
+	var res []Schedule
+	for _, s := range ms.schedules {
+		if filter.match(s) {
+			res = append(res, cloneSchedule(s))
+		}
+	}
+	sortSchedules(res)
+	return res, nil
+}
+
+func (ms *memoryStore) Claim(_ context.Context, id string, runAt time.Time) (bool, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	s, found := ms.schedules[id]
+	if !found {
+		return false, errNotFound
+	} else if s.LastRunAt != nil && !runAt.After(*s.LastRunAt) {
+		return false, nil
+	}
+	s.LastRunAt = &runAt
+	ms.schedules[id] = s
+	return true, nil
+}
+
+func (ms *memoryStore) AddRun(_ context.Context, id string, run Run) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	s, found := ms.schedules[id]
+	if !found {
+		return errNotFound
+	}
+	s.Runs = prependRun(s.Runs, run)
+	ms.schedules[id] = s
+	return nil
+}
+
+func (ms *memoryStore) Close() error { return nil }
+
+func cloneSchedule(s Schedule) Schedule {
+	s.Runs = append([]Run{}, s.Runs...)
+	return s
+}
+
+func prependRun(runs []Run, run Run) []Run {
+	runs = append([]Run{run}, runs...)
+	if len(runs) > maxRuns {
+		runs = runs[:maxRuns]
+	}
+	return runs
+}
+
+func sortSchedules(list []Schedule) {
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].CreatedAt.Equal(list[j].CreatedAt) {
+			return list[i].ID < list[j].ID
+		}
+		return list[i].CreatedAt.Before(list[j].CreatedAt)
+	})
+}
+
+// sqlStore keeps the schedules in a SQLite or Postgres database. The
+// definition and the runs of a schedule are stored as JSON along with the
+// columns used for filtering and claiming the runs.
+type sqlStore struct {
+	db *sql.DB
+}
+
+const createTableQuery = `
+CREATE TABLE IF NOT EXISTS schedules (
+	id          VARCHAR(64) PRIMARY KEY,
+	project     TEXT NOT NULL,
+	urn         TEXT NOT NULL,
+	created_at  BIGINT NOT NULL,
+	last_run_at BIGINT NOT NULL DEFAULT 0,
+	data        TEXT NOT NULL,
+	runs        TEXT NOT NULL DEFAULT '[]'
+);
+CREATE INDEX IF NOT EXISTS schedules_urn_idx ON schedules (urn, created_at);
+`
+
+func (ss *sqlStore) Create(ctx context.Context, s Schedule) error {
+	data, err := marshalDefinition(s)
+	if err != nil {
+		return err
+	}
+
+	const query = `INSERT INTO schedules (id, project, urn, created_at, data) VALUES ($1, $2, $3, $4, $5)`
+	_, err = ss.db.ExecContext(ctx, query, s.ID, s.Project, s.URN, s.CreatedAt.UnixNano(), data)
+	return err
+}
+
+func (ss *sqlStore) Update(ctx context.Context, s Schedule) error {
+	data, err := marshalDefinition(s)
+	if err != nil {
+		return err
+	}
+
+	// placeholders must be in order since sqlite binds them by position.
+	res, err := ss.db.ExecContext(ctx, `UPDATE schedules SET data = $1 WHERE id = $2`, data, s.ID)
+	if err != nil {
+		return err
+	} else if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return errNotFound
+	}
+	return nil
+}
+
+func (ss *sqlStore) Delete(ctx context.Context, id string) error {
+	res, err := ss.db.ExecContext(ctx, `DELETE FROM schedules WHERE id = $1`, id)
+	if err != nil {
+		return err
+	} else if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return errNotFound
+	}
+	return nil
+}
+
+const selectQuery = `SELECT last_run_at, data, runs FROM schedules`
+
+func (ss *sqlStore) Get(ctx context.Context, id string) (*Schedule, error) {
+	s, err := scanSchedule(ss.db.QueryRowContext(ctx, selectQuery+` WHERE id = $1`, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errNotFound
+		}
+		return nil, err
+	}
+	return s, nil
+}
+
+func (ss *sqlStore) List(ctx context.Context, filter Filter) ([]Schedule, error) {
+	var conds []string
+	var args []any
+	if filter.Project != "" {
+		args = append(args, filter.Project)
+		conds = append(conds, fmt.Sprintf("project = $%d", len(args)))
+	}
+	if filter.URN != "" {
+		args = append(args, filter.URN)
+		conds = append(conds, fmt.Sprintf("urn = $%d", len(args)))
+	}
+
+	query := selectQuery
+	if len(conds) > 0 {
+		query += ` WHERE ` + strings.Join(conds, " AND ")
+	}
+	query += ` ORDER BY created_at, id`
+
+	rows, err := ss.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []Schedule
+	for rows.Next() {
+		s, err := scanSchedule(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, *s)
+	}
+	return res, rows.Err()
+}
+
+func (ss *sqlStore) Claim(ctx context.Context, id string, runAt time.Time) (bool, error) {
+	// the conditional update makes sure that only one of the replicas
+	// sharing the database claims the run.
+	const query = `UPDATE schedules SET last_run_at = $1 WHERE id = $2 AND last_run_at < $3`
+	res, err := ss.db.ExecContext(ctx, query, runAt.UnixNano(), id, runAt.UnixNano())
+	if err != nil {
+		return false, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (ss *sqlStore) AddRun(ctx context.Context, id string, run Run) error {
+	tx, err := ss.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var rawRuns string
+	if err := tx.QueryRowContext(ctx, `SELECT runs FROM schedules WHERE id = $1`, id).Scan(&rawRuns); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errNotFound
+		}
+		return err
+	}
+
+	var runs []Run
+	if err := json.Unmarshal([]byte(rawRuns), &runs); err != nil {
+		return err
+	}
+
+	updated, err := json.Marshal(prependRun(runs, run))
+	if err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE schedules SET runs = $1 WHERE id = $2`, string(updated), id); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (ss *sqlStore) Close() error { return ss.db.Close() }
+
+func scanSchedule(row sqlstore.RowScanner) (*Schedule, error) {
+	var lastRunAt int64
+	var data, rawRuns string
+	if err := row.Scan(&lastRunAt, &data, &rawRuns); err != nil {
+		return nil, err
+	}
+
+	var s Schedule
+	if err := json.Unmarshal([]byte(data), &s); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(rawRuns), &s.Runs); err != nil {
+		return nil, err
+	}
+	if lastRunAt > 0 {
+		t := time.Unix(0, lastRunAt).UTC()
+		s.LastRunAt = &t
+	}
+	return &s, nil
+}
+
+// marshalDefinition returns the schedule as JSON without the fields that are
+// stored in their own columns.
+func marshalDefinition(s Schedule) (string, error) {
+	s.LastRunAt = nil
+	s.NextRunAt = nil
+	s.Runs = nil
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,49 @@
+package secret
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/odpf/dex/pkg/errors"
+)
+
+// EnvProvider reads the secrets from the env vars of the server (i.e.,
+// '<prefix><PROJECT>_<NAME>_<KEY>').
+type EnvProvider struct {
+	Prefix string
+}
+
+func (ep *EnvProvider) Get(_ context.Context, project, name, key string) (string, error) {
+	envName := ep.Prefix + envSafe(project) + "_" + envSafe(name) + "_" + envSafe(key)
+	val, found := os.LookupEnv(envName)
+	if !found {
+		return "", errors.ErrNotFound
+	}
+	return val, nil
+}
+
+// FileProvider reads the secrets from files in the layout of mounted
+// kubernetes secrets, one directory per project (i.e.,
+// '<dir>/<project>/<name>/<key>').
+type FileProvider struct {
+	Dir string
+}
+
+func (fp *FileProvider) Get(_ context.Context, project, name, key string) (string, error) {
+	// project, name & key are validated by the resolver and cannot escape
+	// the dir.
+	b, err := os.ReadFile(filepath.Join(fp.Dir, project, name, key))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", errors.ErrNotFound
+		}
+		return "", err
+	}
+	return strings.TrimRight(string(b), "\r\n"), nil
+}
+
+func envSafe(s string) string {
+	return strings.ToUpper(strings.NewReplacer("-", "_", ".", "_").Replace(s))
+}
@@ -0,0 +1,183 @@
+// Package secret resolves the 'secret://<name>/<key>' references in firehose
+// env vars using a pluggable secret provider. Secrets are scoped to the
+// project of the firehose and a firehose cannot refer to the secrets of
+// other projects.
+package secret
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/odpf/dex/pkg/errors"
+)
+
+// Supported secret providers.
+const (
+	ProviderEnv  = "env"
+	ProviderFile = "file"
+)
+
+const refPrefix = "secret://"
+
+var (
+	partPattern = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+	errNoProvider = errors.ErrInvalid.WithMsgf("secret references are not supported since no secret provider is configured")
+)
+
+// Provider returns the value of a key of a secret of the project.
+// errors.ErrNotFound must be returned if the secret or key does not exist.
+type Provider interface {
+	Get(ctx context.Context, project, name, key string) (string, error)
+}
+
+// Config contains the configurations for resolving secret references.
+type Config struct {
+	// Provider can be one of 'env' or 'file'. Secret references are rejected
+	// if no provider is set.
+	Provider string `mapstructure:"provider"`
+
+	// Dir is the directory used by the 'file' provider. The value of a key is
+	// read from '<dir>/<project>/<name>/<key>' (i.e., the layout of mounted
+	// kubernetes secrets, one directory per project).
+	Dir string `mapstructure:"dir"`
+
+	// EnvPrefix is the prefix used by the 'env' provider. The value of a key
+	// is read from the env var '<prefix><PROJECT>_<NAME>_<KEY>'.
+	EnvPrefix string `mapstructure:"env_prefix" default:"DEX_SECRET_"`
+}
+
+// Resolver replaces the secret references with the values from the provider.
+type Resolver struct {
+	provider Provider
+}
+
+// New returns a Resolver as per the given config.
+func New(cfg Config) (*Resolver, error) {
+	switch strings.ToLower(strings.TrimSpace(cfg.Provider)) {
+	case "":
+		return NewResolver(nil), nil
+
+	case ProviderEnv:
+		return NewResolver(&EnvProvider{Prefix: cfg.EnvPrefix}), nil
+
+	case ProviderFile:
+		if cfg.Dir == "" {
+			return nil, fmt.Errorf("secret provider 'file' requires dir")
+		}
+		return NewResolver(&FileProvider{Dir: cfg.Dir}), nil
+
+	default:
+		return nil, fmt.Errorf("secret provider must be one of '%s' or '%s', not '%s'",
+			ProviderEnv, ProviderFile, cfg.Provider)
+	}
+}
+
+// NewResolver returns a Resolver that uses the given provider. A nil
+// provider rejects all secret references.
+func NewResolver(provider Provider) *Resolver {
+	return &Resolver{provider: provider}
+}
+
+// Resolve returns a copy of envVars with all the secret references replaced
+// by the values of the secrets of the project. Problems with all the
+// references are reported at once.
+func (res *Resolver) Resolve(ctx context.Context, project string, envVars map[string]string) (map[string]string, error) {
+	resolved := make(map[string]string, len(envVars))
+	for k, v := range envVars {
+		resolved[k] = v
+	}
+
+	refs := Refs(envVars)
+	if len(refs) == 0 {
+		return resolved, nil
+	} else if res == nil || res.provider == nil {
+		return nil, errNoProvider
+	} else if !partPattern.MatchString(project) {
+		return nil, errors.ErrInvalid.WithMsgf("secret references cannot be resolved for project '%s'", project)
+	}
+
+	var problems []errors.FieldError
+	for _, k := range sortedKeys(refs) {
+		field := "configs.env_vars." + k
+
+		name, key, err := ParseRef(refs[k])
+		if err != nil {
+			problems = append(problems, errors.FieldError{Field: field, Message: err.Error()})
+			continue
+		}
+
+		val, err := res.provider.Get(ctx, project, name, key)
+		if err != nil {
+			msg := fmt.Sprintf("failed to resolve secret '%s/%s'", name, key)
+			if errors.Is(err, errors.ErrNotFound) {
+				msg = fmt.Sprintf("secret '%s/%s' does not exist", name, key)
+			}
+			problems = append(problems, errors.FieldError{Field: field, Message: msg})
+			continue
+		}
+		resolved[k] = val
+	}
+
+	if len(problems) > 0 {
+		msgs := make([]string, len(problems))
+		for i, p := range problems {
+			msgs[i] = fmt.Sprintf("%s: %s", p.Field, p.Message)
+		}
+		return nil, errors.ErrInvalid.
+			WithMsgf("secret references are not valid: %s", strings.Join(msgs, "; ")).
+			WithDetails(problems...)
+	}
+	return resolved, nil
+}
+
+// IsRef returns true if the value is a secret reference.
+func IsRef(v string) bool {
+	return strings.HasPrefix(v, refPrefix)
+}
+
+// ParseRef parses a 'secret://<name>/<key>' reference.
+func ParseRef(ref string) (name, key string, err error) {
+	if !IsRef(ref) {
+		return "", "", fmt.Errorf("'%s' is not a secret reference", ref)
+	}
+
+	name, key, found := strings.Cut(strings.TrimPrefix(ref, refPrefix), "/")
+	if !found || !partPattern.MatchString(name) || !partPattern.MatchString(key) {
+		return "", "", fmt.Errorf("secret reference must be in 'secret://<name>/<key>' format")
+	}
+	return name, key, nil
+}
+
+// Refs returns the env vars whose values are secret references.
+func Refs(envVars map[string]string) map[string]string {
+	refs := map[string]string{}
+	for k, v := range envVars {
+		if IsRef(v) {
+			refs[k] = v
+		}
+	}
+	return refs
+}
+
+// Redact replaces the values of the env vars that were resolved from secret
+// references with the references themselves.
+func Redact(envVars, refs map[string]string) {
+	for k, ref := range refs {
+		if _, found := envVars[k]; found {
+			envVars[k] = ref
+		}
+	}
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,93 @@
+package secret
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/odpf/dex/pkg/errors"
+)
+
+func TestResolver_Resolve(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "foo", "pg"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "foo", "pg", "password"), []byte("s3cret\n"), 0o600))
+	t.Setenv("DEX_SECRET_FOO_BQ_CREDS", "{}")
+
+	envVars := map[string]string{
+		"SINK_JDBC_PASSWORD": "secret://pg/password",
+		"SINK_JDBC_USERNAME": "dex",
+	}
+
+	t.Run("File", func(t *testing.T) {
+		res, err := New(Config{Provider: ProviderFile, Dir: dir})
+		require.NoError(t, err)
+
+		got, err := res.Resolve(context.Background(), "foo", envVars)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"SINK_JDBC_PASSWORD": "s3cret", "SINK_JDBC_USERNAME": "dex"}, got)
+		assert.Equal(t, "secret://pg/password", envVars["SINK_JDBC_PASSWORD"], "input must not be modified")
+	})
+
+	t.Run("Env", func(t *testing.T) {
+		res, err := New(Config{Provider: ProviderEnv, EnvPrefix: "DEX_SECRET_"})
+		require.NoError(t, err)
+
+		got, err := res.Resolve(context.Background(), "foo", map[string]string{"CREDS": "secret://bq/creds"})
+		require.NoError(t, err)
+		assert.Equal(t, "{}", got["CREDS"])
+	})
+
+	t.Run("OtherProject", func(t *testing.T) {
+		file := NewResolver(&FileProvider{Dir: dir})
+		_, err := file.Resolve(context.Background(), "bar", envVars)
+		assert.ErrorIs(t, err, errors.ErrInvalid, "secrets of other projects must not be resolved")
+
+		env := NewResolver(&EnvProvider{Prefix: "DEX_SECRET_"})
+		_, err = env.Resolve(context.Background(), "bar", map[string]string{"CREDS": "secret://bq/creds"})
+		assert.ErrorIs(t, err, errors.ErrInvalid, "secrets of other projects must not be resolved")
+
+		_, err = file.Resolve(context.Background(), "..", envVars)
+		assert.ErrorIs(t, err, errors.ErrInvalid)
+	})
+
+	t.Run("AllProblems", func(t *testing.T) {
+		res := NewResolver(&FileProvider{Dir: dir})
+
+		_, err := res.Resolve(context.Background(), "foo", map[string]string{
+			"A": "secret://pg/missing",
+			"B": "secret://../etc/passwd",
+			"C": "secret://pg/password",
+		})
+		require.ErrorIs(t, err, errors.ErrInvalid)
+
+		e := errors.E(err)
+		require.Len(t, e.Details, 2)
+		assert.Equal(t, "configs.env_vars.A", e.Details[0].Field)
+		assert.Equal(t, "configs.env_vars.B", e.Details[1].Field)
+	})
+
+	t.Run("NoProvider", func(t *testing.T) {
+		res, err := New(Config{})
+		require.NoError(t, err)
+
+		_, err = res.Resolve(context.Background(), "foo", envVars)
+		assert.ErrorIs(t, err, errors.ErrInvalid)
+
+		got, err := res.Resolve(context.Background(), "foo", map[string]string{"A": "plain"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"A": "plain"}, got)
+	})
+}
+
+func TestRedact(t *testing.T) {
+	t.Parallel()
+
+	envVars := map[string]string{"PASSWORD": "s3cret", "USER": "dex"}
+	Redact(envVars, map[string]string{"PASSWORD": "secret://pg/password", "GONE": "secret://x/y"})
+	assert.Equal(t, map[string]string{"PASSWORD": "secret://pg/password", "USER": "dex"}, envVars)
+}
@@ -12,12 +12,22 @@ import (
 	sirenv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/siren/v1beta1"
 	"go.uber.org/zap"
 
+	"github.com/odpf/dex/internal/server/audit"
+	"github.com/odpf/dex/internal/server/authz"
+	"github.com/odpf/dex/internal/server/autoscale"
+	"github.com/odpf/dex/internal/server/kafka"
+	"github.com/odpf/dex/internal/server/mask"
+	"github.com/odpf/dex/internal/server/operation"
 	"github.com/odpf/dex/internal/server/reqctx"
+	"github.com/odpf/dex/internal/server/schedule"
+	"github.com/odpf/dex/internal/server/secret"
 	"github.com/odpf/dex/internal/server/utils"
 	alertsv1 "github.com/odpf/dex/internal/server/v1/alert"
 	firehosev1 "github.com/odpf/dex/internal/server/v1/firehose"
 	kubernetesv1 "github.com/odpf/dex/internal/server/v1/kubernetes"
 	projectsv1 "github.com/odpf/dex/internal/server/v1/project"
+	sinkv1 "github.com/odpf/dex/internal/server/v1/sink"
+	templatev1 "github.com/odpf/dex/internal/server/v1/template"
 )
 
 // Serve initialises all the HTTP API routes, starts listening for requests at addr, and blocks until
@@ -27,8 +37,88 @@ func Serve(ctx context.Context, addr string,
 	shieldClient shieldv1beta1.ShieldServiceClient,
 	entropyClient entropyv1beta1.ResourceServiceClient,
 	sirenClient sirenv1beta1.SirenServiceClient,
+	authCfg reqctx.AuthConfig,
+	authzCfg authz.Config,
+	auditCfg audit.Config,
+	templatesCfg templatev1.Config,
+	secretsCfg secret.Config,
+	maskCfg mask.Config,
+	kafkaCfg kafka.Config,
+	operationsCfg operation.Config,
+	schedulesCfg schedule.Config,
+	autoscaleCfg autoscale.Config,
 ) error {
 	alertSvc := &alertsv1.Service{Siren: sirenClient}
+	authorizer := authz.New(authzCfg, shieldClient)
+
+	authenticator, err := reqctx.NewAuthenticator(ctx, authCfg)
+	if err != nil {
+		return err
+	}
+
+	secretResolver, err := secret.New(secretsCfg)
+	if err != nil {
+		return err
+	}
+
+	masker, err := mask.New(maskCfg)
+	if err != nil {
+		return err
+	}
+
+	kafkaClient, err := kafka.New(kafkaCfg)
+	if err != nil {
+		return err
+	}
+
+	templateStore, err := templatev1.NewStore(templatesCfg)
+	if err != nil {
+		return err
+	}
+
+	auditSvc, err := audit.New(auditCfg)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := auditSvc.Close(); err != nil {
+			logger.Error("failed to close audit log", zap.Error(err))
+		}
+	}()
+
+	operationSvc, err := operation.New(operationsCfg)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := operationSvc.Close(); err != nil {
+			logger.Error("failed to close operations store", zap.Error(err))
+		}
+	}()
+
+	// closed before the operations service since the scheduled runs are
+	// recorded as operations.
+	scheduleSvc, err := schedule.New(schedulesCfg)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := scheduleSvc.Close(); err != nil {
+			logger.Error("failed to close schedules store", zap.Error(err))
+		}
+	}()
+
+	// closed before the operations service since the scaling decisions are
+	// recorded as operations.
+	autoscaleSvc, err := autoscale.New(autoscaleCfg)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := autoscaleSvc.Close(); err != nil {
+			logger.Error("failed to close autoscale store", zap.Error(err))
+		}
+	}()
 
 	router := chi.NewRouter()
 	curRoute := currentRouteGetter(router)
@@ -47,10 +137,21 @@ func Serve(ctx context.Context, addr string,
 	})
 
 	router.Route("/api", func(r chi.Router) {
+		r.Use(
+			authenticator.Middleware(),
+			// audit is before authz so that the denied requests are recorded.
+			audit.Middleware(auditSvc, append(audit.FirehoseActions, audit.TemplateActions...)),
+			authorizer.Middleware(projectSlugGetter(router)),
+		)
+
 		r.Get("/alertTemplates", alertSvc.HandleListTemplates())
+		r.Get("/sinkTypes/{sinkType}/schema", sinkv1.HandleGetSchema())
 
 		r.Route("/projects", projectsv1.Routes(shieldClient))
-		r.Route("/projects/{projectSlug}/firehoses", firehosev1.Routes(entropyClient, shieldClient, alertSvc))
+		r.Get("/projects/{projectSlug}/audit", audit.HandleList(auditSvc))
+		r.Get("/operations/{operationID}", operation.HandleGet(operationSvc, authorizer))
+		r.Route("/projects/{projectSlug}/firehoses", firehosev1.Routes(entropyClient, shieldClient, alertSvc, templateStore, secretResolver, masker, authorizer, kafkaClient, operationSvc, scheduleSvc, autoscaleSvc))
+		r.Route("/projects/{projectSlug}/firehoseTemplates", templatev1.Routes(shieldClient, templateStore, masker))
 		r.Route("/projects/{projectSlug}/kubernetes", kubernetesv1.Routes(shieldClient, entropyClient))
 	})
 
@@ -0,0 +1,79 @@
+// Package sqlstore opens the SQLite or Postgres databases backing the stores
+// of the server (i.e., operations, schedules and autoscale policies). The
+// stores keep only their table-specific queries.
+package sqlstore
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	// database drivers for the stores.
+	_ "github.com/lib/pq"
+	_ "modernc.org/sqlite"
+)
+
+// Supported store drivers.
+const (
+	DriverMemory   = "memory"
+	DriverSQLite   = "sqlite"
+	DriverPostgres = "postgres"
+)
+
+// RowScanner is a row of a query result (i.e., *sql.Row or *sql.Rows).
+type RowScanner interface {
+	Scan(dest ...any) error
+}
+
+// IsMemory returns true if the driver keeps the data in memory. The memory
+// driver is the default.
+func IsMemory(driver string) bool {
+	driver = normalize(driver)
+	return driver == DriverMemory || driver == ""
+}
+
+// CheckReplicas returns error if the driver cannot be shared by the given
+// number of replicas of the server.
+func CheckReplicas(driver string, replicas int) error {
+	if IsMemory(driver) && replicas > 1 {
+		return fmt.Errorf("driver must be '%s' or '%s' when running %d replicas, not '%s'",
+			DriverSQLite, DriverPostgres, replicas, DriverMemory)
+	}
+	return nil
+}
+
+// Open opens the database of the driver and creates the tables using the
+// schema if they do not exist.
+func Open(driver, dsn, schema string) (*sql.DB, error) {
+	driver = normalize(driver)
+	switch driver {
+	case DriverSQLite, DriverPostgres:
+
+	default:
+		return nil, fmt.Errorf("driver must be one of '%s', '%s' or '%s', not '%s'",
+			DriverMemory, DriverSQLite, DriverPostgres, driver)
+	}
+
+	if strings.TrimSpace(dsn) == "" {
+		return nil, fmt.Errorf("dsn must be set for '%s' driver", driver)
+	}
+
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, err
+	}
+	if driver == DriverSQLite {
+		// sqlite does not support concurrent writers.
+		db.SetMaxOpenConns(1)
+	}
+
+	if _, err := db.Exec(schema); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to create tables: %w", err)
+	}
+	return db, nil
+}
+
+func normalize(driver string) string {
+	return strings.ToLower(strings.TrimSpace(driver))
+}
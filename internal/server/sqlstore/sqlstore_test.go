@@ -0,0 +1,45 @@
+package sqlstore
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpen(t *testing.T) {
+	t.Parallel()
+
+	db, err := Open(" SQLite ", filepath.Join(t.TempDir(), "dex.db"), `CREATE TABLE IF NOT EXISTS items (id TEXT PRIMARY KEY)`)
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.Exec(`INSERT INTO items (id) VALUES ($1)`, "a")
+	require.NoError(t, err)
+
+	var id string
+	require.NoError(t, db.QueryRow(`SELECT id FROM items`).Scan(&id))
+	assert.Equal(t, "a", id)
+
+	_, err = Open(DriverSQLite, "", "")
+	assert.Error(t, err, "dsn must be required")
+
+	_, err = Open("mysql", "dex", "")
+	assert.Error(t, err)
+
+	_, err = Open(DriverSQLite, filepath.Join(t.TempDir(), "dex.db"), `CREATE TABLE (`)
+	assert.Error(t, err)
+}
+
+func TestCheckReplicas(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, IsMemory(""))
+	assert.True(t, IsMemory("Memory"))
+	assert.False(t, IsMemory(DriverPostgres))
+
+	assert.NoError(t, CheckReplicas(DriverMemory, 1))
+	assert.Error(t, CheckReplicas("", 2))
+	assert.NoError(t, CheckReplicas(DriverPostgres, 2))
+}
@@ -0,0 +1,189 @@
+package utils
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/odpf/dex/pkg/errors"
+)
+
+// Sort fields supported by list APIs. Prefixing the field with '-' in the
+// sort query param reverses the order.
+const (
+	SortByName      = "name"
+	SortByCreatedAt = "created_at"
+	SortByUpdatedAt = "updated_at"
+)
+
+const (
+	maxPageSize = 1000
+
+	// sortableTimeFormat is a fixed-width representation of time that can
+	// be compared lexicographically.
+	sortableTimeFormat = "2006-01-02T15:04:05.000000000Z"
+)
+
+var errInvalidPageToken = errors.ErrInvalid.WithMsgf("page_token is not valid")
+
+// PageRequest represents the pagination & sorting options of a list request.
+// PageSize of 0 disables pagination and all items are returned.
+type PageRequest struct {
+	PageSize   int
+	PageToken  string
+	SortBy     string
+	Descending bool
+}
+
+// PageKey contains the values of an item that can be used for sorting
+// and as cursor for pagination. ID must be unique across items.
+type PageKey struct {
+	ID        string
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type pageCursor struct {
+	SortBy     string `json:"s"`
+	Descending bool   `json:"d"`
+	Value      string `json:"v"`
+	ID         string `json:"id"`
+}
+
+// ReadPageRequest reads the 'page_size', 'page_token' & 'sort' query params
+// from the request.
+func ReadPageRequest(r *http.Request) (PageRequest, error) {
+	query := r.URL.Query()
+
+	pr := PageRequest{
+		SortBy:    SortByName,
+		PageToken: strings.TrimSpace(query.Get("page_token")),
+	}
+
+	if s := strings.TrimSpace(query.Get("page_size")); s != "" {
+		size, err := strconv.Atoi(s)
+		if err != nil || size < 0 {
+			return pr, errors.ErrInvalid.WithMsgf("page_size must be a non-negative integer")
+		} else if size > maxPageSize {
+			size = maxPageSize
+		}
+		pr.PageSize = size
+	}
+
+	if s := strings.TrimSpace(query.Get("sort")); s != "" {
+		pr.Descending = strings.HasPrefix(s, "-")
+		pr.SortBy = strings.TrimPrefix(s, "-")
+
+		switch pr.SortBy {
+		case SortByName, SortByCreatedAt, SortByUpdatedAt:
+		default:
+			return pr, errors.ErrInvalid.
+				WithMsgf("sort must be one of '%s', '%s', '%s' (prefix '-' for descending)",
+					SortByName, SortByCreatedAt, SortByUpdatedAt)
+		}
+	}
+
+	return pr, nil
+}
+
+// Paginate sorts the items as per the page request and returns the page
+// of items after the cursor in the page token.
+func Paginate[T any](items []T, pr PageRequest, keyFn func(T) PageKey) (ListResponse[T], error) {
+	sorted := make([]T, len(items))
+	copy(sorted, items)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return pr.compare(keyFn(sorted[i]), keyFn(sorted[j])) < 0
+	})
+
+	keys := make([]PageKey, len(sorted))
+	for i, item := range sorted {
+		keys[i] = keyFn(item)
+	}
+
+	start := 0
+	if pr.PageToken != "" {
+		cursor, err := decodePageCursor(pr.PageToken)
+		if err != nil {
+			return ListResponse[T]{}, err
+		} else if cursor.SortBy != pr.SortBy || cursor.Descending != pr.Descending {
+			return ListResponse[T]{}, errInvalidPageToken.WithCausef("sort order changed between pages")
+		}
+
+		// first item that comes after the cursor.
+		start = sort.Search(len(keys), func(i int) bool {
+			return pr.compareValues(cursor.Value, cursor.ID, pr.sortValue(keys[i]), keys[i].ID) < 0
+		})
+	}
+
+	resp := ListResponse[T]{
+		Items:      sorted[start:],
+		TotalCount: len(sorted),
+	}
+
+	if pr.PageSize > 0 && len(resp.Items) > pr.PageSize {
+		resp.Items = resp.Items[:pr.PageSize]
+
+		last := keys[start+pr.PageSize-1]
+		resp.NextPageToken = encodePageCursor(pageCursor{
+			SortBy:     pr.SortBy,
+			Descending: pr.Descending,
+			Value:      pr.sortValue(last),
+			ID:         last.ID,
+		})
+	}
+
+	return resp, nil
+}
+
+func (pr PageRequest) sortValue(k PageKey) string {
+	switch pr.SortBy {
+	case SortByCreatedAt:
+		return k.CreatedAt.UTC().Format(sortableTimeFormat)
+
+	case SortByUpdatedAt:
+		return k.UpdatedAt.UTC().Format(sortableTimeFormat)
+
+	default:
+		return k.Name
+	}
+}
+
+func (pr PageRequest) compare(a, b PageKey) int {
+	return pr.compareValues(pr.sortValue(a), a.ID, pr.sortValue(b), b.ID)
+}
+
+func (pr PageRequest) compareValues(aVal, aID, bVal, bID string) int {
+	c := strings.Compare(aVal, bVal)
+	if pr.Descending {
+		c = -c
+	}
+	if c == 0 {
+		// ID is used as tie-breaker to ensure stable order.
+		c = strings.Compare(aID, bID)
+	}
+	return c
+}
+
+func encodePageCursor(c pageCursor) string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodePageCursor(token string) (*pageCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errInvalidPageToken.WithCausef(err.Error())
+	}
+
+	var c pageCursor
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, errInvalidPageToken.WithCausef(err.Error())
+	}
+	return &c, nil
+}
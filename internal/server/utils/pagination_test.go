@@ -0,0 +1,150 @@
+package utils_test
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/odpf/dex/internal/server/utils"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+type sampleItem struct {
+	ID        string
+	Name      string
+	CreatedAt time.Time
+}
+
+func samplePageKey(item sampleItem) utils.PageKey {
+	return utils.PageKey{
+		ID:        item.ID,
+		Name:      item.Name,
+		CreatedAt: item.CreatedAt,
+		UpdatedAt: item.CreatedAt,
+	}
+}
+
+func TestReadPageRequest(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Defaults", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/items", nil)
+
+		got, err := utils.ReadPageRequest(req)
+		require.NoError(t, err)
+		assert.Equal(t, utils.PageRequest{SortBy: utils.SortByName}, got)
+	})
+
+	t.Run("Descending", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/items?page_size=10&sort=-created_at&page_token=abc", nil)
+
+		got, err := utils.ReadPageRequest(req)
+		require.NoError(t, err)
+		assert.Equal(t, utils.PageRequest{
+			PageSize:   10,
+			PageToken:  "abc",
+			SortBy:     utils.SortByCreatedAt,
+			Descending: true,
+		}, got)
+	})
+
+	t.Run("InvalidSort", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/items?sort=foo", nil)
+
+		_, err := utils.ReadPageRequest(req)
+		assert.ErrorIs(t, err, errors.ErrInvalid)
+	})
+
+	t.Run("InvalidPageSize", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/items?page_size=-1", nil)
+
+		_, err := utils.ReadPageRequest(req)
+		assert.ErrorIs(t, err, errors.ErrInvalid)
+	})
+}
+
+func TestPaginate(t *testing.T) {
+	t.Parallel()
+
+	baseTime := time.Date(2022, 10, 10, 10, 10, 10, 0, time.UTC)
+	items := []sampleItem{
+		{ID: "c", Name: "charlie", CreatedAt: baseTime.Add(1 * time.Hour)},
+		{ID: "a", Name: "alpha", CreatedAt: baseTime.Add(3 * time.Hour)},
+		{ID: "d", Name: "bravo", CreatedAt: baseTime.Add(2 * time.Hour)},
+		{ID: "b", Name: "bravo", CreatedAt: baseTime.Add(500 * time.Millisecond)},
+	}
+
+	collectIDs := func(t *testing.T, pr utils.PageRequest) ([]string, int) {
+		t.Helper()
+
+		var ids []string
+		pages := 0
+		for {
+			page, err := utils.Paginate(items, pr, samplePageKey)
+			require.NoError(t, err)
+			assert.Equal(t, len(items), page.TotalCount)
+
+			pages++
+			for _, item := range page.Items {
+				ids = append(ids, item.ID)
+			}
+
+			if page.NextPageToken == "" {
+				return ids, pages
+			}
+			pr.PageToken = page.NextPageToken
+		}
+	}
+
+	t.Run("NoPagination", func(t *testing.T) {
+		ids, pages := collectIDs(t, utils.PageRequest{SortBy: utils.SortByName})
+		assert.Equal(t, []string{"a", "b", "d", "c"}, ids)
+		assert.Equal(t, 1, pages)
+	})
+
+	t.Run("ByNameInPages", func(t *testing.T) {
+		ids, pages := collectIDs(t, utils.PageRequest{SortBy: utils.SortByName, PageSize: 1})
+		assert.Equal(t, []string{"a", "b", "d", "c"}, ids)
+		assert.Equal(t, 4, pages)
+	})
+
+	t.Run("ByCreatedAtDescending", func(t *testing.T) {
+		ids, pages := collectIDs(t, utils.PageRequest{
+			SortBy:     utils.SortByCreatedAt,
+			Descending: true,
+			PageSize:   3,
+		})
+		assert.Equal(t, []string{"a", "d", "c", "b"}, ids)
+		assert.Equal(t, 2, pages)
+	})
+
+	t.Run("SortChangedBetweenPages", func(t *testing.T) {
+		page, err := utils.Paginate(items, utils.PageRequest{SortBy: utils.SortByName, PageSize: 2}, samplePageKey)
+		require.NoError(t, err)
+
+		_, err = utils.Paginate(items, utils.PageRequest{
+			SortBy:    utils.SortByCreatedAt,
+			PageSize:  2,
+			PageToken: page.NextPageToken,
+		}, samplePageKey)
+		assert.ErrorIs(t, err, errors.ErrInvalid)
+	})
+
+	t.Run("EmptyResult", func(t *testing.T) {
+		page, err := utils.Paginate(nil, utils.PageRequest{SortBy: utils.SortByName}, samplePageKey)
+		require.NoError(t, err)
+
+		b, err := json.Marshal(page)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"items": [], "total_count": 0}`, string(b))
+	})
+
+	t.Run("MalformedToken", func(t *testing.T) {
+		_, err := utils.Paginate(items, utils.PageRequest{SortBy: utils.SortByName, PageToken: "!!"}, samplePageKey)
+		assert.ErrorIs(t, err, errors.ErrInvalid)
+	})
+}
@@ -9,9 +9,20 @@ import (
 )
 
 // ListResponse can be used to write list of items to response.
-// This format is helpful in enabling pagination.
+// This format is helpful in enabling pagination. Use Paginate() to
+// populate the pagination fields.
 type ListResponse[T any] struct {
-	Items []T `json:"items"`
+	Items         []T    `json:"items"`
+	NextPageToken string `json:"next_page_token,omitempty"`
+	TotalCount    int    `json:"total_count"`
+}
+
+// NewListResponse returns a response with all the items in a single page.
+func NewListResponse[T any](items []T) ListResponse[T] {
+	if items == nil {
+		items = []T{}
+	}
+	return ListResponse[T]{Items: items, TotalCount: len(items)}
 }
 
 func ReadJSON(r *http.Request, into any) error {
@@ -35,9 +35,7 @@ func (svc *Service) HandleListTemplates() http.HandlerFunc {
 		}
 
 		utils.WriteJSON(w, http.StatusOK,
-			utils.ListResponse[Template]{
-				Items: RemoveSuppliedVariablesFromTemplates(templates, SuppliedVariables),
-			},
+			utils.NewListResponse(RemoveSuppliedVariablesFromTemplates(templates, SuppliedVariables)),
 		)
 	}
 }
@@ -22,6 +22,15 @@ const (
 	actionStart       = "start"
 	actionUpgrade     = "upgrade"
 	actionResetOffset = "reset"
+
+	// operations other than the entropy actions.
+	actionCreate   = "create"
+	actionUpdate   = "update"
+	actionDelete   = "delete"
+	actionClone    = "clone"
+	actionMigrate  = "migrate"
+	actionRollback = "rollback"
+	actionSchedule = "schedule"
 )
 
 func (api *firehoseAPI) handleReset(w http.ResponseWriter, r *http.Request) {
@@ -36,11 +45,13 @@ func (api *firehoseAPI) handleReset(w http.ResponseWriter, r *http.Request) {
 	}
 
 	urn := chi.URLParam(r, pathParamURN)
-	updatedFirehose, err := api.executeAction(r.Context(), urn, actionResetOffset, reqBody)
+	updatedFirehose, err := api.executeAction(r.Context(), chi.URLParam(r, pathParamProject), urn, actionResetOffset, reqBody, "")
 	if err != nil {
 		utils.WriteErr(w, err)
 		return
 	}
+	api.startOperation(w, r, chi.URLParam(r, pathParamProject), actionResetOffset, urn, reqBody, api.pollFirehose(urn))
+	api.maskFirehoses(r, updatedFirehose)
 	utils.WriteJSON(w, http.StatusOK, updatedFirehose)
 }
 
@@ -55,11 +66,13 @@ func (api *firehoseAPI) handleScale(w http.ResponseWriter, r *http.Request) {
 	}
 
 	urn := chi.URLParam(r, pathParamURN)
-	updatedFirehose, err := api.executeAction(r.Context(), urn, actionScale, reqBody)
+	updatedFirehose, err := api.executeAction(r.Context(), chi.URLParam(r, pathParamProject), urn, actionScale, reqBody, "")
 	if err != nil {
 		utils.WriteErr(w, err)
 		return
 	}
+	api.startOperation(w, r, chi.URLParam(r, pathParamProject), actionScale, urn, reqBody, api.pollFirehose(urn))
+	api.maskFirehoses(r, updatedFirehose)
 	utils.WriteJSON(w, http.StatusOK, updatedFirehose)
 }
 
@@ -71,11 +84,13 @@ func (api *firehoseAPI) handleStart(w http.ResponseWriter, r *http.Request) {
 	}
 
 	urn := chi.URLParam(r, pathParamURN)
-	updatedFirehose, err := api.executeAction(r.Context(), urn, actionStart, reqBody)
+	updatedFirehose, err := api.executeAction(r.Context(), chi.URLParam(r, pathParamProject), urn, actionStart, reqBody, "")
 	if err != nil {
 		utils.WriteErr(w, err)
 		return
 	}
+	api.startOperation(w, r, chi.URLParam(r, pathParamProject), actionStart, urn, reqBody, api.pollFirehose(urn))
+	api.maskFirehoses(r, updatedFirehose)
 	utils.WriteJSON(w, http.StatusOK, updatedFirehose)
 }
 
@@ -87,7 +102,7 @@ func (api *firehoseAPI) handleStop(w http.ResponseWriter, r *http.Request) {
 	}
 
 	urn := chi.URLParam(r, pathParamURN)
-	updatedFirehose, err := api.executeAction(r.Context(), urn, actionStop, reqBody)
+	updatedFirehose, err := api.executeAction(r.Context(), chi.URLParam(r, pathParamProject), urn, actionStop, reqBody, "")
 	if err != nil {
 		utils.WriteErr(w, err)
 		return
@@ -104,6 +119,9 @@ func (api *firehoseAPI) handleStop(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	api.startOperation(w, r, prj.GetSlug(), actionStop, urn, reqBody, api.pollFirehose(urn))
+
+	api.maskFirehoses(r, updatedFirehose)
 	utils.WriteJSON(w, http.StatusOK, updatedFirehose)
 }
 
@@ -115,15 +133,19 @@ func (api *firehoseAPI) handleUpgrade(w http.ResponseWriter, r *http.Request) {
 	}
 
 	urn := chi.URLParam(r, pathParamURN)
-	updatedFirehose, err := api.executeAction(r.Context(), urn, actionUpgrade, reqBody)
+	updatedFirehose, err := api.executeAction(r.Context(), chi.URLParam(r, pathParamProject), urn, actionUpgrade, reqBody, "")
 	if err != nil {
 		utils.WriteErr(w, err)
 		return
 	}
+	api.startOperation(w, r, chi.URLParam(r, pathParamProject), actionUpgrade, urn, reqBody, api.pollFirehose(urn))
+	api.maskFirehoses(r, updatedFirehose)
 	utils.WriteJSON(w, http.StatusOK, updatedFirehose)
 }
 
-func (api *firehoseAPI) executeAction(ctx context.Context, urn, actionType string, params any) (*models.Firehose, error) {
+// executeAction applies the entropy action on the firehose. reason, if set,
+// is recorded with the new revision.
+func (api *firehoseAPI) executeAction(ctx context.Context, prjSlug, urn, actionType string, params any, reason string) (*models.Firehose, error) {
 	reqCtx := reqctx.From(ctx)
 
 	paramStruct, err := utils.GoValToProtoStruct(params)
@@ -132,7 +154,7 @@ func (api *firehoseAPI) executeAction(ctx context.Context, urn, actionType strin
 	}
 
 	// Ensure that the URN refers to a valid firehose resource.
-	existingFirehose, err := api.getFirehose(ctx, urn)
+	existingFirehose, err := api.getFirehose(ctx, prjSlug, urn)
 	if err != nil {
 		return nil, err
 	}
@@ -140,6 +162,9 @@ func (api *firehoseAPI) executeAction(ctx context.Context, urn, actionType strin
 	labels := makeLabelsMap(*existingFirehose)
 	labels["updated_by"] = reqCtx.UserID
 	labels["updated_by_email"] = reqCtx.UserEmail
+	if reason != "" {
+		labels[labelUpdateReason] = reason
+	}
 
 	rpcReq := &entropyv1beta1.ApplyActionRequest{
 		Urn:    urn,
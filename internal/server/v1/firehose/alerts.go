@@ -26,7 +26,7 @@ func (api *firehoseAPI) handleListAlerts(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	firehoseDef, err := api.getFirehose(r.Context(), urn)
+	firehoseDef, err := api.getFirehose(r.Context(), chi.URLParam(r, pathParamProject), urn)
 	if err != nil {
 		utils.WriteErr(w, err)
 		return
@@ -44,8 +44,7 @@ func (api *firehoseAPI) handleListAlerts(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	utils.WriteJSON(w, http.StatusOK,
-		utils.ListResponse[alertsv1.Alert]{Items: alerts})
+	utils.WriteJSON(w, http.StatusOK, utils.NewListResponse(alerts))
 }
 
 func (api *firehoseAPI) handleGetAlertPolicy(w http.ResponseWriter, r *http.Request) {
@@ -57,7 +56,7 @@ func (api *firehoseAPI) handleGetAlertPolicy(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	firehoseDef, err := api.getFirehose(r.Context(), urn)
+	firehoseDef, err := api.getFirehose(r.Context(), chi.URLParam(r, pathParamProject), urn)
 	if err != nil {
 		utils.WriteErr(w, err)
 		return
@@ -87,7 +86,7 @@ func (api *firehoseAPI) handleUpsertAlertPolicy(w http.ResponseWriter, r *http.R
 	}
 
 	urn := chi.URLParam(r, pathParamURN)
-	firehoseDef, err := api.getFirehose(r.Context(), urn)
+	firehoseDef, err := api.getFirehose(r.Context(), chi.URLParam(r, pathParamProject), urn)
 	if err != nil {
 		utils.WriteErr(w, err)
 		return
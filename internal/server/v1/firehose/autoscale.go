@@ -0,0 +1,175 @@
+package firehose
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/odpf/dex/internal/server/autoscale"
+	"github.com/odpf/dex/internal/server/reqctx"
+	"github.com/odpf/dex/internal/server/utils"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+const requestIDAutoscale = "autoscale"
+
+var errNoAutoscalePolicy = errors.ErrNotFound.WithMsgf("no autoscale policy for given firehose")
+
+type autoscalePolicyRequest struct {
+	MinReplicas     int   `json:"min_replicas"`
+	MaxReplicas     int   `json:"max_replicas"`
+	TargetLag       int64 `json:"target_lag"`
+	CooldownSeconds int64 `json:"cooldown_seconds"`
+	Enabled         *bool `json:"enabled"`
+}
+
+func (api *firehoseAPI) handleGetAutoscalePolicy(w http.ResponseWriter, r *http.Request) {
+	policy, err := api.getAutoscalePolicy(r)
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, policy)
+}
+
+func (api *firehoseAPI) handlePutAutoscalePolicy(w http.ResponseWriter, r *http.Request) {
+	var req autoscalePolicyRequest
+	if err := utils.ReadJSON(r, &req); err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	urn := chi.URLParam(r, pathParamURN)
+	prjSlug := chi.URLParam(r, pathParamProject)
+
+	// Ensure that the URN refers to a valid firehose resource.
+	if _, err := api.getFirehose(r.Context(), chi.URLParam(r, pathParamProject), urn); err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	existing, err := api.Autoscaler.Get(r.Context(), urn)
+	if err != nil && !errors.Is(err, errors.ErrNotFound) {
+		utils.WriteErr(w, err)
+		return
+	} else if existing != nil && existing.Project != prjSlug {
+		utils.WriteErr(w, errors.ErrConflict.WithMsgf("firehose has an autoscale policy in another project"))
+		return
+	}
+
+	reqCtx := reqctx.From(r.Context())
+	policy, err := api.Autoscaler.Put(r.Context(), autoscale.Policy{
+		Project:         prjSlug,
+		URN:             urn,
+		MinReplicas:     req.MinReplicas,
+		MaxReplicas:     req.MaxReplicas,
+		TargetLag:       req.TargetLag,
+		CooldownSeconds: req.CooldownSeconds,
+		Enabled:         req.Enabled == nil || *req.Enabled,
+		UpdatedBy:       reqCtx.UserID,
+		UpdatedByEmail:  reqCtx.UserEmail,
+	})
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, policy)
+}
+
+func (api *firehoseAPI) handleDeleteAutoscalePolicy(w http.ResponseWriter, r *http.Request) {
+	policy, err := api.getAutoscalePolicy(r)
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	if err := api.Autoscaler.Delete(r.Context(), policy.URN); err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+	utils.WriteJSON(w, http.StatusNoContent, nil)
+}
+
+// getAutoscalePolicy returns the policy of the firehose in the request path.
+// Policies of other projects are reported as not found.
+func (api *firehoseAPI) getAutoscalePolicy(r *http.Request) (*autoscale.Policy, error) {
+	policy, err := api.Autoscaler.Get(r.Context(), chi.URLParam(r, pathParamURN))
+	if err != nil {
+		if errors.Is(err, errors.ErrNotFound) {
+			return nil, errNoAutoscalePolicy
+		}
+		return nil, err
+	} else if policy.Project != chi.URLParam(r, pathParamProject) {
+		return nil, errNoAutoscalePolicy
+	}
+	return policy, nil
+}
+
+// deleteAutoscalePolicy removes the policy of a deleted firehose.
+func (api *firehoseAPI) deleteAutoscalePolicy(ctx context.Context, urn string) {
+	if api.Autoscaler == nil {
+		return
+	}
+
+	if err := api.Autoscaler.Delete(ctx, urn); err != nil && !errors.Is(err, errors.ErrNotFound) {
+		log.Printf("error: failed to delete autoscale policy of '%s': %v", urn, err)
+	}
+}
+
+// describeForAutoscale returns the state of the firehose of the policy used
+// in evaluating it.
+func (api *firehoseAPI) describeForAutoscale(ctx context.Context, p autoscale.Policy) (*autoscale.Target, error) {
+	firehose, err := api.getFirehose(ctx, p.Project, p.URN)
+	if err != nil {
+		return nil, err
+	}
+
+	target := &autoscale.Target{
+		URN:     p.URN,
+		Running: firehose.State != nil && firehose.State.State != moduleStateStopped,
+	}
+	if cfg := firehose.Configs; cfg != nil {
+		if cfg.ConsumerGroupID != nil {
+			target.ConsumerGroup = *cfg.ConsumerGroupID
+		}
+		if cfg.TopicName != nil {
+			target.Topic = *cfg.TopicName
+		}
+		if cfg.Replicas != nil {
+			target.Replicas = int(*cfg.Replicas)
+		}
+	}
+	return target, nil
+}
+
+// autoscaleFirehose scales the firehose of the policy on behalf of the user
+// who last updated the policy. The scaling is recorded in the history of the
+// firehose with the reason of the decision, and as an operation. The policy
+// is disabled if the user no longer has the manage permission on the project.
+func (api *firehoseAPI) autoscaleFirehose(ctx context.Context, p autoscale.Policy, replicas int, reason string) (string, error) {
+	ctx = reqctx.With(ctx, reqctx.ReqCtx{
+		UserID:    p.UpdatedBy,
+		UserEmail: p.UpdatedByEmail,
+		RequestID: requestIDAutoscale,
+	})
+
+	if err := api.Authz.CheckManageFor(ctx, p.Project); err != nil {
+		if errors.OneOf(err, errors.ErrForbidden, errors.ErrUnauthorized) {
+			p.Enabled = false
+			if _, putErr := api.Autoscaler.Put(ctx, p); putErr != nil {
+				log.Printf("error: failed to disable autoscale policy of '%s': %v", p.URN, putErr)
+			}
+			return "", fmt.Errorf("%w (autoscale policy disabled)", err)
+		}
+		return "", err
+	}
+
+	params := scaleParams{Replicas: replicas}
+	if _, err := api.executeAction(ctx, p.Project, p.URN, actionScale, params, "autoscale: "+reason); err != nil {
+		return "", err
+	}
+	return api.recordOperation(ctx, p.Project, actionScale, p.URN, params, api.pollFirehose(p.URN)), nil
+}
@@ -0,0 +1,73 @@
+package firehose
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	entropyv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/entropy/v1beta1"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/odpf/dex/internal/server/authz"
+	"github.com/odpf/dex/internal/server/autoscale"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+func TestAutoscaleFirehose(t *testing.T) {
+	t.Parallel()
+
+	const urn = "orn:entropy:firehose:foo:bar"
+
+	configs, err := structpb.NewValue(map[string]any{
+		"state": moduleStateRunning,
+		"firehose": map[string]any{
+			"replicas":      1,
+			"env_variables": map[string]any{"SINK_TYPE": "LOG"},
+		},
+	})
+	require.NoError(t, err)
+
+	entropy := &fakeEntropy{resources: map[string]*entropyv1beta1.Resource{
+		urn: {
+			Urn:     urn,
+			Kind:    kindFirehose,
+			Name:    "bar",
+			Project: "foo",
+			State:   &entropyv1beta1.ResourceState{Status: entropyv1beta1.ResourceState_STATUS_COMPLETED},
+			Spec:    &entropyv1beta1.ResourceSpec{Configs: configs},
+		},
+	}}
+
+	shield := permissionShield{managers: map[string]bool{"john@example.com": true}}
+	autoscaler := autoscale.NewWithStore(autoscale.NewMemoryStore(), nil, time.Hour)
+	defer autoscaler.Close()
+
+	api := &firehoseAPI{
+		Entropy:    entropy,
+		Shield:     shield,
+		Authz:      authz.New(authz.Config{Enabled: true, ManagePermission: "manage", IdentityHeader: "X-Shield-Email"}, shield),
+		Autoscaler: autoscaler,
+	}
+
+	policy, err := autoscaler.Put(context.Background(), autoscale.Policy{
+		Project: "foo", URN: urn, MinReplicas: 1, MaxReplicas: 4, TargetLag: 1000, Enabled: true,
+		UpdatedByEmail: "john@example.com",
+	})
+	require.NoError(t, err)
+
+	_, err = api.autoscaleFirehose(context.Background(), *policy, 3, "lag 3000 is above target 1000")
+	require.NoError(t, err)
+	assert.Equal(t, "autoscale: lag 3000 is above target 1000", entropy.resources[urn].GetLabels()[labelUpdateReason])
+
+	// the user who updated the policy lost the manage permission since.
+	policy.UpdatedByEmail = "jane@example.com"
+	_, err = api.autoscaleFirehose(context.Background(), *policy, 4, "lag 4000 is above target 1000")
+	assert.ErrorIs(t, err, errors.ErrForbidden)
+	assert.Equal(t, "autoscale: lag 3000 is above target 1000", entropy.resources[urn].GetLabels()[labelUpdateReason])
+
+	policy, err = autoscaler.Get(context.Background(), urn)
+	require.NoError(t, err)
+	assert.False(t, policy.Enabled)
+}
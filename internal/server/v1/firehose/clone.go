@@ -0,0 +1,150 @@
+package firehose
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-openapi/strfmt"
+	shieldv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/shield/v1beta1"
+	"golang.org/x/exp/maps"
+
+	"github.com/odpf/dex/generated/models"
+	"github.com/odpf/dex/internal/server/reqctx"
+	"github.com/odpf/dex/internal/server/utils"
+	"github.com/odpf/dex/internal/server/v1/project"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+type cloneRequest struct {
+	Project         string            `json:"project"`
+	Name            string            `json:"name"`
+	Title           string            `json:"title"`
+	KubeCluster     string            `json:"kube_cluster"`
+	ConsumerGroupID string            `json:"consumer_group_id"`
+	EnvVars         map[string]string `json:"env_vars"`
+	Stopped         bool              `json:"stopped"`
+}
+
+func (api *firehoseAPI) handleClone(w http.ResponseWriter, r *http.Request) {
+	urn := chi.URLParam(r, pathParamURN)
+
+	var req cloneRequest
+	if err := utils.ReadJSON(r, &req); err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	srcPrj, err := api.getProject(r)
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	source, err := api.getFirehose(r.Context(), chi.URLParam(r, pathParamProject), urn)
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	targetPrj, err := api.cloneTarget(r, srcPrj, strings.TrimSpace(req.Project))
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	clone := makeClone(*source, req, targetPrj, srcPrj.GetSlug() != targetPrj.GetSlug())
+
+	reqCtx := reqctx.From(r.Context())
+	clone.Metadata = &models.FirehoseMetadata{
+		CreatedBy:      strfmt.UUID(reqCtx.UserID),
+		CreatedByEmail: strfmt.Email(reqCtx.UserEmail),
+		UpdatedBy:      strfmt.UUID(reqCtx.UserID),
+		UpdatedByEmail: strfmt.Email(reqCtx.UserEmail),
+	}
+
+	if err := sanitiseAndValidate(&clone); err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	created, err := api.createFirehose(r, clone, targetPrj, req.Stopped)
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	params := req
+	params.EnvVars = maps.Clone(req.EnvVars)
+	api.Masker.EnvVars(params.EnvVars)
+	api.startOperation(w, r, targetPrj.GetSlug(), actionClone, created.Urn, params, api.pollFirehose(created.Urn))
+	api.writeCreated(w, r, created)
+}
+
+// cloneTarget returns the project to create the clone in. The user must be
+// allowed to manage the target project if it is not the source project.
+func (api *firehoseAPI) cloneTarget(r *http.Request, srcPrj *shieldv1beta1.Project, targetSlug string) (*shieldv1beta1.Project, error) {
+	if targetSlug == "" || targetSlug == srcPrj.GetSlug() {
+		return srcPrj, nil
+	}
+
+	if err := api.Authz.CheckManage(r, targetSlug); err != nil {
+		return nil, err
+	}
+
+	prj, err := project.FindProjectBySlug(r.Context(), api.Shield, targetSlug)
+	if err != nil {
+		if errors.Is(err, errors.ErrNotFound) {
+			return nil, errors.ErrNotFound.WithMsgf("no project with slug '%s'", targetSlug)
+		}
+		return nil, err
+	}
+	return prj, nil
+}
+
+// makeClone returns the definition of the clone of the source firehose with
+// the overrides in the request applied.
+func makeClone(source models.Firehose, req cloneRequest, targetPrj *shieldv1beta1.Project, crossProject bool) models.Firehose {
+	clone := models.Firehose{
+		Name:        source.Name,
+		Title:       source.Title,
+		Description: source.Description,
+		KubeCluster: source.KubeCluster,
+		Configs:     source.Configs,
+	}
+	if !crossProject {
+		// groups belong to the project of the source.
+		clone.Group = source.Group
+	}
+
+	if name := strings.TrimSpace(req.Name); name != "" {
+		clone.Name = name
+	}
+	if title := strings.TrimSpace(req.Title); title != "" {
+		clone.Title = title
+	}
+	if kubeCluster := strings.TrimSpace(req.KubeCluster); kubeCluster != "" {
+		clone.KubeCluster = kubeCluster
+	}
+
+	if clone.Configs == nil {
+		clone.Configs = &models.FirehoseConfig{}
+	}
+	clone.Configs.Version = ""
+
+	// the clone must not share the offsets of the source by default.
+	consumerGroup := strings.TrimSpace(req.ConsumerGroupID)
+	if consumerGroup == "" {
+		consumerGroup = targetPrj.GetSlug() + "-" + clone.Name
+	}
+	clone.Configs.ConsumerGroupID = &consumerGroup
+
+	if len(req.EnvVars) > 0 && clone.Configs.EnvVars == nil {
+		clone.Configs.EnvVars = map[string]string{}
+	}
+	for k, v := range req.EnvVars {
+		clone.Configs.EnvVars[k] = v
+	}
+
+	return clone
+}
@@ -0,0 +1,59 @@
+package firehose
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	shieldv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/shield/v1beta1"
+
+	"github.com/odpf/dex/generated/models"
+)
+
+func TestMakeClone(t *testing.T) {
+	t.Parallel()
+
+	sourceGroup := strfmt.UUID("2fa5a6bf-6c4f-4a3c-8e0e-4f2b3a1b9a10")
+	sourceConsumer := "foo-bar-0001"
+	source := models.Firehose{
+		Urn:         "orn:entropy:firehose:foo:bar",
+		Name:        "bar",
+		Title:       "Bar",
+		KubeCluster: "orn:entropy:kubernetes:foo:c1",
+		Group:       sourceGroup,
+		Configs: &models.FirehoseConfig{
+			ConsumerGroupID: &sourceConsumer,
+			Version:         "0.1.0",
+			EnvVars:         map[string]string{"SINK_TYPE": "LOG", "INPUT_SCHEMA_PROTO_CLASS": "a.B"},
+		},
+	}
+
+	t.Run("Defaults", func(t *testing.T) {
+		t.Parallel()
+
+		clone := makeClone(source, cloneRequest{Name: "bar-copy"}, &shieldv1beta1.Project{Slug: "foo"}, false)
+		assert.Empty(t, clone.Urn)
+		assert.Equal(t, "bar-copy", clone.Name)
+		assert.Equal(t, "Bar", clone.Title)
+		assert.Equal(t, source.KubeCluster, clone.KubeCluster)
+		assert.Equal(t, sourceGroup, clone.Group)
+		assert.Equal(t, "foo-bar-copy", *clone.Configs.ConsumerGroupID)
+		assert.Empty(t, clone.Configs.Version)
+	})
+
+	t.Run("CrossProjectWithOverrides", func(t *testing.T) {
+		t.Parallel()
+
+		req := cloneRequest{
+			ConsumerGroupID: "custom",
+			KubeCluster:     "orn:entropy:kubernetes:baz:c2",
+			EnvVars:         map[string]string{"SINK_TYPE": "BIGQUERY"},
+		}
+		clone := makeClone(source, req, &shieldv1beta1.Project{Slug: "baz"}, true)
+		assert.Empty(t, clone.Group)
+		assert.Equal(t, "bar", clone.Name)
+		assert.Equal(t, req.KubeCluster, clone.KubeCluster)
+		assert.Equal(t, "custom", *clone.Configs.ConsumerGroupID)
+		assert.Equal(t, map[string]string{"SINK_TYPE": "BIGQUERY", "INPUT_SCHEMA_PROTO_CLASS": "a.B"}, clone.Configs.EnvVars)
+	})
+}
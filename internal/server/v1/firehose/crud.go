@@ -2,23 +2,33 @@ package firehose
 
 import (
 	"context"
-	"encoding/json"
 	"net/http"
+	"strings"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-openapi/strfmt"
 	entropyv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/entropy/v1beta1"
+	shieldv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/shield/v1beta1"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/odpf/dex/generated/models"
 	"github.com/odpf/dex/internal/server/reqctx"
 	"github.com/odpf/dex/internal/server/utils"
+	templatev1 "github.com/odpf/dex/internal/server/v1/template"
 	"github.com/odpf/dex/pkg/errors"
 )
 
-const kindFirehose = "firehose"
+const (
+	kindFirehose   = "firehose"
+	kindKubernetes = "kubernetes"
+)
+
+// maxExpandWorkers is the maximum number of resources decoded concurrently
+// while expanding list items.
+const maxExpandWorkers = 8
 
 type firehoseUpdates struct {
 	Description string                `json:"description"`
@@ -28,12 +38,13 @@ type firehoseUpdates struct {
 func (api *firehoseAPI) handleGet(w http.ResponseWriter, r *http.Request) {
 	urn := chi.URLParam(r, pathParamURN)
 
-	def, err := api.getFirehose(r.Context(), urn)
+	def, err := api.getFirehose(r.Context(), chi.URLParam(r, pathParamProject), urn)
 	if err != nil {
 		utils.WriteErr(w, err)
 		return
 	}
 
+	api.maskFirehoses(r, def)
 	utils.WriteJSON(w, http.StatusOK, def)
 }
 
@@ -61,42 +72,69 @@ func (api *firehoseAPI) handleCreate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	res, err := mapFirehoseToResource(def, prj)
+	if err := api.renderTemplate(r, &def, prj.GetSlug()); err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	createdFirehose, err := api.createFirehose(r, def, prj, false)
 	if err != nil {
 		utils.WriteErr(w, err)
 		return
 	}
+	api.startOperation(w, r, prj.GetSlug(), actionCreate, createdFirehose.Urn, nil, api.pollFirehose(createdFirehose.Urn))
+	api.writeCreated(w, r, createdFirehose)
+}
+
+// createFirehose creates the firehose in the project. For dry-run requests,
+// the validated definition is returned without creating the firehose.
+func (api *firehoseAPI) createFirehose(r *http.Request, def models.Firehose,
+	prj *shieldv1beta1.Project, stopped bool,
+) (*models.Firehose, error) {
+	res, err := mapFirehoseToResource(r.Context(), def, prj, api.Secrets)
+	if err != nil {
+		return nil, err
+	}
+	if stopped {
+		res.GetSpec().GetConfigs().GetStructValue().Fields["state"] = structpb.NewStringValue(moduleStateStopped)
+	}
+
+	if isDryRun(r) {
+		// definition is valid. return the sanitised version without creating.
+		return &def, nil
+	}
 
 	rpcReq := &entropyv1beta1.CreateResourceRequest{Resource: res}
 	rpcResp, err := api.Entropy.CreateResource(r.Context(), rpcReq)
 	if err != nil {
-		outErr := errors.ErrInternal
-
 		st := status.Convert(err)
 		if st.Code() == codes.AlreadyExists {
-			outErr = errors.ErrConflict.WithCausef(st.Message())
+			return nil, errors.ErrConflict.WithCausef(st.Message())
 		} else if st.Code() == codes.InvalidArgument {
-			outErr = errors.ErrInvalid.WithCausef(st.Message())
+			return nil, errors.ErrInvalid.WithCausef(st.Message())
 		}
-
-		utils.WriteErr(w, outErr)
-		return
+		return nil, errors.ErrInternal.WithCausef(err.Error())
 	}
 
-	createdFirehose, err := mapResourceToFirehose(rpcResp.GetResource(), false)
-	if err != nil {
-		utils.WriteErr(w, err)
+	return mapResourceToFirehose(rpcResp.GetResource(), false)
+}
+
+// writeCreated writes the created firehose (or the validated one for
+// dry-run requests) with the sensitive values masked.
+func (api *firehoseAPI) writeCreated(w http.ResponseWriter, r *http.Request, def *models.Firehose) {
+	api.maskFirehoses(r, def)
+	if isDryRun(r) {
+		utils.WriteJSON(w, http.StatusOK, def)
 		return
 	}
-
-	utils.WriteJSON(w, http.StatusCreated, createdFirehose)
+	utils.WriteJSON(w, http.StatusCreated, def)
 }
 
 func (api *firehoseAPI) handleDelete(w http.ResponseWriter, r *http.Request) {
 	urn := chi.URLParam(r, pathParamURN)
 
 	// Ensure that the URN refers to a valid firehose resource.
-	if _, err := api.getFirehose(r.Context(), urn); err != nil {
+	if _, err := api.getFirehose(r.Context(), chi.URLParam(r, pathParamProject), urn); err != nil {
 		utils.WriteErr(w, err)
 		return
 	}
@@ -113,10 +151,25 @@ func (api *firehoseAPI) handleDelete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	api.deleteSchedules(r.Context(), chi.URLParam(r, pathParamProject), urn)
+	api.deleteAutoscalePolicy(r.Context(), urn)
+	api.startOperation(w, r, chi.URLParam(r, pathParamProject), actionDelete, urn, nil, api.pollDeletion(urn))
 	utils.WriteJSON(w, http.StatusNoContent, nil)
 }
 
 func (api *firehoseAPI) handleList(w http.ResponseWriter, r *http.Request) {
+	pageReq, err := utils.ReadPageRequest(r)
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	expansion, err := parseListExpansion(r.URL.Query())
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
 	prj, err := api.getProject(r)
 	if err != nil {
 		utils.WriteErr(w, err)
@@ -134,23 +187,104 @@ func (api *firehoseAPI) handleList(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	filter := parseFirehoseFilter(r.URL.Query())
+
 	var arr []models.Firehose
+	resources := map[string]*entropyv1beta1.Resource{}
 	for _, res := range rpcResp.GetResources() {
+		if ok, err := filter.match(res); err != nil {
+			utils.WriteErr(w, err)
+			return
+		} else if !ok {
+			continue
+		}
+
 		def, err := mapResourceToFirehose(res, true)
 		if err != nil {
 			utils.WriteErr(w, err)
 			return
 		}
 		arr = append(arr, *def)
+		resources[res.GetUrn()] = res
+	}
+
+	resp, err := utils.Paginate(arr, pageReq, firehosePageKey)
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	// Only the items in the current page are expanded.
+	if err := expandFirehoses(r.Context(), resp.Items, resources, expansion); err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+	for i := range resp.Items {
+		api.maskFirehoses(r, &resp.Items[i])
+	}
+	utils.WriteJSON(w, http.StatusOK, resp)
+}
+
+// expandFirehoses decodes the module config & state of the resources for the
+// given list items as per the expansion requested. Decoding is done concurrently
+// with at-most maxExpandWorkers resources being decoded at a time.
+func expandFirehoses(ctx context.Context, items []models.Firehose,
+	resources map[string]*entropyv1beta1.Resource, expansion listExpansion,
+) error {
+	if !expansion.State && !expansion.Configs {
+		return nil
+	}
+
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.SetLimit(maxExpandWorkers)
+
+	for i := range items {
+		item := &items[i]
+		eg.Go(func() error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			fullDef, err := mapResourceToFirehose(resources[item.Urn], false)
+			if err != nil {
+				return err
+			}
+
+			if expansion.Configs {
+				item.Configs = fullDef.Configs
+			}
+			if expansion.State {
+				item.State = fullDef.State
+			}
+			return nil
+		})
+	}
+
+	return eg.Wait()
+}
+
+// renderTemplate fills the configs of the firehose from the template named
+// in the 'template' query param, if any.
+func (api *firehoseAPI) renderTemplate(r *http.Request, def *models.Firehose, prjSlug string) error {
+	name := strings.TrimSpace(r.URL.Query().Get("template"))
+	if name == "" {
+		return nil
+	}
+
+	values, err := templatev1.ParseVariables(r.URL.Query()["var"])
+	if err != nil {
+		return err
 	}
 
-	utils.WriteJSON(w, http.StatusOK,
-		utils.ListResponse[models.Firehose]{Items: arr})
+	tpl, err := api.Templates.Get(r.Context(), prjSlug, name)
+	if err != nil {
+		return err
+	}
+	return templatev1.Render(*tpl, def, prjSlug, values)
 }
 
 func (api *firehoseAPI) handleUpdate(w http.ResponseWriter, r *http.Request) {
 	urn := chi.URLParam(r, pathParamURN)
-	reqCtx := reqctx.From(r.Context())
 
 	var updates firehoseUpdates
 	if err := utils.ReadJSON(r, &updates); err != nil {
@@ -158,110 +292,90 @@ func (api *firehoseAPI) handleUpdate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	existingFirehose, err := api.getFirehose(r.Context(), urn)
+	prj, err := api.getProject(r)
 	if err != nil {
 		utils.WriteErr(w, err)
 		return
 	}
 
-	labels := makeLabelsMap(*existingFirehose)
-	labels["updated_by"] = reqCtx.UserID
-	labels["updated_by_email"] = reqCtx.UserEmail
-	if updates.Description != "" {
-		labels["description"] = updates.Description
-	}
-
-	prj, err := api.getProject(r)
+	existingFirehose, err := api.getFirehose(r.Context(), chi.URLParam(r, pathParamProject), urn)
 	if err != nil {
 		utils.WriteErr(w, err)
 		return
 	}
+	api.Masker.Restore(updates.Configs.EnvVars, existingFirehose.Configs.EnvVars)
 
-	cfgStruct, err := makeConfigStruct(&updates.Configs, prj)
+	cfgStruct, err := makeConfigStruct(r.Context(), &updates.Configs, existingFirehose.Configs, prj, api.Secrets)
 	if err != nil {
 		utils.WriteErr(w, err)
 		return
 	}
 
-	rpcReq := &entropyv1beta1.UpdateResourceRequest{
-		Urn:    existingFirehose.Urn,
-		Labels: labels,
-		NewSpec: &entropyv1beta1.ResourceSpec{
-			Configs: cfgStruct,
-		},
-	}
-
-	rpcResp, err := api.Entropy.UpdateResource(r.Context(), rpcReq)
-	if err != nil {
-		st := status.Convert(err)
-		if st.Code() == codes.InvalidArgument {
-			utils.WriteErr(w, errors.ErrInvalid.WithCausef(st.Message()))
-		} else if st.Code() == codes.NotFound {
-			utils.WriteErr(w, errFirehoseNotFound.WithCausef(st.Message()))
-		} else {
-			utils.WriteErr(w, err)
+	if isDryRun(r) {
+		// updates are valid. return the sanitised version without updating.
+		// changes to the sensitive values are reported separately since they
+		// are not visible in the masked versions.
+		changed := api.Masker.Changed(updates.Configs.EnvVars, existingFirehose.Configs.EnvVars)
+		if len(changed) > 0 {
+			w.Header().Set(headerMaskedChanges, strings.Join(changed, ","))
 		}
+		dryRunFirehose := models.Firehose{
+			Urn:         urn,
+			Description: updates.Description,
+			Configs:     &updates.Configs,
+		}
+		api.maskFirehoses(r, &dryRunFirehose)
+		utils.WriteJSON(w, http.StatusOK, dryRunFirehose)
 		return
 	}
 
-	updatedFirehose, err := mapResourceToFirehose(rpcResp.GetResource(), false)
+	updatedFirehose, err := api.updateFirehose(r.Context(), *existingFirehose, updates, cfgStruct, "")
 	if err != nil {
 		utils.WriteErr(w, err)
 		return
 	}
+	api.startOperation(w, r, prj.GetSlug(), actionUpdate, urn, nil, api.pollFirehose(urn))
+	api.maskFirehoses(r, updatedFirehose)
 	utils.WriteJSON(w, http.StatusOK, updatedFirehose)
 }
 
-func (api *firehoseAPI) handleGetHistory(w http.ResponseWriter, r *http.Request) {
-	urn := chi.URLParam(r, pathParamURN)
+// updateFirehose applies the description and configs in updates to the
+// existing firehose. cfgStruct is the module config built from the configs.
+// reason, if set, is recorded with the new revision.
+func (api *firehoseAPI) updateFirehose(ctx context.Context, existing models.Firehose,
+	updates firehoseUpdates, cfgStruct *structpb.Value, reason string,
+) (*models.Firehose, error) {
+	reqCtx := reqctx.From(ctx)
 
-	diffs, err := api.getRevisions(r.Context(), urn)
-	if err != nil {
-		utils.WriteErr(w, err)
-		return
+	labels := makeLabelsMap(existing)
+	labels["updated_by"] = reqCtx.UserID
+	labels["updated_by_email"] = reqCtx.UserEmail
+	if updates.Description != "" {
+		labels["description"] = updates.Description
 	}
-	utils.WriteJSON(w, http.StatusOK, diffs)
-}
+	if reason != "" {
+		labels[labelUpdateReason] = reason
+	}
+	setSecretRefsLabel(labels, updates.Configs.EnvVars)
 
-func (api *firehoseAPI) getRevisions(ctx context.Context, urn string) ([]models.RevisionDiff, error) {
-	rpcReq := &entropyv1beta1.GetResourceRevisionsRequest{Urn: urn}
-	rpcResp, err := api.Entropy.GetResourceRevisions(ctx, rpcReq)
+	rpcReq := &entropyv1beta1.UpdateResourceRequest{
+		Urn:    existing.Urn,
+		Labels: labels,
+		NewSpec: &entropyv1beta1.ResourceSpec{
+			Configs: cfgStruct,
+		},
+	}
+
+	rpcResp, err := api.Entropy.UpdateResource(ctx, rpcReq)
 	if err != nil {
 		st := status.Convert(err)
-		if st.Code() == codes.NotFound {
+		if st.Code() == codes.InvalidArgument {
+			return nil, errors.ErrInvalid.WithCausef(st.Message())
+		} else if st.Code() == codes.NotFound {
 			return nil, errFirehoseNotFound.WithCausef(st.Message())
 		}
 		return nil, err
 	}
 
-	prevSpec := []byte("{}")
-	var rh []models.RevisionDiff
-
-	marshaller := protojson.MarshalOptions{
-		UseProtoNames: true,
-	}
-
-	for _, revision := range rpcResp.GetRevisions() {
-		var rd models.RevisionDiff
-
-		currentSpec, err := marshaller.Marshal(revision.GetSpec())
-		if err != nil {
-			return nil, err
-		}
-
-		specDiff, err := jsonDiff(prevSpec, currentSpec)
-		if err != nil {
-			return nil, err
-		}
-
-		rd.Labels = revision.GetLabels()
-		rd.Reason = revision.GetReason()
-		rd.Diff = json.RawMessage(specDiff)
-		rd.UpdatedAt = strfmt.DateTime(revision.GetCreatedAt().AsTime())
-
-		rh = append(rh, rd)
-		prevSpec = currentSpec
-	}
-
-	return rh, nil
+	return mapResourceToFirehose(rpcResp.GetResource(), false)
 }
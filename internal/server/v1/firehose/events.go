@@ -0,0 +1,261 @@
+package firehose
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/odpf/dex/internal/server/utils"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+const (
+	eventTypeState   = "state"
+	eventTypeDeleted = "deleted"
+
+	eventPollInterval = 5 * time.Second
+	eventHeartbeat    = 15 * time.Second
+)
+
+// firehoseEvent is pushed to subscribers whenever the state, status or the
+// output of a firehose changes.
+type firehoseEvent struct {
+	Type      string    `json:"type"`
+	URN       string    `json:"urn"`
+	State     string    `json:"state,omitempty"`
+	Status    string    `json:"status,omitempty"`
+	Output    any       `json:"output,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+func (ev firehoseEvent) sameAs(other firehoseEvent) bool {
+	return ev.Type == other.Type &&
+		ev.State == other.State &&
+		ev.Status == other.Status &&
+		reflect.DeepEqual(ev.Output, other.Output)
+}
+
+func (api *firehoseAPI) handleStreamEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		utils.WriteErr(w, errors.ErrInternal.WithMsgf("streaming not supported by client"))
+		return
+	}
+
+	urn := chi.URLParam(r, pathParamURN)
+	if _, err := api.getFirehose(r.Context(), chi.URLParam(r, pathParamProject), urn); err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	events, unsubscribe := api.Events.subscribe(urn)
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(eventHeartbeat)
+	defer heartbeat.Stop()
+
+	eventID := 0
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+
+		case ev, open := <-events:
+			if !open {
+				return
+			}
+
+			data, err := json.Marshal(ev)
+			if err != nil {
+				log.Printf("error: failed to marshal event: %v", err)
+				return
+			}
+
+			eventID++
+			if _, err := fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", eventID, ev.Type, data); err != nil {
+				return
+			}
+			flusher.Flush()
+
+			if ev.Type == eventTypeDeleted {
+				return
+			}
+		}
+	}
+}
+
+// eventPoller polls Entropy for firehoses that have at least one subscriber.
+// A single poll loop is shared by all subscribers of a firehose so that the
+// number of calls to Entropy does not grow with the number of subscribers.
+type eventPoller struct {
+	mu       sync.Mutex
+	interval time.Duration
+	fetch    func(ctx context.Context, urn string) (*firehoseEvent, error)
+	watches  map[string]*firehoseWatch
+}
+
+type firehoseWatch struct {
+	subs   map[chan firehoseEvent]struct{}
+	last   *firehoseEvent
+	cancel context.CancelFunc
+}
+
+func newEventPoller(interval time.Duration, fetch func(ctx context.Context, urn string) (*firehoseEvent, error)) *eventPoller {
+	return &eventPoller{
+		interval: interval,
+		fetch:    fetch,
+		watches:  map[string]*firehoseWatch{},
+	}
+}
+
+// subscribe returns a channel that receives events for the firehose. The
+// latest known event is delivered immediately. The returned function must be
+// called to release the subscription. The channel is closed when the firehose
+// is deleted.
+func (p *eventPoller) subscribe(urn string) (<-chan firehoseEvent, func()) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ch := make(chan firehoseEvent, 1)
+
+	watch, exists := p.watches[urn]
+	if !exists {
+		ctx, cancel := context.WithCancel(context.Background())
+		watch = &firehoseWatch{
+			subs:   map[chan firehoseEvent]struct{}{},
+			cancel: cancel,
+		}
+		p.watches[urn] = watch
+		go p.poll(ctx, urn, watch)
+	}
+	watch.subs[ch] = struct{}{}
+
+	if watch.last != nil {
+		ch <- *watch.last
+	}
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() { p.release(urn, watch, ch) })
+	}
+	return ch, unsubscribe
+}
+
+func (p *eventPoller) release(urn string, watch *firehoseWatch, ch chan firehoseEvent) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, ok := watch.subs[ch]; !ok {
+		return // already closed by broadcast.
+	}
+	delete(watch.subs, ch)
+	close(ch)
+
+	if len(watch.subs) == 0 && p.watches[urn] == watch {
+		watch.cancel()
+		delete(p.watches, urn)
+	}
+}
+
+func (p *eventPoller) poll(ctx context.Context, urn string, watch *firehoseWatch) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		ev, err := p.fetch(ctx, urn)
+		if err != nil {
+			if errors.Is(err, errors.ErrNotFound) {
+				p.broadcast(urn, watch, firehoseEvent{
+					Type:      eventTypeDeleted,
+					URN:       urn,
+					Timestamp: time.Now(),
+				})
+				return
+			} else if ctx.Err() == nil {
+				log.Printf("error: failed to poll firehose '%s': %v", urn, err)
+			}
+		} else {
+			p.broadcast(urn, watch, *ev)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *eventPoller) broadcast(urn string, watch *firehoseWatch, ev firehoseEvent) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if watch.last != nil && watch.last.sameAs(ev) {
+		return
+	}
+	watch.last = &ev
+
+	for ch := range watch.subs {
+		// subscribers only care about the latest event. drop the pending
+		// one if the subscriber is slow.
+		select {
+		case <-ch:
+		default:
+		}
+		ch <- ev
+	}
+
+	if ev.Type == eventTypeDeleted {
+		for ch := range watch.subs {
+			close(ch)
+			delete(watch.subs, ch)
+		}
+		watch.cancel()
+		if p.watches[urn] == watch {
+			delete(p.watches, urn)
+		}
+	}
+}
+
+func (api *firehoseAPI) fetchFirehoseEvent(ctx context.Context, urn string) (*firehoseEvent, error) {
+	res, err := api.fetchFirehoseResource(ctx, urn)
+	if err != nil {
+		return nil, err
+	}
+
+	def, err := mapResourceToFirehose(res, false)
+	if err != nil {
+		return nil, err
+	}
+
+	ev := &firehoseEvent{
+		Type:      eventTypeState,
+		URN:       urn,
+		Timestamp: time.Now(),
+	}
+	if def.State != nil {
+		ev.State = def.State.State
+		ev.Status = def.State.Status
+		ev.Output = def.State.Output
+	}
+	return ev, nil
+}
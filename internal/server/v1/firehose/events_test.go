@@ -0,0 +1,121 @@
+package firehose
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/odpf/dex/pkg/errors"
+)
+
+func TestEventPoller(t *testing.T) {
+	t.Parallel()
+
+	t.Run("SharedPollAndDeduplication", func(t *testing.T) {
+		var calls int64
+		var mu sync.Mutex
+		status := "STATUS_PENDING"
+
+		poller := newEventPoller(10*time.Millisecond, func(ctx context.Context, urn string) (*firehoseEvent, error) {
+			atomic.AddInt64(&calls, 1)
+			mu.Lock()
+			defer mu.Unlock()
+			return &firehoseEvent{Type: eventTypeState, URN: urn, Status: status}, nil
+		})
+
+		first, unsubFirst := poller.subscribe("urn-1")
+		second, unsubSecond := poller.subscribe("urn-1")
+		defer unsubSecond()
+
+		ev := receiveEvent(t, first)
+		assert.Equal(t, "STATUS_PENDING", ev.Status)
+		assert.Equal(t, "STATUS_PENDING", receiveEvent(t, second).Status)
+
+		mu.Lock()
+		status = "STATUS_COMPLETED"
+		mu.Unlock()
+
+		assert.Equal(t, "STATUS_COMPLETED", receiveEvent(t, first).Status)
+		assert.Equal(t, "STATUS_COMPLETED", receiveEvent(t, second).Status)
+
+		// a single poll loop serves both subscribers.
+		poller.mu.Lock()
+		assert.Len(t, poller.watches, 1)
+		assert.Len(t, poller.watches["urn-1"].subs, 2)
+		poller.mu.Unlock()
+
+		// unchanged state must not be re-sent.
+		unsubFirst()
+		time.Sleep(50 * time.Millisecond)
+		assert.Greater(t, atomic.LoadInt64(&calls), int64(2))
+		select {
+		case ev := <-second:
+			t.Fatalf("unexpected event: %+v", ev)
+		default:
+		}
+	})
+
+	t.Run("StopsWhenUnsubscribed", func(t *testing.T) {
+		var calls int64
+		poller := newEventPoller(5*time.Millisecond, func(ctx context.Context, urn string) (*firehoseEvent, error) {
+			atomic.AddInt64(&calls, 1)
+			return &firehoseEvent{Type: eventTypeState, URN: urn}, nil
+		})
+
+		ch, unsub := poller.subscribe("urn-1")
+		receiveEvent(t, ch)
+		unsub()
+
+		time.Sleep(20 * time.Millisecond)
+		stopped := atomic.LoadInt64(&calls)
+		time.Sleep(30 * time.Millisecond)
+		assert.Equal(t, stopped, atomic.LoadInt64(&calls))
+		assert.Empty(t, poller.watches)
+	})
+
+	t.Run("Deleted", func(t *testing.T) {
+		poller := newEventPoller(5*time.Millisecond, func(ctx context.Context, urn string) (*firehoseEvent, error) {
+			return nil, errFirehoseNotFound
+		})
+
+		ch, unsub := poller.subscribe("urn-1")
+		defer unsub()
+
+		assert.Equal(t, eventTypeDeleted, receiveEvent(t, ch).Type)
+		_, open := <-ch
+		assert.False(t, open)
+	})
+
+	t.Run("TransientErrorsIgnored", func(t *testing.T) {
+		var calls int64
+		poller := newEventPoller(5*time.Millisecond, func(ctx context.Context, urn string) (*firehoseEvent, error) {
+			if atomic.AddInt64(&calls, 1) == 1 {
+				return nil, errors.ErrInternal
+			}
+			return &firehoseEvent{Type: eventTypeState, URN: urn, Status: "STATUS_COMPLETED"}, nil
+		})
+
+		ch, unsub := poller.subscribe("urn-1")
+		defer unsub()
+
+		assert.Equal(t, "STATUS_COMPLETED", receiveEvent(t, ch).Status)
+	})
+}
+
+func receiveEvent(t *testing.T, ch <-chan firehoseEvent) firehoseEvent {
+	t.Helper()
+
+	select {
+	case ev, open := <-ch:
+		require.True(t, open, "channel closed unexpectedly")
+		return ev
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for event")
+		return firehoseEvent{}
+	}
+}
@@ -0,0 +1,114 @@
+package firehose
+
+import (
+	"net/url"
+	"strings"
+
+	entropyv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/entropy/v1beta1"
+
+	"github.com/odpf/dex/internal/server/utils"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+// Fields of list items that can be expanded using the 'expand' query param.
+const (
+	expandState   = "state"
+	expandConfigs = "configs"
+)
+
+// firehoseFilter represents the filters supported by the list API. Empty
+// fields are ignored.
+type firehoseFilter struct {
+	Group       string
+	KubeCluster string
+	Status      string
+	TopicName   string
+	StreamName  string
+	SinkType    string
+}
+
+// listExpansion represents the optional fields to be populated for each
+// item in the list response.
+type listExpansion struct {
+	State   bool
+	Configs bool
+}
+
+func parseListExpansion(query url.Values) (listExpansion, error) {
+	var exp listExpansion
+	for _, val := range query["expand"] {
+		for _, field := range strings.Split(val, ",") {
+			switch strings.TrimSpace(field) {
+			case expandState:
+				exp.State = true
+
+			case expandConfigs:
+				exp.Configs = true
+
+			case "":
+				continue
+
+			default:
+				return exp, errors.ErrInvalid.
+					WithMsgf("expand must be a combination of '%s' & '%s'", expandState, expandConfigs)
+			}
+		}
+	}
+	return exp, nil
+}
+
+func parseFirehoseFilter(query url.Values) firehoseFilter {
+	return firehoseFilter{
+		Group:       strings.TrimSpace(query.Get("group")),
+		KubeCluster: strings.TrimSpace(query.Get("kube_cluster")),
+		Status:      strings.TrimSpace(query.Get("status")),
+		TopicName:   strings.TrimSpace(query.Get("topic_name")),
+		StreamName:  strings.TrimSpace(query.Get("stream_name")),
+		SinkType:    strings.TrimSpace(query.Get("sink_type")),
+	}
+}
+
+// needsConfigs returns true if any of the filters can be evaluated only
+// after decoding the module config of the resource.
+func (f firehoseFilter) needsConfigs() bool {
+	return f.Status != "" || f.TopicName != "" || f.StreamName != "" || f.SinkType != ""
+}
+
+// match returns true if the given firehose resource satisfies all the
+// filters. Labels & dependencies are checked before decoding the config
+// to avoid the decoding cost for resources that are already rejected.
+func (f firehoseFilter) match(res *entropyv1beta1.Resource) (bool, error) {
+	if f.Group != "" && res.GetLabels()["group"] != f.Group {
+		return false, nil
+	}
+
+	if f.KubeCluster != "" && getKubeCluster(res) != f.KubeCluster {
+		return false, nil
+	}
+
+	if !f.needsConfigs() {
+		return true, nil
+	}
+
+	var modConf moduleConfig
+	if err := utils.ProtoStructToGoVal(res.GetSpec().GetConfigs(), &modConf); err != nil {
+		return false, err
+	}
+	envVars := modConf.Firehose.EnvVariables
+
+	switch {
+	case f.Status != "" && !strings.EqualFold(modConf.State, f.Status):
+		return false, nil
+
+	case f.TopicName != "" && modConf.Firehose.KafkaTopic != f.TopicName:
+		return false, nil
+
+	case f.StreamName != "" && envVars["STREAM_NAME"] != f.StreamName:
+		return false, nil
+
+	case f.SinkType != "" && !strings.EqualFold(envVars["SINK_TYPE"], f.SinkType):
+		return false, nil
+	}
+
+	return true, nil
+}
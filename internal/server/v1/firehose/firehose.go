@@ -3,10 +3,9 @@ package firehose
 import (
 	"context"
 	"net/http"
+	"strconv"
 
 	"github.com/go-chi/chi/v5"
-	"github.com/yudai/gojsondiff"
-	"github.com/yudai/gojsondiff/formatter"
 	entropyv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/entropy/v1beta1"
 	shieldv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/shield/v1beta1"
 	sirenv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/siren/v1beta1"
@@ -14,23 +13,61 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/odpf/dex/generated/models"
+	"github.com/odpf/dex/internal/server/authz"
+	"github.com/odpf/dex/internal/server/autoscale"
+	"github.com/odpf/dex/internal/server/kafka"
+	"github.com/odpf/dex/internal/server/mask"
+	"github.com/odpf/dex/internal/server/operation"
+	"github.com/odpf/dex/internal/server/schedule"
+	"github.com/odpf/dex/internal/server/secret"
 	alertsv1 "github.com/odpf/dex/internal/server/v1/alert"
 	"github.com/odpf/dex/internal/server/v1/project"
+	templatev1 "github.com/odpf/dex/internal/server/v1/template"
 	"github.com/odpf/dex/pkg/errors"
 )
 
-const pathParamURN = "urn"
+const (
+	pathParamURN     = "urn"
+	pathParamProject = "projectSlug"
+)
 
 var errFirehoseNotFound = errors.ErrNotFound.WithMsgf("no firehose with given URN")
 
 func Routes(entropy entropyv1beta1.ResourceServiceClient,
 	shield shieldv1beta1.ShieldServiceClient,
 	alertSvc *alertsv1.Service,
+	templates templatev1.Store,
+	secrets *secret.Resolver,
+	masker *mask.Masker,
+	authorizer *authz.Authorizer,
+	kafkaClient *kafka.Client,
+	operations *operation.Service,
+	schedules *schedule.Service,
+	autoscaler *autoscale.Service,
 ) func(chi.Router) {
 	api := &firehoseAPI{
-		Shield:   shield,
-		Entropy:  entropy,
-		AlertSvc: alertSvc,
+		Shield:     shield,
+		Entropy:    entropy,
+		AlertSvc:   alertSvc,
+		Templates:  templates,
+		Secrets:    secrets,
+		Masker:     masker,
+		Authz:      authorizer,
+		Kafka:      kafkaClient,
+		Operations: operations,
+		Schedules:  schedules,
+		Autoscaler: autoscaler,
+		Migrations: newMigrationTracker(operations, migrationPollInterval, migrationStepTimeout),
+	}
+	api.Events = newEventPoller(eventPollInterval, api.fetchFirehoseEvent)
+	if operations != nil {
+		go api.recoverMigrations(context.Background())
+	}
+	if schedules != nil {
+		schedules.Start(api.runSchedule)
+	}
+	if autoscaler != nil {
+		autoscaler.Start(api.describeForAutoscale, api.autoscaleFirehose)
 	}
 
 	return func(r chi.Router) {
@@ -42,6 +79,11 @@ func Routes(entropy entropyv1beta1.ResourceServiceClient,
 		r.Delete("/{urn}", api.handleDelete)
 		r.Get("/{urn}/logs", api.handleStreamLog)
 		r.Get("/{urn}/history", api.handleGetHistory)
+		r.Get("/{urn}/history/{revision}", api.handleGetRevision)
+		r.Post("/{urn}/rollback", api.handleRollback)
+		r.Get("/{urn}/events", api.handleStreamEvents)
+		r.Get("/{urn}/operations", api.handleListOperations)
+		r.Get("/{urn}/consumerLag", api.handleGetConsumerLag)
 
 		// Firehose Actions
 		r.Post("/{urn}/reset", api.handleReset)
@@ -49,6 +91,22 @@ func Routes(entropy entropyv1beta1.ResourceServiceClient,
 		r.Post("/{urn}/start", api.handleStart)
 		r.Post("/{urn}/stop", api.handleStop)
 		r.Post("/{urn}/upgrade", api.handleUpgrade)
+		r.Post("/{urn}/clone", api.handleClone)
+		r.Post("/{urn}/migrate", api.handleMigrate)
+		r.Get("/{urn}/migrations", api.handleListMigrations)
+		r.Get("/{urn}/migrations/{migrationID}", api.handleGetMigration)
+
+		// Scheduled actions
+		r.Get("/{urn}/schedules", api.handleListSchedules)
+		r.Post("/{urn}/schedules", api.handleCreateSchedule)
+		r.Get("/{urn}/schedules/{scheduleID}", api.handleGetSchedule)
+		r.Put("/{urn}/schedules/{scheduleID}", api.handleUpdateSchedule)
+		r.Delete("/{urn}/schedules/{scheduleID}", api.handleDeleteSchedule)
+
+		// Autoscaling
+		r.Get("/{urn}/autoscalePolicy", api.handleGetAutoscalePolicy)
+		r.Put("/{urn}/autoscalePolicy", api.handlePutAutoscalePolicy)
+		r.Delete("/{urn}/autoscalePolicy", api.handleDeleteAutoscalePolicy)
 
 		// Alert management
 		r.Get("/{urn}/alerts", api.handleListAlerts)
@@ -62,39 +120,83 @@ type firehoseAPI struct {
 	Shield  shieldv1beta1.ShieldServiceClient
 	Siren   sirenv1beta1.SirenServiceClient
 
-	AlertSvc *alertsv1.Service
+	AlertSvc   *alertsv1.Service
+	Templates  templatev1.Store
+	Secrets    *secret.Resolver
+	Masker     *mask.Masker
+	Authz      *authz.Authorizer
+	Kafka      offsetsReader
+	Events     *eventPoller
+	Migrations *migrationTracker
+	Operations *operation.Service
+	Schedules  *schedule.Service
+	Autoscaler *autoscale.Service
 }
 
 func (api *firehoseAPI) getProject(r *http.Request) (*shieldv1beta1.Project, error) {
 	return project.GetProject(r, api.Shield)
 }
 
-func (api *firehoseAPI) getFirehose(ctx context.Context, firehoseURN string) (*models.Firehose, error) {
-	resp, err := api.Entropy.GetResource(ctx, &entropyv1beta1.GetResourceRequest{Urn: firehoseURN})
+func (api *firehoseAPI) getFirehose(ctx context.Context, prjSlug, firehoseURN string) (*models.Firehose, error) {
+	res, err := api.getFirehoseResource(ctx, prjSlug, firehoseURN)
 	if err != nil {
-		st := status.Convert(err)
-		if st.Code() == codes.NotFound {
-			return nil, errFirehoseNotFound.WithCausef(st.Message())
-		}
 		return nil, err
-	} else if resp.GetResource().GetKind() != kindFirehose {
-		return nil, errFirehoseNotFound
 	}
+	return mapResourceToFirehose(res, false)
+}
 
-	return mapResourceToFirehose(resp.GetResource(), false)
+// getFirehoseResource returns the firehose resource with the given URN in
+// the project. Resources of other projects are reported as not found since
+// the access is authorized using the project in the request path.
+func (api *firehoseAPI) getFirehoseResource(ctx context.Context, prjSlug, firehoseURN string) (*entropyv1beta1.Resource, error) {
+	res, err := api.fetchFirehoseResource(ctx, firehoseURN)
+	if err != nil {
+		return nil, err
+	} else if res.GetProject() != prjSlug {
+		return nil, errFirehoseNotFound
+	}
+	return res, nil
 }
 
-func jsonDiff(left, right []byte) (string, error) {
-	differ := gojsondiff.New()
-	compare, err := differ.Compare(left, right)
+// getKubeClusterResource returns the kubernetes cluster resource with the given URN
+// in the project. Missing clusters and the clusters of other projects are
+// reported as invalid since the URN is an input of the request.
+func (api *firehoseAPI) getKubeClusterResource(ctx context.Context, prjSlug, kubeURN string) (*entropyv1beta1.Resource, error) {
+	errNoCluster := errors.ErrInvalid.WithMsgf("no kubernetes cluster '%s' in the project", kubeURN)
+
+	resp, err := api.Entropy.GetResource(ctx, &entropyv1beta1.GetResourceRequest{Urn: kubeURN})
 	if err != nil {
-		return "", err
+		st := status.Convert(err)
+		if st.Code() == codes.NotFound || st.Code() == codes.InvalidArgument {
+			return nil, errNoCluster.WithCausef(st.Message())
+		}
+		return nil, err
+	} else if res := resp.GetResource(); res.GetKind() != kindKubernetes || res.GetProject() != prjSlug {
+		return nil, errNoCluster
 	}
+	return resp.GetResource(), nil
+}
 
-	diffString, err := formatter.NewDeltaFormatter().Format(compare)
+// fetchFirehoseResource returns the firehose resource with the given URN
+// irrespective of its project. Must be used only for the URNs already
+// validated using getFirehoseResource.
+func (api *firehoseAPI) fetchFirehoseResource(ctx context.Context, firehoseURN string) (*entropyv1beta1.Resource, error) {
+	resp, err := api.Entropy.GetResource(ctx, &entropyv1beta1.GetResourceRequest{Urn: firehoseURN})
 	if err != nil {
-		return "", err
+		st := status.Convert(err)
+		if st.Code() == codes.NotFound {
+			return nil, errFirehoseNotFound.WithCausef(st.Message())
+		}
+		return nil, err
+	} else if resp.GetResource().GetKind() != kindFirehose {
+		return nil, errFirehoseNotFound
 	}
+	return resp.GetResource(), nil
+}
 
-	return diffString, nil
+// isDryRun returns true if the request should only be validated without
+// applying any changes.
+func isDryRun(r *http.Request) bool {
+	dryRun, _ := strconv.ParseBool(r.URL.Query().Get("dry_run"))
+	return dryRun
 }
@@ -0,0 +1,248 @@
+package firehose
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	entropyv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/entropy/v1beta1"
+	shieldv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/shield/v1beta1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/odpf/dex/internal/server/autoscale"
+	"github.com/odpf/dex/internal/server/mask"
+	"github.com/odpf/dex/internal/server/schedule"
+)
+
+type fakeShield struct {
+	shieldv1beta1.ShieldServiceClient
+}
+
+func (fakeShield) ListProjects(_ context.Context, _ *shieldv1beta1.ListProjectsRequest, _ ...grpc.CallOption) (*shieldv1beta1.ListProjectsResponse, error) {
+	return &shieldv1beta1.ListProjectsResponse{Projects: []*shieldv1beta1.Project{{Slug: "foo"}, {Slug: "bar"}}}, nil
+}
+
+func TestCrossProjectAccess(t *testing.T) {
+	t.Parallel()
+
+	const urn = "orn:entropy:firehose:bar:baz"
+
+	configs, err := structpb.NewValue(map[string]any{
+		"state": moduleStateRunning,
+		"firehose": map[string]any{
+			"replicas":      1,
+			"env_variables": map[string]any{"SINK_TYPE": "LOG"},
+		},
+	})
+	require.NoError(t, err)
+
+	entropy := &fakeEntropy{resources: map[string]*entropyv1beta1.Resource{
+		urn: {
+			Urn:     urn,
+			Kind:    kindFirehose,
+			Name:    "baz",
+			Project: "bar",
+			State:   &entropyv1beta1.ResourceState{Status: entropyv1beta1.ResourceState_STATUS_COMPLETED},
+			Spec:    &entropyv1beta1.ResourceSpec{Configs: configs},
+		},
+	}}
+
+	masker, err := mask.New(mask.Config{})
+	require.NoError(t, err)
+
+	schedules := schedule.NewWithStore(schedule.NewMemoryStore(), time.Hour)
+	defer schedules.Close()
+	autoscaler := autoscale.NewWithStore(autoscale.NewMemoryStore(), nil, time.Hour)
+	defer autoscaler.Close()
+
+	router := chi.NewRouter()
+	router.Route("/projects/{projectSlug}/firehoses",
+		Routes(entropy, nil, nil, nil, nil, masker, nil, nil, nil, schedules, autoscaler))
+
+	table := []struct {
+		title  string
+		method string
+		path   string
+		body   string
+	}{
+		{title: "Get", method: http.MethodGet, path: "/"},
+		{title: "Scale", method: http.MethodPost, path: "/scale", body: `{"replicas": 4}`},
+		{title: "Delete", method: http.MethodDelete, path: "/"},
+		{title: "History", method: http.MethodGet, path: "/history"},
+		{title: "Revision", method: http.MethodGet, path: "/history/1"},
+		{title: "Logs", method: http.MethodGet, path: "/logs?reveal=true"},
+		{title: "CreateSchedule", method: http.MethodPost, path: "/schedules", body: `{"cron": "0 22 * * *", "action": "stop"}`},
+		{title: "PutAutoscalePolicy", method: http.MethodPut, path: "/autoscalePolicy", body: `{"min_replicas": 1, "max_replicas": 4, "target_lag": 1000}`},
+	}
+
+	for _, tt := range table {
+		tt := tt
+		t.Run(tt.title, func(t *testing.T) {
+			path := "/projects/foo/firehoses/" + urn + strings.TrimSuffix(tt.path, "/")
+			req := httptest.NewRequest(tt.method, path, strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusNotFound, rec.Code, rec.Body.String())
+		})
+	}
+
+	t.Run("SameProject", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/projects/bar/firehoses/"+urn, nil)
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	})
+
+	ctx := context.Background()
+	list, err := schedules.List(ctx, schedule.Filter{URN: urn})
+	require.NoError(t, err)
+	assert.Empty(t, list)
+
+	_, err = autoscaler.Get(ctx, urn)
+	assert.Error(t, err)
+	assert.Equal(t, moduleStateRunning, getModuleState(entropy.resources[urn]))
+}
+
+func TestUpdateLegacyFirehose(t *testing.T) {
+	t.Parallel()
+
+	const urn = "orn:entropy:firehose:foo:bar"
+
+	// created before SINK_HTTP_SERVICE_URL was required.
+	configs, err := structpb.NewValue(map[string]any{
+		"state": moduleStateRunning,
+		"firehose": map[string]any{
+			"replicas":             1,
+			"kafka_broker_address": "localhost:9092",
+			"kafka_topic":          "bookings",
+			"kafka_consumer_id":    "foo-bar-0001",
+			"env_variables": map[string]any{
+				"SINK_TYPE":                "HTTP",
+				"STREAM_NAME":              "main",
+				"INPUT_SCHEMA_PROTO_CLASS": "com.example.Booking",
+				"SINK_HTTP_REQUEST_METHOD": "post",
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	entropy := &fakeEntropy{resources: map[string]*entropyv1beta1.Resource{
+		urn: {
+			Urn:     urn,
+			Kind:    kindFirehose,
+			Name:    "bar",
+			Project: "foo",
+			State:   &entropyv1beta1.ResourceState{Status: entropyv1beta1.ResourceState_STATUS_COMPLETED},
+			Spec:    &entropyv1beta1.ResourceSpec{Configs: configs},
+		},
+	}}
+
+	router := chi.NewRouter()
+	router.Route("/projects/{projectSlug}/firehoses",
+		Routes(entropy, fakeShield{}, nil, nil, nil, nil, nil, nil, nil, nil, nil))
+
+	update := func(t *testing.T, envVars string) *httptest.ResponseRecorder {
+		t.Helper()
+
+		body := `{"configs": {
+			"bootstrap_servers": "localhost:9092",
+			"topic_name": "bookings",
+			"consumer_group_id": "foo-bar-0001",
+			"sink_type": "HTTP",
+			"stream_name": "main",
+			"input_schema_proto_class": "com.example.Booking",
+			"replicas": 2,
+			"env_vars": ` + envVars + `
+		}}`
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/projects/foo/firehoses/"+urn, strings.NewReader(body)))
+		return rec
+	}
+
+	rec := update(t, `{"SINK_HTTP_REQUEST_METHOD": "post"}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Contains(t, rec.Body.String(), `"replicas":2`)
+
+	rec = update(t, `{"SINK_HTTP_REQUEST_METHOD": "get"}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code, "changed env vars must still be validated")
+	assert.Contains(t, rec.Body.String(), "SINK_HTTP_REQUEST_METHOD")
+}
+
+func TestUpdateMaskedChanges(t *testing.T) {
+	t.Parallel()
+
+	const urn = "orn:entropy:firehose:foo:bar"
+
+	configs, err := structpb.NewValue(map[string]any{
+		"state": moduleStateRunning,
+		"firehose": map[string]any{
+			"replicas":             1,
+			"kafka_broker_address": "localhost:9092",
+			"kafka_topic":          "bookings",
+			"kafka_consumer_id":    "foo-bar-0001",
+			"env_variables": map[string]any{
+				"SINK_TYPE":                "LOG",
+				"STREAM_NAME":              "main",
+				"INPUT_SCHEMA_PROTO_CLASS": "com.example.Booking",
+				"SOURCE_KAFKA_PASSWORD":    "s3cret",
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	entropy := &fakeEntropy{resources: map[string]*entropyv1beta1.Resource{
+		urn: {
+			Urn:     urn,
+			Kind:    kindFirehose,
+			Name:    "bar",
+			Project: "foo",
+			State:   &entropyv1beta1.ResourceState{Status: entropyv1beta1.ResourceState_STATUS_COMPLETED},
+			Spec:    &entropyv1beta1.ResourceSpec{Configs: configs},
+		},
+	}}
+
+	masker, err := mask.New(mask.Config{})
+	require.NoError(t, err)
+
+	router := chi.NewRouter()
+	router.Route("/projects/{projectSlug}/firehoses",
+		Routes(entropy, fakeShield{}, nil, nil, nil, masker, nil, nil, nil, nil, nil))
+
+	dryRun := func(t *testing.T, password string) *httptest.ResponseRecorder {
+		t.Helper()
+
+		body := `{"configs": {
+			"bootstrap_servers": "localhost:9092",
+			"topic_name": "bookings",
+			"consumer_group_id": "foo-bar-0001",
+			"sink_type": "LOG",
+			"stream_name": "main",
+			"input_schema_proto_class": "com.example.Booking",
+			"env_vars": {"SOURCE_KAFKA_PASSWORD": "` + password + `"}
+		}}`
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/projects/foo/firehoses/"+urn+"?dry_run=true", strings.NewReader(body)))
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		return rec
+	}
+
+	// the masked value read from the server is retained as is.
+	rec := dryRun(t, mask.Placeholder)
+	assert.Empty(t, rec.Header().Get(headerMaskedChanges))
+
+	rec = dryRun(t, "s3cret")
+	assert.Empty(t, rec.Header().Get(headerMaskedChanges))
+
+	// a rotated credential is masked in the response, but is reported.
+	rec = dryRun(t, "rotated")
+	assert.Equal(t, "SOURCE_KAFKA_PASSWORD", rec.Header().Get(headerMaskedChanges))
+	assert.NotContains(t, rec.Body.String(), "rotated")
+}
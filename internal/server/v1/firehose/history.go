@@ -0,0 +1,259 @@
+package firehose
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-openapi/strfmt"
+	entropyv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/entropy/v1beta1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/odpf/dex/generated/models"
+	"github.com/odpf/dex/internal/server/secret"
+	"github.com/odpf/dex/internal/server/utils"
+	"github.com/odpf/dex/pkg/diff"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+const pathParamRevision = "revision"
+
+// historyFilter represents the criteria for listing revisions. Empty fields
+// are ignored.
+type historyFilter struct {
+	Actor  string
+	Reason string
+	Since  time.Time
+	Until  time.Time
+	Limit  int
+}
+
+func (f historyFilter) match(rd models.RevisionDiff) bool {
+	if f.Actor != "" && !strings.EqualFold(rd.UpdatedByEmail, f.Actor) && rd.UpdatedBy != f.Actor {
+		return false
+	}
+	if f.Reason != "" && !strings.Contains(strings.ToLower(rd.Reason), strings.ToLower(f.Reason)) {
+		return false
+	}
+
+	updatedAt := time.Time(rd.UpdatedAt)
+	return (f.Since.IsZero() || !updatedAt.Before(f.Since)) &&
+		(f.Until.IsZero() || !updatedAt.After(f.Until))
+}
+
+// apply returns the diffs matching the filter. If a limit is set, only the
+// most recent diffs are returned.
+func (f historyFilter) apply(diffs []models.RevisionDiff) []models.RevisionDiff {
+	res := []models.RevisionDiff{}
+	for _, rd := range diffs {
+		if f.match(rd) {
+			res = append(res, rd)
+		}
+	}
+
+	if f.Limit > 0 && len(res) > f.Limit {
+		res = res[len(res)-f.Limit:]
+	}
+	return res
+}
+
+func parseHistoryFilter(r *http.Request) (historyFilter, error) {
+	q := r.URL.Query()
+	f := historyFilter{
+		Actor:  strings.TrimSpace(q.Get("actor")),
+		Reason: strings.TrimSpace(q.Get("reason")),
+	}
+
+	for name, t := range map[string]*time.Time{"since": &f.Since, "until": &f.Until} {
+		if s := strings.TrimSpace(q.Get(name)); s != "" {
+			parsed, err := time.Parse(time.RFC3339, s)
+			if err != nil {
+				return f, errors.ErrInvalid.WithMsgf("%s must be a valid RFC3339 timestamp", name)
+			}
+			*t = parsed
+		}
+	}
+
+	if s := strings.TrimSpace(q.Get("limit")); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil || n <= 0 {
+			return f, errors.ErrInvalid.WithMsgf("limit must be a positive integer")
+		}
+		f.Limit = n
+	}
+	return f, nil
+}
+
+func historyFormat(r *http.Request) (string, error) {
+	format := strings.ToLower(strings.TrimSpace(r.URL.Query().Get("format")))
+	switch format {
+	case "":
+		return diff.FormatDelta, nil
+
+	case diff.FormatDelta, diff.FormatUnified, diff.FormatJSONPatch:
+		return format, nil
+
+	default:
+		return "", errors.ErrInvalid.WithMsgf("format must be one of '%s', '%s' or '%s'",
+			diff.FormatDelta, diff.FormatUnified, diff.FormatJSONPatch)
+	}
+}
+
+func (api *firehoseAPI) handleGetHistory(w http.ResponseWriter, r *http.Request) {
+	urn := chi.URLParam(r, pathParamURN)
+
+	format, err := historyFormat(r)
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	filter, err := parseHistoryFilter(r)
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	diffs, err := api.getRevisions(r.Context(), chi.URLParam(r, pathParamProject), urn, isReveal(r), format)
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, utils.NewListResponse(filter.apply(diffs)))
+}
+
+func (api *firehoseAPI) handleGetRevision(w http.ResponseWriter, r *http.Request) {
+	urn := chi.URLParam(r, pathParamURN)
+
+	n, err := strconv.Atoi(chi.URLParam(r, pathParamRevision))
+	if err != nil || n <= 0 {
+		utils.WriteErr(w, errors.ErrInvalid.WithMsgf("revision must be a positive integer"))
+		return
+	}
+
+	revisions, err := api.fetchRevisions(r.Context(), chi.URLParam(r, pathParamProject), urn)
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	} else if n > len(revisions) {
+		utils.WriteErr(w, errors.ErrNotFound.WithMsgf("revision %d does not exist, firehose has %d revisions",
+			n, len(revisions)))
+		return
+	}
+
+	revision := revisions[n-1]
+	spec, err := api.revisionSpec(revision, isReveal(r))
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	labels := revision.GetLabels()
+	utils.WriteJSON(w, http.StatusOK, models.Revision{
+		Revision:       int64(n),
+		ID:             revision.GetId(),
+		Labels:         labels,
+		Reason:         revisionReason(revision),
+		UpdatedBy:      labels["updated_by"],
+		UpdatedByEmail: labels["updated_by_email"],
+		UpdatedAt:      strfmt.DateTime(revision.GetCreatedAt().AsTime()),
+		Spec:           json.RawMessage(spec),
+	})
+}
+
+// getRevisions returns the diffs between the consecutive revisions in the
+// given format. Values of the sensitive env vars are masked unless reveal is
+// set.
+func (api *firehoseAPI) getRevisions(ctx context.Context, prjSlug, urn string, reveal bool, format string) ([]models.RevisionDiff, error) {
+	revisions, err := api.fetchRevisions(ctx, prjSlug, urn)
+	if err != nil {
+		return nil, err
+	}
+
+	prevSpec := []byte("{}")
+	var rh []models.RevisionDiff
+
+	for i, revision := range revisions {
+		currentSpec, err := api.revisionSpec(revision, reveal)
+		if err != nil {
+			return nil, err
+		}
+
+		specDiff, err := diff.Format(prevSpec, currentSpec, format)
+		if err != nil {
+			return nil, err
+		}
+
+		labels := revision.GetLabels()
+		rh = append(rh, models.RevisionDiff{
+			Revision:       int64(i + 1),
+			ID:             revision.GetId(),
+			Labels:         labels,
+			Reason:         revisionReason(revision),
+			UpdatedBy:      labels["updated_by"],
+			UpdatedByEmail: labels["updated_by_email"],
+			Diff:           specDiff,
+			UpdatedAt:      strfmt.DateTime(revision.GetCreatedAt().AsTime()),
+		})
+		prevSpec = currentSpec
+	}
+
+	return rh, nil
+}
+
+// revisionSpec returns the spec of the revision as JSON. The resolved secret
+// values are replaced with the references, and the values of the sensitive
+// env vars are masked unless reveal is set.
+func (api *firehoseAPI) revisionSpec(revision *entropyv1beta1.ResourceRevision, reveal bool) ([]byte, error) {
+	marshaller := protojson.MarshalOptions{
+		UseProtoNames: true,
+	}
+
+	spec, err := marshaller.Marshal(revision.GetSpec())
+	if err != nil {
+		return nil, err
+	}
+
+	refs := parseSecretRefsLabel(revision.GetLabels()[labelSecretRefs])
+	return transformSpecEnvVars(spec, func(envVars map[string]string) {
+		secret.Redact(envVars, refs)
+		if !reveal {
+			api.Masker.EnvVars(envVars)
+		}
+	}), nil
+}
+
+// revisionReason returns the reason recorded for the update, if any, or the
+// reason set by entropy.
+func revisionReason(revision *entropyv1beta1.ResourceRevision) string {
+	if reason := revision.GetLabels()[labelUpdateReason]; reason != "" {
+		return reason
+	}
+	return revision.GetReason()
+}
+
+// fetchRevisions returns the revisions of the firehose in the project, oldest
+// first. The URN is validated first since entropy returns the revisions of
+// any kind of resource.
+func (api *firehoseAPI) fetchRevisions(ctx context.Context, prjSlug, urn string) ([]*entropyv1beta1.ResourceRevision, error) {
+	if _, err := api.getFirehoseResource(ctx, prjSlug, urn); err != nil {
+		return nil, err
+	}
+
+	rpcReq := &entropyv1beta1.GetResourceRevisionsRequest{Urn: urn}
+	rpcResp, err := api.Entropy.GetResourceRevisions(ctx, rpcReq)
+	if err != nil {
+		st := status.Convert(err)
+		if st.Code() == codes.NotFound {
+			return nil, errFirehoseNotFound.WithCausef(st.Message())
+		}
+		return nil, err
+	}
+	return rpcResp.GetRevisions(), nil
+}
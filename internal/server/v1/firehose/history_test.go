@@ -0,0 +1,101 @@
+package firehose
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	entropyv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/entropy/v1beta1"
+
+	"github.com/odpf/dex/generated/models"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+func TestHistoryFilter(t *testing.T) {
+	t.Parallel()
+
+	base := time.Date(2022, 10, 10, 10, 0, 0, 0, time.UTC)
+	diffs := []models.RevisionDiff{
+		{Revision: 1, UpdatedByEmail: "alice@example.com", Reason: "action:create", UpdatedAt: strfmt.DateTime(base)},
+		{Revision: 2, UpdatedByEmail: "bob@example.com", Reason: "action:update", UpdatedAt: strfmt.DateTime(base.Add(time.Hour))},
+		{Revision: 3, UpdatedByEmail: "alice@example.com", Reason: "rollback to revision 1", UpdatedAt: strfmt.DateTime(base.Add(2 * time.Hour))},
+		{Revision: 4, UpdatedBy: "u-42", Reason: "action:scale", UpdatedAt: strfmt.DateTime(base.Add(3 * time.Hour))},
+	}
+
+	table := []struct {
+		title   string
+		query   string
+		want    []int64
+		wantErr error
+	}{
+		{title: "NoFilters", query: "", want: []int64{1, 2, 3, 4}},
+		{title: "ActorEmail", query: "actor=ALICE@example.com", want: []int64{1, 3}},
+		{title: "ActorID", query: "actor=u-42", want: []int64{4}},
+		{title: "Reason", query: "reason=Rollback", want: []int64{3}},
+		{title: "TimeRange", query: "since=2022-10-10T10:30:00Z&until=2022-10-10T12:00:00Z", want: []int64{2, 3}},
+		{title: "LimitKeepsLatest", query: "limit=2", want: []int64{3, 4}},
+		{title: "FilterAndLimit", query: "actor=alice@example.com&limit=1", want: []int64{3}},
+		{title: "NoMatch", query: "actor=eve@example.com", want: []int64{}},
+		{title: "InvalidSince", query: "since=yesterday", wantErr: errors.ErrInvalid},
+		{title: "InvalidLimit", query: "limit=0", wantErr: errors.ErrInvalid},
+	}
+
+	for _, tt := range table {
+		tt := tt
+		t.Run(tt.title, func(t *testing.T) {
+			t.Parallel()
+
+			filter, err := parseHistoryFilter(httptest.NewRequest("GET", "/history?"+tt.query, nil))
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			got := []int64{}
+			for _, rd := range filter.apply(diffs) {
+				got = append(got, rd.Revision)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestHistoryFormat(t *testing.T) {
+	t.Parallel()
+
+	format, err := historyFormat(httptest.NewRequest("GET", "/history", nil))
+	require.NoError(t, err)
+	assert.Equal(t, "delta", format)
+
+	format, err = historyFormat(httptest.NewRequest("GET", "/history?format=JSONPatch", nil))
+	require.NoError(t, err)
+	assert.Equal(t, "jsonpatch", format)
+
+	_, err = historyFormat(httptest.NewRequest("GET", "/history?format=xml", nil))
+	assert.ErrorIs(t, err, errors.ErrInvalid)
+}
+
+func TestFetchRevisions(t *testing.T) {
+	t.Parallel()
+
+	entropy := &fakeEntropy{resources: map[string]*entropyv1beta1.Resource{
+		"orn:entropy:firehose:foo:bar":  {Urn: "orn:entropy:firehose:foo:bar", Kind: kindFirehose, Project: "foo"},
+		"orn:entropy:kubernetes:foo:c1": {Urn: "orn:entropy:kubernetes:foo:c1", Kind: "kubernetes", Project: "foo"},
+	}}
+	api := &firehoseAPI{Entropy: entropy}
+
+	revisions, err := api.fetchRevisions(context.Background(), "foo", "orn:entropy:firehose:foo:bar")
+	require.NoError(t, err)
+	assert.Len(t, revisions, 1)
+
+	_, err = api.fetchRevisions(context.Background(), "baz", "orn:entropy:firehose:foo:bar")
+	assert.ErrorIs(t, err, errors.ErrNotFound, "firehose of another project must not be found")
+
+	_, err = api.fetchRevisions(context.Background(), "foo", "orn:entropy:kubernetes:foo:c1")
+	assert.ErrorIs(t, err, errors.ErrNotFound, "revisions of other kinds of resources must not be returned")
+}
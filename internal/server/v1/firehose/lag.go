@@ -0,0 +1,86 @@
+package firehose
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/odpf/dex/generated/models"
+	"github.com/odpf/dex/internal/server/kafka"
+	"github.com/odpf/dex/internal/server/utils"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+func (api *firehoseAPI) handleGetConsumerLag(w http.ResponseWriter, r *http.Request) {
+	urn := chi.URLParam(r, pathParamURN)
+
+	def, err := api.getFirehose(r.Context(), chi.URLParam(r, pathParamProject), urn)
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	var brokers, group, topic string
+	if def.Configs != nil {
+		if def.Configs.BootstrapServers != nil {
+			brokers = *def.Configs.BootstrapServers
+		}
+		if def.Configs.ConsumerGroupID != nil {
+			group = *def.Configs.ConsumerGroupID
+		}
+		if def.Configs.TopicName != nil {
+			topic = *def.Configs.TopicName
+		}
+	}
+
+	servers := kafka.SplitBrokers(brokers)
+	if len(servers) == 0 || group == "" || topic == "" {
+		utils.WriteErr(w, errors.ErrInvalid.
+			WithMsgf("firehose must have bootstrap servers, consumer group and topic to read consumer lag"))
+		return
+	}
+
+	lags, err := api.Kafka.ConsumerLag(r.Context(), servers, group, topic)
+	if errors.Is(err, kafka.ErrUnknownBrokers) {
+		utils.WriteErr(w, errors.ErrInvalid.
+			WithMsgf("bootstrap servers of firehose are not of a configured kafka cluster").
+			WithCausef(err.Error()))
+		return
+	} else if errors.Is(err, kafka.ErrUnknownTopic) {
+		utils.WriteErr(w, errors.ErrNotFound.
+			WithMsgf("topic of firehose does not exist on the kafka cluster").
+			WithCausef(err.Error()))
+		return
+	} else if err != nil {
+		utils.WriteErr(w, errors.ErrInternal.
+			WithMsgf("failed to read consumer lag from kafka").
+			WithCausef(err.Error()))
+		return
+	}
+
+	utils.WriteJSON(w, http.StatusOK, mapConsumerLag(group, topic, lags))
+}
+
+// mapConsumerLag returns the lag of the consumer group. Partitions without
+// a committed offset are not counted in the total lag.
+func mapConsumerLag(group, topic string, lags []kafka.PartitionLag) models.ConsumerLag {
+	res := models.ConsumerLag{
+		ConsumerGroup: group,
+		Topic:         topic,
+		Partitions:    []*models.PartitionLag{},
+	}
+
+	for _, l := range lags {
+		if l.Lag > 0 {
+			res.TotalLag += l.Lag
+		}
+		res.Partitions = append(res.Partitions, &models.PartitionLag{
+			Topic:           l.Topic,
+			Partition:       int64(l.Partition),
+			CommittedOffset: l.CommittedOffset,
+			LogEndOffset:    l.LogEndOffset,
+			Lag:             l.Lag,
+		})
+	}
+	return res
+}
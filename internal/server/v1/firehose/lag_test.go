@@ -0,0 +1,109 @@
+package firehose
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	entropyv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/entropy/v1beta1"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/odpf/dex/internal/server/kafka"
+)
+
+func TestMapConsumerLag(t *testing.T) {
+	t.Parallel()
+
+	lags, err := fakeOffsets{}.ConsumerLag(context.Background(), nil, "foo-bar-0001", "bookings")
+	require.NoError(t, err)
+
+	res := mapConsumerLag("foo-bar-0001", "bookings", lags)
+	assert.Equal(t, int64(58), res.TotalLag, "partitions without committed offset must not be counted")
+	require.Len(t, res.Partitions, 2)
+	assert.Equal(t, int64(0), res.Partitions[0].Partition)
+	assert.Equal(t, int64(100), res.Partitions[0].LogEndOffset)
+	assert.Equal(t, int64(-1), res.Partitions[1].Lag)
+
+	empty := mapConsumerLag("foo-bar-0001", "bookings", nil)
+	assert.NotNil(t, empty.Partitions)
+	assert.Zero(t, empty.TotalLag)
+}
+
+func TestGetConsumerLag(t *testing.T) {
+	t.Parallel()
+
+	resource := func(t *testing.T, urn, brokers, group string) *entropyv1beta1.Resource {
+		t.Helper()
+
+		configs, err := structpb.NewValue(map[string]any{
+			"state": moduleStateRunning,
+			"firehose": map[string]any{
+				"replicas":             1,
+				"kafka_broker_address": brokers,
+				"kafka_topic":          "bookings",
+				"kafka_consumer_id":    group,
+				"env_variables": map[string]any{
+					"SINK_TYPE":                "LOG",
+					"STREAM_NAME":              "main",
+					"INPUT_SCHEMA_PROTO_CLASS": "com.example.Booking",
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		return &entropyv1beta1.Resource{
+			Urn:     urn,
+			Kind:    kindFirehose,
+			Name:    "bar",
+			Project: "foo",
+			State:   &entropyv1beta1.ResourceState{Status: entropyv1beta1.ResourceState_STATUS_COMPLETED},
+			Spec:    &entropyv1beta1.ResourceSpec{Configs: configs},
+		}
+	}
+
+	broker := sarama.NewMockBroker(t, 1)
+	defer broker.Close()
+
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(t).
+			SetBroker(broker.Addr(), broker.BrokerID()).
+			SetController(broker.BrokerID()).
+			SetLeader("payments", 0, broker.BrokerID()),
+	})
+
+	const (
+		noGroup      = "orn:entropy:firehose:foo:no-group"
+		external     = "orn:entropy:firehose:foo:external"
+		unknownTopic = "orn:entropy:firehose:foo:unknown-topic"
+	)
+	entropy := &fakeEntropy{resources: map[string]*entropyv1beta1.Resource{
+		noGroup:      resource(t, noGroup, "localhost:9092", ""),
+		external:     resource(t, external, "attacker.example.com:9092", "foo-bar-0001"),
+		unknownTopic: resource(t, unknownTopic, broker.Addr(), "foo-bar-0001"),
+	}}
+
+	kafkaClient, err := kafka.New(kafka.Config{Clusters: []kafka.ClusterConfig{
+		{Name: "main", Brokers: []string{"localhost:9092"}, SASL: kafka.SASLConfig{Enabled: true, Username: "dex", Password: "secret"}},
+		{Name: "mock", Brokers: []string{broker.Addr()}},
+	}})
+	require.NoError(t, err)
+
+	router := chi.NewRouter()
+	router.Route("/projects/{projectSlug}/firehoses",
+		Routes(entropy, fakeShield{}, nil, nil, nil, nil, nil, kafkaClient, nil, nil, nil))
+
+	for _, urn := range []string{noGroup, external} {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/projects/foo/firehoses/"+urn+"/consumerLag", nil))
+		assert.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+	}
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/projects/foo/firehoses/"+unknownTopic+"/consumerLag", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code, rec.Body.String())
+}
@@ -0,0 +1,137 @@
+package firehose
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/odpf/dex/pkg/errors"
+)
+
+// levelKeys are the keys checked (in order) for the level of a JSON log line.
+var levelKeys = []string{"level", "lvl", "severity", "log.level"}
+
+// logFilter represents the server-side filters applied to the log lines
+// before they are sent to the client. Empty fields are ignored.
+type logFilter struct {
+	Include  []*regexp.Regexp
+	Exclude  []*regexp.Regexp
+	Levels   map[string]bool
+	MaxBytes int64
+}
+
+func parseLogFilter(query url.Values) (*logFilter, error) {
+	var f logFilter
+
+	for _, expr := range query["grep"] {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, errors.ErrInvalid.WithMsgf("grep pattern '%s' is not valid: %s", expr, err)
+		}
+		f.Include = append(f.Include, re)
+	}
+
+	for _, expr := range query["exclude"] {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, errors.ErrInvalid.WithMsgf("exclude pattern '%s' is not valid: %s", expr, err)
+		}
+		f.Exclude = append(f.Exclude, re)
+	}
+
+	for _, val := range query["level"] {
+		for _, level := range strings.Split(val, ",") {
+			level = strings.ToUpper(strings.TrimSpace(level))
+			if level == "" {
+				continue
+			}
+			if f.Levels == nil {
+				f.Levels = map[string]bool{}
+			}
+			f.Levels[level] = true
+		}
+	}
+
+	if s := strings.TrimSpace(query.Get("max_bytes")); s != "" {
+		maxBytes, err := strconv.ParseInt(s, 10, 64)
+		if err != nil || maxBytes <= 0 {
+			return nil, errors.ErrInvalid.WithMsgf("max_bytes must be a positive integer")
+		}
+		f.MaxBytes = maxBytes
+	}
+
+	return &f, nil
+}
+
+// isNoop returns true if the filter does not modify the lines.
+func (f *logFilter) isNoop() bool {
+	return len(f.Include) == 0 && len(f.Exclude) == 0 && len(f.Levels) == 0
+}
+
+// apply returns data with the lines that do not pass the filter removed.
+func (f *logFilter) apply(data []byte) []byte {
+	if f.isNoop() {
+		return data
+	}
+
+	var out []byte
+	for _, line := range bytes.SplitAfter(data, []byte("\n")) {
+		if len(line) > 0 && f.matchLine(line) {
+			out = append(out, line...)
+		}
+	}
+	return out
+}
+
+func (f *logFilter) matchLine(line []byte) bool {
+	for _, re := range f.Exclude {
+		if re.Match(line) {
+			return false
+		}
+	}
+
+	if len(f.Include) > 0 {
+		matched := false
+		for _, re := range f.Include {
+			if re.Match(line) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	if len(f.Levels) > 0 {
+		// lines that are not JSON (or have no level) are dropped when
+		// filtering by level.
+		return f.Levels[lineLevel(line)]
+	}
+	return true
+}
+
+// lineLevel returns the upper-cased level of a JSON formatted log line. Any
+// prefix before the JSON object (e.g., timestamps added by kubernetes) is
+// ignored. Returns empty string if the level cannot be determined.
+func lineLevel(line []byte) string {
+	start := bytes.IndexByte(line, '{')
+	if start < 0 {
+		return ""
+	}
+
+	var entry map[string]any
+	if err := json.Unmarshal(bytes.TrimSpace(line[start:]), &entry); err != nil {
+		return ""
+	}
+
+	for _, key := range levelKeys {
+		if level, ok := entry[key].(string); ok {
+			return strings.ToUpper(strings.TrimSpace(level))
+		}
+	}
+	return ""
+}
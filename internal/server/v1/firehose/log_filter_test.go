@@ -0,0 +1,77 @@
+package firehose
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/odpf/dex/pkg/errors"
+)
+
+func TestLogFilter(t *testing.T) {
+	t.Parallel()
+
+	data := []byte(`plain line with Exception
+{"level":"info","msg":"started"}
+2022-10-10T10:10:10Z {"level":"error","msg":"health check failed"}
+{"severity":"WARN","msg":"slow sink"}
+`)
+
+	table := []struct {
+		title string
+		query string
+		want  string
+		err   error
+	}{
+		{
+			title: "NoFilters",
+			query: "",
+			want:  string(data),
+		},
+		{
+			title: "Grep",
+			query: "grep=Exception&grep=slow",
+			want:  "plain line with Exception\n" + `{"severity":"WARN","msg":"slow sink"}` + "\n",
+		},
+		{
+			title: "Exclude",
+			query: "exclude=health&exclude=^plain",
+			want:  `{"level":"info","msg":"started"}` + "\n" + `{"severity":"WARN","msg":"slow sink"}` + "\n",
+		},
+		{
+			title: "Levels",
+			query: "level=error,warn",
+			want:  `2022-10-10T10:10:10Z {"level":"error","msg":"health check failed"}` + "\n" + `{"severity":"WARN","msg":"slow sink"}` + "\n",
+		},
+		{
+			title: "InvalidRegex",
+			query: "grep=(",
+			err:   errors.ErrInvalid,
+		},
+		{
+			title: "InvalidMaxBytes",
+			query: "max_bytes=0",
+			err:   errors.ErrInvalid,
+		},
+	}
+
+	for _, tt := range table {
+		tt := tt
+		t.Run(tt.title, func(t *testing.T) {
+			t.Parallel()
+
+			query, err := url.ParseQuery(tt.query)
+			require.NoError(t, err)
+
+			lf, err := parseLogFilter(query)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, string(lf.apply(data)))
+		})
+	}
+}
@@ -1,9 +1,13 @@
 package firehose
 
 import (
+	"compress/gzip"
+	"fmt"
 	"io"
 	"log"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/go-chi/chi/v5"
 	entropyv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/entropy/v1beta1"
@@ -11,6 +15,7 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 
+	"github.com/odpf/dex/internal/server/mask"
 	"github.com/odpf/dex/internal/server/utils"
 	"github.com/odpf/dex/pkg/errors"
 )
@@ -30,12 +35,38 @@ func (api *firehoseAPI) handleStreamLog(w http.ResponseWriter, r *http.Request)
 	urn := chi.URLParam(r, pathParamURN)
 	query := r.URL.Query()
 
+	lf, err := parseLogFilter(query)
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+	download, _ := strconv.ParseBool(query.Get("download"))
+
 	filters := map[string]string{}
 	for _, filterKey := range firehoseLogFilterKeys {
 		if query.Has(filterKey) {
 			filters[filterKey] = query.Get(filterKey)
 		}
 	}
+	if download {
+		// a download must terminate. so the logs are never followed.
+		filters["follow"] = "false"
+	}
+
+	res, err := api.getFirehoseResource(r.Context(), chi.URLParam(r, pathParamProject), urn)
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	var maskValues []string
+	if !isReveal(r) {
+		maskValues, err = api.sensitiveLogValues(res)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+	}
 
 	rpcReq := &entropyv1beta1.GetLogRequest{
 		Urn:    urn,
@@ -53,18 +84,50 @@ func (api *firehoseAPI) handleStreamLog(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/x-ndjson")
-	w.Header().Set("Transfer-Encoding", "chunked")
-
+	var gz *gzip.Writer
 	statusSent := false
+	startResponse := func() {
+		if statusSent {
+			return
+		}
+		statusSent = true
+
+		if download {
+			w.Header().Set("Content-Type", "application/gzip")
+			w.Header().Set("Content-Disposition",
+				fmt.Sprintf("attachment; filename=%q", logFileName(urn)))
+			gz = gzip.NewWriter(w)
+		} else {
+			w.Header().Set("Content-Type", "application/x-ndjson")
+			w.Header().Set("Transfer-Encoding", "chunked")
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+	defer func() {
+		if gz != nil {
+			if err := gz.Close(); err != nil {
+				log.Printf("error: failed to close gzip stream: %v", err)
+			}
+			flusher.Flush()
+		}
+	}()
+
+	var written int64
 	for {
 		getLogRes, err := logClient.Recv()
 		if err != nil {
 			if errors.Is(err, io.EOF) {
+				startResponse()
 				flusher.Flush()
 				return
 			}
 
+			if statusSent && download {
+				// cannot report errors within the gzipped file.
+				log.Printf("error: failed to read logs for '%s': %v", urn, err)
+				return
+			}
+
 			st := status.Convert(err)
 			if st.Code() == codes.NotFound {
 				utils.WriteErr(w, errors.ErrNotFound)
@@ -74,20 +137,50 @@ func (api *firehoseAPI) handleStreamLog(w http.ResponseWriter, r *http.Request)
 			return
 		}
 
-		logChunk, err := protojson.Marshal(getLogRes.GetChunk())
-		if err != nil {
-			utils.WriteErr(w, err)
-			return
+		data := lf.apply(mask.Text(getLogRes.GetChunk().GetData(), maskValues))
+		if len(data) == 0 {
+			continue
 		}
 
-		if !statusSent {
-			w.WriteHeader(http.StatusOK)
-			statusSent = true
+		limitReached := false
+		if lf.MaxBytes > 0 && written+int64(len(data)) >= lf.MaxBytes {
+			data = data[:lf.MaxBytes-written]
+			limitReached = true
 		}
+		written += int64(len(data))
 
-		writeLine(w, logChunk)
+		startResponse()
+		if download {
+			if _, err := gz.Write(data); err != nil {
+				log.Printf("error: failed to write logs: %v", err)
+				return
+			}
+			_ = gz.Flush()
+		} else {
+			logChunk, err := protojson.Marshal(&entropyv1beta1.LogChunk{
+				Data:   data,
+				Labels: getLogRes.GetChunk().GetLabels(),
+			})
+			if err != nil {
+				utils.WriteErr(w, err)
+				return
+			}
+			writeLine(w, logChunk)
+		}
 		flusher.Flush()
+
+		if limitReached {
+			return
+		}
+	}
+}
+
+func logFileName(urn string) string {
+	name := urn
+	if idx := strings.LastIndex(urn, ":"); idx >= 0 {
+		name = urn[idx+1:]
 	}
+	return name + ".log.gz"
 }
 
 func writeLine(w http.ResponseWriter, b []byte) {
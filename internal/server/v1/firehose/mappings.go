@@ -1,7 +1,10 @@
 package firehose
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
+	"log"
 	"regexp"
 	"strings"
 	"time"
@@ -13,11 +16,25 @@ import (
 	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/odpf/dex/generated/models"
+	"github.com/odpf/dex/internal/server/secret"
 	"github.com/odpf/dex/internal/server/utils"
+	"github.com/odpf/dex/internal/server/v1/sink"
 	"github.com/odpf/dex/pkg/errors"
 )
 
-const kubeClusterDependencyKey = "kube_cluster"
+const (
+	moduleStateRunning = "RUNNING"
+	moduleStateStopped = "STOPPED"
+)
+
+const (
+	kubeClusterDependencyKey = "kube_cluster"
+	labelSecretRefs          = "secret_refs"
+
+	// labelUpdateReason records the reason for an update. It is not carried
+	// over to later revisions.
+	labelUpdateReason = "update_reason"
+)
 
 var nonAlphaNumPattern = regexp.MustCompile("[^a-zA-Z0-9]+")
 
@@ -29,6 +46,7 @@ type firehoseLabels struct {
 	CreatedByEmail string `mapstructure:"created_by_email"`
 	UpdatedBy      string `mapstructure:"updated_by"`
 	UpdatedByEmail string `mapstructure:"updated_by_email"`
+	SecretRefs     string `mapstructure:"secret_refs"`
 }
 
 type moduleConfig struct {
@@ -71,8 +89,10 @@ func sanitiseAndValidate(def *models.Firehose) error {
 	return nil
 }
 
-func mapFirehoseToResource(def models.Firehose, prj *shieldv1beta1.Project) (*entropyv1beta1.Resource, error) {
-	cfgStruct, err := makeConfigStruct(def.Configs, prj)
+func mapFirehoseToResource(ctx context.Context, def models.Firehose,
+	prj *shieldv1beta1.Project, secrets *secret.Resolver,
+) (*entropyv1beta1.Resource, error) {
+	cfgStruct, err := makeConfigStruct(ctx, def.Configs, nil, prj, secrets)
 	if err != nil {
 		return nil, err
 	}
@@ -92,13 +112,37 @@ func mapFirehoseToResource(def models.Firehose, prj *shieldv1beta1.Project) (*en
 	}, nil
 }
 
+// validateConfig validates the configs including the sink-specific env vars
+// and reports all the problems found at once. If the configs replace prev,
+// only the env vars that are changed are validated against the schema of
+// the sink.
+func validateConfig(cfg, prev *models.FirehoseConfig) error {
+	var problems []errors.FieldError
+	if prev == nil {
+		problems = sink.Validate(cfg)
+	} else {
+		problems = sink.ValidateUpdate(cfg, prev)
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+
+	msgs := make([]string, len(problems))
+	for i, p := range problems {
+		msgs[i] = fmt.Sprintf("%s %s", p.Field, p.Message)
+	}
+	return errors.ErrInvalid.
+		WithMsgf("configs are not valid: %s", strings.Join(msgs, "; ")).
+		WithDetails(problems...)
+}
+
 func makeLabelsMap(def models.Firehose) map[string]string {
 	var meta models.FirehoseMetadata
 	if def.Metadata != nil {
 		meta = *def.Metadata
 	}
 
-	return map[string]string{
+	labels := map[string]string{
 		"title":            def.Title,
 		"group":            def.Group.String(),
 		"description":      def.Description,
@@ -107,27 +151,41 @@ func makeLabelsMap(def models.Firehose) map[string]string {
 		"updated_by":       meta.UpdatedBy.String(),
 		"updated_by_email": meta.UpdatedByEmail.String(),
 	}
+	if def.Configs != nil {
+		setSecretRefsLabel(labels, def.Configs.EnvVars)
+	}
+	return labels
 }
 
-func makeConfigStruct(cfg *models.FirehoseConfig, prj *shieldv1beta1.Project) (*structpb.Value, error) {
-	switch {
-	case cfg.BootstrapServers == nil:
-		return nil, errors.ErrInvalid.WithMsgf("bootstrap_servers must be set")
-
-	case cfg.TopicName == nil:
-		return nil, errors.ErrInvalid.WithMsgf("topic_name must be set")
-
-	case cfg.ConsumerGroupID == nil:
-		return nil, errors.ErrInvalid.WithMsgf("consumer_group_id must be set")
+// setSecretRefsLabel records the secret references in the env vars as a
+// label so that the resolved values can be replaced with the references
+// when the firehose is read back.
+func setSecretRefsLabel(labels, envVars map[string]string) {
+	refs := secret.Refs(envVars)
+	if len(refs) == 0 {
+		delete(labels, labelSecretRefs)
+		return
+	}
 
-	case cfg.SinkType == nil:
-		return nil, errors.ErrInvalid.WithMsgf("sink_type must be set")
+	b, _ := json.Marshal(refs)
+	labels[labelSecretRefs] = string(b)
+}
 
-	case cfg.StreamName == nil:
-		return nil, errors.ErrInvalid.WithMsgf("stream_name must be set")
+func parseSecretRefsLabel(label string) map[string]string {
+	var refs map[string]string
+	if label != "" {
+		if err := json.Unmarshal([]byte(label), &refs); err != nil {
+			log.Printf("error: failed to parse secret references label: %v", err)
+		}
+	}
+	return refs
+}
 
-	case cfg.InputSchemaProtoClass == nil:
-		return nil, errors.ErrInvalid.WithMsgf("input_schema_proto_class must be set")
+func makeConfigStruct(ctx context.Context, cfg, prev *models.FirehoseConfig,
+	prj *shieldv1beta1.Project, secrets *secret.Resolver,
+) (*structpb.Value, error) {
+	if err := validateConfig(cfg, prev); err != nil {
+		return nil, err
 	}
 
 	var stopAt *time.Time
@@ -155,12 +213,22 @@ func makeConfigStruct(cfg *models.FirehoseConfig, prj *shieldv1beta1.Project) (*
 		}
 	}
 
+	if cfg.EnvVars == nil {
+		cfg.EnvVars = map[string]string{}
+	}
 	cfg.EnvVars["SINK_TYPE"] = string(*cfg.SinkType)
 	cfg.EnvVars["STREAM_NAME"] = *cfg.StreamName
 	cfg.EnvVars["INPUT_SCHEMA_PROTO_CLASS"] = *cfg.InputSchemaProtoClass
 
+	// only the module config gets the resolved secret values. cfg retains
+	// the references.
+	envVars, err := secrets.Resolve(ctx, prj.GetSlug(), cfg.EnvVars)
+	if err != nil {
+		return nil, err
+	}
+
 	return utils.GoValToProtoStruct(moduleConfig{
-		State:    "RUNNING",
+		State:    moduleStateRunning,
 		StopTime: stopAt,
 		Telegraf: telegrafConf,
 		Firehose: moduleConfigFirehoseDef{
@@ -168,7 +236,7 @@ func makeConfigStruct(cfg *models.FirehoseConfig, prj *shieldv1beta1.Project) (*
 			KafkaBrokerAddress: *cfg.BootstrapServers,
 			KafkaTopic:         *cfg.TopicName,
 			KafkaConsumerID:    *cfg.ConsumerGroupID,
-			EnvVariables:       cfg.EnvVars,
+			EnvVariables:       envVars,
 		},
 	})
 }
@@ -183,13 +251,6 @@ func mapResourceToFirehose(res *entropyv1beta1.Resource, onlyMeta bool) (*models
 		return nil, errors.ErrInternal.WithCausef(err.Error())
 	}
 
-	var kubeCluster string
-	for _, dep := range res.GetSpec().GetDependencies() {
-		if dep.GetKey() == kubeClusterDependencyKey {
-			kubeCluster = dep.GetValue()
-		}
-	}
-
 	firehoseDef := models.Firehose{
 		Urn:         res.GetUrn(),
 		Name:        res.GetName(),
@@ -198,7 +259,7 @@ func mapResourceToFirehose(res *entropyv1beta1.Resource, onlyMeta bool) (*models
 		CreatedAt:   strfmt.DateTime(res.GetCreatedAt().AsTime()),
 		UpdatedAt:   strfmt.DateTime(res.GetUpdatedAt().AsTime()),
 		Description: labels.Description,
-		KubeCluster: kubeCluster,
+		KubeCluster: getKubeCluster(res),
 		Metadata: &models.FirehoseMetadata{
 			CreatedBy:      strfmt.UUID(labels.CreatedBy),
 			CreatedByEmail: strfmt.Email(labels.CreatedByEmail),
@@ -217,14 +278,21 @@ func mapResourceToFirehose(res *entropyv1beta1.Resource, onlyMeta bool) (*models
 		streamName := modConf.Firehose.EnvVariables["STREAM_NAME"]
 		protoClass := modConf.Firehose.EnvVariables["INPUT_SCHEMA_PROTO_CLASS"]
 
+		var stopDate string
+		if modConf.StopTime != nil {
+			stopDate = modConf.StopTime.Format(time.RFC3339)
+		}
+		replicas := float64(modConf.Firehose.Replicas)
+		secret.Redact(modConf.Firehose.EnvVariables, parseSecretRefsLabel(labels.SecretRefs))
+
 		firehoseDef.Configs = &models.FirehoseConfig{
 			BootstrapServers:      &modConf.Firehose.KafkaBrokerAddress,
 			ConsumerGroupID:       &modConf.Firehose.KafkaConsumerID,
 			EnvVars:               modConf.Firehose.EnvVariables,
 			InputSchemaProtoClass: &protoClass,
-			Replicas:              nil,
+			Replicas:              &replicas,
 			SinkType:              &sinkType,
-			StopDate:              modConf.StopTime.String(),
+			StopDate:              stopDate,
 			StreamName:            &streamName,
 			TopicName:             &modConf.Firehose.KafkaTopic,
 		}
@@ -239,6 +307,25 @@ func mapResourceToFirehose(res *entropyv1beta1.Resource, onlyMeta bool) (*models
 	return &firehoseDef, nil
 }
 
+func firehosePageKey(def models.Firehose) utils.PageKey {
+	return utils.PageKey{
+		ID:        def.Urn,
+		Name:      def.Name,
+		CreatedAt: time.Time(def.CreatedAt),
+		UpdatedAt: time.Time(def.UpdatedAt),
+	}
+}
+
+func getKubeCluster(res *entropyv1beta1.Resource) string {
+	var kubeCluster string
+	for _, dep := range res.GetSpec().GetDependencies() {
+		if dep.GetKey() == kubeClusterDependencyKey {
+			kubeCluster = dep.GetValue()
+		}
+	}
+	return kubeCluster
+}
+
 func slugify(s string) string {
 	s = strings.ToLower(s)
 	s = nonAlphaNumPattern.ReplaceAllString(s, "-")
@@ -0,0 +1,81 @@
+package firehose
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	entropyv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/entropy/v1beta1"
+
+	"github.com/odpf/dex/generated/models"
+	"github.com/odpf/dex/internal/server/utils"
+)
+
+// isReveal returns true if the caller asked for the sensitive values. The
+// authorizer allows this only for the callers with manage permission.
+func isReveal(r *http.Request) bool {
+	reveal, _ := strconv.ParseBool(r.URL.Query().Get("reveal"))
+	return reveal
+}
+
+// maskFirehoses masks the sensitive env vars of the firehoses unless the
+// caller asked to reveal them.
+func (api *firehoseAPI) maskFirehoses(r *http.Request, defs ...*models.Firehose) {
+	if isReveal(r) {
+		return
+	}
+
+	for _, def := range defs {
+		if def != nil && def.Configs != nil {
+			api.Masker.EnvVars(def.Configs.EnvVars)
+		}
+	}
+}
+
+// sensitiveLogValues returns the actual values of the sensitive env vars
+// (including the resolved secrets) of the firehose to be masked in its logs.
+func (api *firehoseAPI) sensitiveLogValues(res *entropyv1beta1.Resource) ([]string, error) {
+	var modConf moduleConfig
+	if err := utils.ProtoStructToGoVal(res.GetSpec().GetConfigs(), &modConf); err != nil {
+		return nil, err
+	}
+
+	var secretKeys []string
+	for k := range parseSecretRefsLabel(res.GetLabels()[labelSecretRefs]) {
+		secretKeys = append(secretKeys, k)
+	}
+	return api.Masker.Values(modConf.Firehose.EnvVariables, secretKeys...), nil
+}
+
+// transformSpecEnvVars applies fn to the env vars of the firehose module
+// config in the JSON spec of a revision.
+func transformSpecEnvVars(spec []byte, fn func(envVars map[string]string)) []byte {
+	var specMap map[string]any
+	if err := json.Unmarshal(spec, &specMap); err != nil {
+		return spec
+	}
+
+	configs, _ := specMap["configs"].(map[string]any)
+	firehoseConf, _ := configs["firehose"].(map[string]any)
+	rawEnvVars, _ := firehoseConf["env_variables"].(map[string]any)
+	if len(rawEnvVars) == 0 {
+		return spec
+	}
+
+	envVars := make(map[string]string, len(rawEnvVars))
+	for k, v := range rawEnvVars {
+		if s, ok := v.(string); ok {
+			envVars[k] = s
+		}
+	}
+	fn(envVars)
+	for k, v := range envVars {
+		rawEnvVars[k] = v
+	}
+
+	transformed, err := json.Marshal(specMap)
+	if err != nil {
+		return spec
+	}
+	return transformed
+}
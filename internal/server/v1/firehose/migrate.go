@@ -0,0 +1,813 @@
+package firehose
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	entropyv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/entropy/v1beta1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/odpf/dex/internal/server/kafka"
+	"github.com/odpf/dex/internal/server/operation"
+	"github.com/odpf/dex/internal/server/reqctx"
+	"github.com/odpf/dex/internal/server/secret"
+	"github.com/odpf/dex/internal/server/utils"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+const (
+	migrationRunning    = "RUNNING"
+	migrationSucceeded  = "SUCCEEDED"
+	migrationRolledBack = "ROLLED_BACK"
+	migrationFailed     = "FAILED"
+
+	stepPending   = "PENDING"
+	stepRunning   = "RUNNING"
+	stepSucceeded = "SUCCEEDED"
+	stepFailed    = "FAILED"
+	stepSkipped   = "SKIPPED"
+
+	stepStop          = "stop"
+	stepRecordOffsets = "record_offsets"
+	stepDelete        = "delete"
+	stepCreate        = "create"
+	stepStart         = "start"
+	stepRollback      = "rollback"
+
+	migrationPollInterval = 5 * time.Second
+	migrationStepTimeout  = 10 * time.Minute
+	migrationHeartbeat    = 30 * time.Second
+	migrationStaleAfter   = 2 * time.Minute
+)
+
+var errMigrationInterrupted = errors.New("migration was interrupted (server stopped)")
+
+var migrationSteps = []string{stepStop, stepRecordOffsets, stepDelete, stepCreate, stepStart}
+
+// offsetsReader reads the offsets committed by the consumer group of a
+// firehose and its lag.
+type offsetsReader interface {
+	CommittedOffsets(ctx context.Context, brokers []string, group, topic string) (kafka.Offsets, error)
+	ConsumerLag(ctx context.Context, brokers []string, group, topic string) ([]kafka.PartitionLag, error)
+}
+
+// migration moves a firehose to another kubernetes cluster. The firehose is
+// stopped, deleted and recreated on the target cluster with the same configs
+// (and hence the same consumer group) so that it resumes from the committed
+// offsets. The ID of a migration is the ID of its operation.
+type migration struct {
+	ID          string          `json:"id"`
+	URN         string          `json:"urn"`
+	FromCluster string          `json:"from_cluster"`
+	ToCluster   string          `json:"to_cluster"`
+	Status      string          `json:"status"`
+	Error       string          `json:"error,omitempty"`
+	Offsets     kafka.Offsets   `json:"offsets,omitempty"`
+	Steps       []migrationStep `json:"steps"`
+	CreatedBy   string          `json:"created_by,omitempty"`
+	StartedAt   time.Time       `json:"started_at"`
+	FinishedAt  *time.Time      `json:"finished_at,omitempty"`
+}
+
+type migrationStep struct {
+	Name       string     `json:"name"`
+	Status     string     `json:"status"`
+	Error      string     `json:"error,omitempty"`
+	StartedAt  *time.Time `json:"started_at,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+}
+
+// migrationCheckpoint is the state of a migration persisted as the
+// checkpoint of its operation. Source is the firehose as it was before the
+// migration and is used to restore it if the migration is interrupted. The
+// secret references are kept in its configs in place of the resolved values
+// (see resolveSourceSecrets). It is dropped once the migration is finished
+// since its configs can still contain sensitive env vars.
+type migrationCheckpoint struct {
+	Migration migration        `json:"migration"`
+	Source    *migrationSource `json:"source,omitempty"`
+}
+
+type migrationSource struct {
+	URN          string                `json:"urn"`
+	Kind         string                `json:"kind"`
+	Name         string                `json:"name"`
+	Project      string                `json:"project"`
+	Labels       map[string]string     `json:"labels,omitempty"`
+	Configs      any                   `json:"configs"`
+	Dependencies []migrationDependency `json:"dependencies,omitempty"`
+}
+
+type migrationDependency struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+func newMigrationSource(res *entropyv1beta1.Resource) *migrationSource {
+	configs, _ := proto.Clone(res.GetSpec().GetConfigs()).(*structpb.Value)
+	if envVars := moduleEnvVariables(configs); envVars != nil {
+		for k, ref := range parseSecretRefsLabel(res.GetLabels()[labelSecretRefs]) {
+			if _, found := envVars.GetFields()[k]; found {
+				envVars.Fields[k] = structpb.NewStringValue(ref)
+			}
+		}
+	}
+
+	src := &migrationSource{
+		URN:     res.GetUrn(),
+		Kind:    res.GetKind(),
+		Name:    res.GetName(),
+		Project: res.GetProject(),
+		Labels:  res.GetLabels(),
+		Configs: configs.AsInterface(),
+	}
+	for _, dep := range res.GetSpec().GetDependencies() {
+		src.Dependencies = append(src.Dependencies, migrationDependency{Key: dep.GetKey(), Value: dep.GetValue()})
+	}
+	return src
+}
+
+func (src *migrationSource) resource() (*entropyv1beta1.Resource, error) {
+	configs, err := structpb.NewValue(src.Configs)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &entropyv1beta1.Resource{
+		Urn:     src.URN,
+		Kind:    src.Kind,
+		Name:    src.Name,
+		Project: src.Project,
+		Labels:  src.Labels,
+		Spec:    &entropyv1beta1.ResourceSpec{Configs: configs},
+	}
+	for _, dep := range src.Dependencies {
+		res.Spec.Dependencies = append(res.Spec.Dependencies,
+			&entropyv1beta1.ResourceDependency{Key: dep.Key, Value: dep.Value})
+	}
+	return res, nil
+}
+
+// resolveSourceSecrets replaces the secret references in the configs of
+// the firehose restored from a migration source with their values.
+func (api *firehoseAPI) resolveSourceSecrets(ctx context.Context, res *entropyv1beta1.Resource) error {
+	envVars := moduleEnvVariables(res.GetSpec().GetConfigs())
+	if envVars == nil {
+		return nil
+	}
+
+	refs := map[string]string{}
+	for k := range parseSecretRefsLabel(res.GetLabels()[labelSecretRefs]) {
+		if v := envVars.GetFields()[k].GetStringValue(); secret.IsRef(v) {
+			refs[k] = v
+		}
+	}
+
+	resolved, err := api.Secrets.Resolve(ctx, res.GetProject(), refs)
+	if err != nil {
+		return err
+	}
+	for k, v := range resolved {
+		envVars.Fields[k] = structpb.NewStringValue(v)
+	}
+	return nil
+}
+
+// moduleEnvVariables returns the env vars in the module configs, if any.
+func moduleEnvVariables(configs *structpb.Value) *structpb.Struct {
+	return configs.GetStructValue().GetFields()["firehose"].GetStructValue().GetFields()["env_variables"].GetStructValue()
+}
+
+// migrationTracker runs the migrations and persists their progress in the
+// checkpoints of their operations. Hence the migrations can be read on any
+// replica and the ones left running by a stopped server are rolled back by
+// another (see recoverMigrations). At most one migration of a firehose can
+// be running at a time.
+type migrationTracker struct {
+	ops          *operation.Service
+	pollInterval time.Duration
+	stepTimeout  time.Duration
+
+	// heartbeat is the interval at which a running migration is saved even
+	// if it made no progress. Migrations not saved within staleAfter are
+	// considered to be interrupted.
+	heartbeat  time.Duration
+	staleAfter time.Duration
+}
+
+func newMigrationTracker(ops *operation.Service, pollInterval, stepTimeout time.Duration) *migrationTracker {
+	return &migrationTracker{
+		ops:          ops,
+		pollInterval: pollInterval,
+		stepTimeout:  stepTimeout,
+		heartbeat:    migrationHeartbeat,
+		staleAfter:   migrationStaleAfter,
+	}
+}
+
+// start records the migration of the firehose to the target cluster on
+// behalf of the user in ctx.
+func (mt *migrationTracker) start(ctx context.Context, prjSlug string, res *entropyv1beta1.Resource, target string) (*migrationRun, error) {
+	if mt.ops == nil {
+		return nil, errors.ErrInternal.WithMsgf("operations must be tracked to migrate firehoses")
+	}
+
+	urn := res.GetUrn()
+	running, err := mt.ops.List(ctx, operation.Filter{URN: urn, Action: actionMigrate, Status: operation.StatusRunning, Limit: 1})
+	if err != nil {
+		return nil, err
+	} else if len(running) > 0 {
+		return nil, errors.ErrConflict.WithMsgf("migration '%s' of the firehose is in progress", running[0].ID)
+	}
+
+	reqCtx := reqctx.From(ctx)
+	cp := migrationCheckpoint{
+		Migration: migration{
+			URN:         urn,
+			FromCluster: getKubeCluster(res),
+			ToCluster:   target,
+			Status:      migrationRunning,
+			CreatedBy:   reqCtx.UserEmail,
+			StartedAt:   time.Now(),
+		},
+		Source: newMigrationSource(res),
+	}
+	for _, name := range migrationSteps {
+		cp.Migration.Steps = append(cp.Migration.Steps, migrationStep{Name: name, Status: stepPending})
+	}
+
+	checkpoint, err := json.Marshal(cp)
+	if err != nil {
+		return nil, err
+	}
+	params, _ := json.Marshal(map[string]string{"kube_cluster": target})
+
+	op, err := mt.ops.Create(ctx, operation.Operation{
+		Project:    prjSlug,
+		URN:        urn,
+		Action:     actionMigrate,
+		Params:     params,
+		Actor:      reqCtx.UserEmail,
+		ActorID:    reqCtx.UserID,
+		RequestID:  reqCtx.RequestID,
+		Checkpoint: checkpoint,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &migrationRun{ops: mt.ops, op: op, cp: cp}, nil
+}
+
+func (mt *migrationTracker) get(ctx context.Context, prjSlug, urn, id string) (*migration, error) {
+	op, err := mt.ops.Get(ctx, id)
+	if err != nil && !errors.Is(err, errors.ErrNotFound) {
+		return nil, err
+	} else if err != nil || op.Project != prjSlug || op.URN != urn || op.Action != actionMigrate {
+		return nil, errors.ErrNotFound.WithMsgf("no migration with id '%s'", id)
+	}
+
+	cp, err := decodeMigration(op)
+	if err != nil {
+		return nil, err
+	}
+	return &cp.Migration, nil
+}
+
+// list returns the migrations of the firehose, latest first.
+func (mt *migrationTracker) list(ctx context.Context, prjSlug, urn string) ([]migration, error) {
+	ops, err := mt.ops.List(ctx, operation.Filter{Project: prjSlug, URN: urn, Action: actionMigrate})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]migration, 0, len(ops))
+	for i := range ops {
+		cp, err := decodeMigration(&ops[i])
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, cp.Migration)
+	}
+	return res, nil
+}
+
+func decodeMigration(op *operation.Operation) (*migrationCheckpoint, error) {
+	var cp migrationCheckpoint
+	if err := json.Unmarshal(op.Checkpoint, &cp); err != nil {
+		return nil, errors.ErrInternal.WithCausef("invalid checkpoint of migration '%s': %v", op.ID, err)
+	}
+	cp.Migration.ID = op.ID
+	return &cp, nil
+}
+
+// migrationRun is a migration run by this server. Every change is saved to
+// the checkpoint of its operation.
+type migrationRun struct {
+	mu  sync.Mutex
+	ops *operation.Service
+	op  *operation.Operation
+	cp  migrationCheckpoint
+}
+
+// migration returns a copy of the current state of the migration.
+func (run *migrationRun) migration() *migration {
+	run.mu.Lock()
+	defer run.mu.Unlock()
+
+	m := run.cp.Migration
+	m.ID = run.op.ID
+	m.Steps = append([]migrationStep(nil), m.Steps...)
+	return &m
+}
+
+func (run *migrationRun) update(fn func(m *migration)) {
+	run.mu.Lock()
+	defer run.mu.Unlock()
+
+	fn(&run.cp.Migration)
+	run.save()
+}
+
+// keepAlive saves the migration at the given interval until the returned
+// func is called, so that it is not considered to be interrupted while a
+// step is in progress.
+func (run *migrationRun) keepAlive(interval time.Duration) func() {
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+
+			case <-ticker.C:
+				run.update(func(m *migration) {})
+			}
+		}
+	}()
+	return func() { close(done) }
+}
+
+// save must be called with the lock held.
+func (run *migrationRun) save() {
+	m := &run.cp.Migration
+	if m.Status != migrationRunning {
+		run.cp.Source = nil
+	}
+
+	checkpoint, err := json.Marshal(run.cp)
+	if err != nil {
+		log.Printf("error: failed to save migration '%s': %v", run.op.ID, err)
+		return
+	}
+	run.op.Checkpoint = checkpoint
+
+	obs := operation.Observation{Status: m.Status}
+	for _, step := range m.Steps {
+		if step.Status == stepRunning || step.Status == stepFailed {
+			obs.State = step.Name
+		}
+	}
+	if m.Status != migrationRunning {
+		obs.Done = true
+		if m.Status != migrationSucceeded {
+			obs.Err = errors.New(m.Error)
+		}
+	}
+	run.ops.Progress(run.op, obs)
+}
+
+// runStep runs the step and records its progress. The step is added to the
+// migration if it is not one of the planned steps (e.g., rollback).
+func (run *migrationRun) runStep(name string, skip bool, fn func() error) error {
+	if skip {
+		run.setStep(name, func(s *migrationStep) { s.Status = stepSkipped })
+		return nil
+	}
+
+	run.setStep(name, func(s *migrationStep) {
+		now := time.Now()
+		s.StartedAt = &now
+		s.Status = stepRunning
+	})
+
+	err := fn()
+	run.setStep(name, func(s *migrationStep) {
+		now := time.Now()
+		s.FinishedAt = &now
+		s.Status = stepSucceeded
+		if err != nil {
+			s.Status = stepFailed
+			s.Error = err.Error()
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	return nil
+}
+
+func (run *migrationRun) setStep(name string, fn func(s *migrationStep)) {
+	run.update(func(m *migration) {
+		for i := range m.Steps {
+			if m.Steps[i].Name == name {
+				fn(&m.Steps[i])
+				return
+			}
+		}
+		m.Steps = append(m.Steps, migrationStep{Name: name, Status: stepPending})
+		fn(&m.Steps[len(m.Steps)-1])
+	})
+}
+
+func (run *migrationRun) finish(err, rollbackErr error) {
+	run.update(func(m *migration) {
+		now := time.Now()
+		m.FinishedAt = &now
+		m.Status = migrationSucceeded
+		if err != nil {
+			m.Error = err.Error()
+			m.Status = migrationRolledBack
+			if rollbackErr != nil {
+				m.Status = migrationFailed
+				m.Error = fmt.Sprintf("%s (rollback failed: %s)", err, rollbackErr)
+			}
+		}
+
+		for i := range m.Steps {
+			if m.Steps[i].Status == stepPending {
+				m.Steps[i].Status = stepSkipped
+			}
+		}
+	})
+}
+
+func (api *firehoseAPI) handleMigrate(w http.ResponseWriter, r *http.Request) {
+	urn := chi.URLParam(r, pathParamURN)
+
+	var reqBody struct {
+		KubeCluster string `json:"kube_cluster"`
+	}
+	if err := utils.ReadJSON(r, &reqBody); err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	target := strings.TrimSpace(reqBody.KubeCluster)
+	if target == "" {
+		utils.WriteErr(w, errors.ErrInvalid.WithMsgf("kube_cluster must be set"))
+		return
+	}
+
+	res, err := api.getFirehoseResource(r.Context(), chi.URLParam(r, pathParamProject), urn)
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	from := getKubeCluster(res)
+	if from == target {
+		utils.WriteErr(w, errors.ErrInvalid.WithMsgf("firehose is already deployed to '%s'", target))
+		return
+	} else if res.GetState().GetStatus() == entropyv1beta1.ResourceState_STATUS_PENDING {
+		utils.WriteErr(w, errors.ErrConflict.WithMsgf("firehose has pending changes, retry once they are applied"))
+		return
+	}
+
+	// the firehose is stopped & deleted before it is created on the target.
+	if _, err := api.getKubeClusterResource(r.Context(), chi.URLParam(r, pathParamProject), target); err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	run, err := api.Migrations.start(r.Context(), chi.URLParam(r, pathParamProject), res, target)
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+	m := run.migration()
+
+	go api.runMigration(reqctx.Detach(r.Context()), run, res)
+
+	w.Header().Set(headerOperationID, m.ID)
+	utils.WriteJSON(w, http.StatusAccepted, m)
+}
+
+func (api *firehoseAPI) handleListMigrations(w http.ResponseWriter, r *http.Request) {
+	urn := chi.URLParam(r, pathParamURN)
+
+	migrations, err := api.Migrations.list(r.Context(), chi.URLParam(r, pathParamProject), urn)
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, utils.NewListResponse(migrations))
+}
+
+func (api *firehoseAPI) handleGetMigration(w http.ResponseWriter, r *http.Request) {
+	urn := chi.URLParam(r, pathParamURN)
+
+	m, err := api.Migrations.get(r.Context(), chi.URLParam(r, pathParamProject), urn, chi.URLParam(r, "migrationID"))
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, m)
+}
+
+// runMigration runs the steps of the migration. If any of the steps fails,
+// the firehose is restored on the original cluster in its original state.
+func (api *firehoseAPI) runMigration(ctx context.Context, run *migrationRun, orig *entropyv1beta1.Resource) {
+	defer run.keepAlive(api.Migrations.heartbeat)()
+
+	urn := orig.GetUrn()
+	target := run.migration().ToCluster
+	wasRunning := getModuleState(orig) != moduleStateStopped
+
+	steps := []struct {
+		name string
+		skip bool
+		fn   func() error
+	}{
+		{stepStop, !wasRunning, func() error { return api.applyAndWait(ctx, orig.GetProject(), urn, actionStop) }},
+		{stepRecordOffsets, false, func() error { return api.recordOffsets(ctx, run, orig) }},
+		{stepDelete, false, func() error { return api.deleteAndWait(ctx, urn) }},
+		{stepCreate, false, func() error { return api.createAndWait(ctx, urn, recreatedResource(ctx, orig, target, true)) }},
+		{stepStart, !wasRunning, func() error { return api.applyAndWait(ctx, orig.GetProject(), urn, actionStart) }},
+	}
+
+	var err error
+	for _, step := range steps {
+		if err = run.runStep(step.name, step.skip, step.fn); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		run.finish(nil, nil)
+		return
+	}
+	api.abortMigration(ctx, run, orig, err)
+}
+
+// abortMigration rolls back the migration that failed with err.
+func (api *firehoseAPI) abortMigration(ctx context.Context, run *migrationRun, orig *entropyv1beta1.Resource, err error) {
+	id, urn := run.op.ID, orig.GetUrn()
+	log.Printf("error: migration '%s' of '%s' failed: %v", id, urn, err)
+
+	wasRunning := getModuleState(orig) != moduleStateStopped
+	rollbackErr := run.runStep(stepRollback, false, func() error {
+		return api.rollbackMigration(ctx, orig, wasRunning)
+	})
+	if rollbackErr != nil {
+		log.Printf("error: rollback of migration '%s' of '%s' failed: %v", id, urn, rollbackErr)
+	}
+	run.finish(err, rollbackErr)
+}
+
+// recoverMigrations rolls back the migrations interrupted by a stopped
+// server (i.e., not saved within the stale period). The migrations are
+// checked on start and periodically after, since the ones interrupted by
+// the restart of this server become stale only after the start.
+func (api *firehoseAPI) recoverMigrations(ctx context.Context) {
+	ticker := time.NewTicker(api.Migrations.staleAfter)
+	defer ticker.Stop()
+
+	for {
+		api.recoverStaleMigrations(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-ticker.C:
+		}
+	}
+}
+
+func (api *firehoseAPI) recoverStaleMigrations(ctx context.Context) {
+	mt := api.Migrations
+	ops, err := mt.ops.List(ctx, operation.Filter{Action: actionMigrate, Status: operation.StatusRunning})
+	if err != nil {
+		log.Printf("error: failed to list running migrations: %v", err)
+		return
+	}
+
+	for i := range ops {
+		op := &ops[i]
+		if time.Since(op.UpdatedAt) < mt.staleAfter {
+			continue
+		}
+
+		// another replica may be recovering the same migration.
+		if claimed, err := mt.ops.Claim(ctx, op); err != nil {
+			log.Printf("error: failed to claim migration '%s': %v", op.ID, err)
+			continue
+		} else if !claimed {
+			continue
+		}
+
+		cp, err := decodeMigration(op)
+		if err == nil && cp.Source == nil {
+			err = errors.New("migration has no source to restore")
+		}
+		var orig *entropyv1beta1.Resource
+		if err == nil {
+			orig, err = cp.Source.resource()
+		}
+		if err == nil {
+			err = api.resolveSourceSecrets(ctx, orig)
+		}
+		if err != nil {
+			log.Printf("error: failed to recover migration '%s': %v", op.ID, err)
+			mt.ops.Progress(op, operation.Observation{Status: migrationFailed, Done: true, Err: err})
+			continue
+		}
+
+		run := &migrationRun{ops: mt.ops, op: op, cp: *cp}
+		run.update(func(m *migration) {
+			for i := range m.Steps {
+				if m.Steps[i].Status == stepRunning {
+					m.Steps[i].Status = stepFailed
+					m.Steps[i].Error = errMigrationInterrupted.Error()
+				}
+			}
+		})
+
+		log.Printf("info: rolling back migration '%s' of '%s' interrupted by a restart", op.ID, op.URN)
+		runCtx := reqctx.With(ctx, reqctx.ReqCtx{
+			UserID:    op.ActorID,
+			UserEmail: op.Actor,
+			RequestID: op.RequestID,
+		})
+		go func() {
+			defer run.keepAlive(mt.heartbeat)()
+			api.abortMigration(runCtx, run, orig, errMigrationInterrupted)
+		}()
+	}
+}
+
+// rollbackMigration restores the firehose on its original cluster with its
+// original state, irrespective of the step at which the migration failed.
+func (api *firehoseAPI) rollbackMigration(ctx context.Context, orig *entropyv1beta1.Resource, wasRunning bool) error {
+	urn := orig.GetUrn()
+
+	cur, err := api.fetchFirehoseResource(ctx, urn)
+	if err != nil && !errors.Is(err, errors.ErrNotFound) {
+		return err
+	}
+
+	if cur != nil && getKubeCluster(cur) != getKubeCluster(orig) {
+		if err := api.deleteAndWait(ctx, urn); err != nil {
+			return err
+		}
+		cur = nil
+	}
+
+	if cur == nil {
+		return api.createAndWait(ctx, urn, recreatedResource(ctx, orig, getKubeCluster(orig), false))
+	} else if wasRunning && getModuleState(cur) == moduleStateStopped {
+		return api.applyAndWait(ctx, orig.GetProject(), urn, actionStart)
+	}
+	return nil
+}
+
+func (api *firehoseAPI) recordOffsets(ctx context.Context, run *migrationRun, res *entropyv1beta1.Resource) error {
+	def, err := mapResourceToFirehose(res, false)
+	if err != nil {
+		return err
+	}
+
+	var brokers, group, topic string
+	if def.Configs.BootstrapServers != nil {
+		brokers = *def.Configs.BootstrapServers
+	}
+	if def.Configs.ConsumerGroupID != nil {
+		group = *def.Configs.ConsumerGroupID
+	}
+	if def.Configs.TopicName != nil {
+		topic = *def.Configs.TopicName
+	}
+
+	offsets, err := api.Kafka.CommittedOffsets(ctx, kafka.SplitBrokers(brokers), group, topic)
+	if err != nil {
+		return err
+	}
+
+	run.update(func(m *migration) { m.Offsets = offsets })
+	return nil
+}
+
+func (api *firehoseAPI) applyAndWait(ctx context.Context, prjSlug, urn, action string) error {
+	if _, err := api.executeAction(ctx, prjSlug, urn, action, struct{}{}, ""); err != nil {
+		return err
+	}
+	return api.waitForStatus(ctx, urn, false)
+}
+
+func (api *firehoseAPI) deleteAndWait(ctx context.Context, urn string) error {
+	_, err := api.Entropy.DeleteResource(ctx, &entropyv1beta1.DeleteResourceRequest{Urn: urn})
+	if err != nil && status.Code(err) != codes.NotFound {
+		return err
+	}
+	return api.waitForStatus(ctx, urn, true)
+}
+
+func (api *firehoseAPI) createAndWait(ctx context.Context, urn string, res *entropyv1beta1.Resource) error {
+	_, err := api.Entropy.CreateResource(ctx, &entropyv1beta1.CreateResourceRequest{Resource: res})
+	if err != nil {
+		return err
+	}
+	return api.waitForStatus(ctx, urn, false)
+}
+
+// waitForStatus polls the firehose until it is deleted (if deleted is set)
+// or until its changes are applied. Returns error if the firehose ends in
+// the error status or the step times out.
+func (api *firehoseAPI) waitForStatus(ctx context.Context, urn string, deleted bool) error {
+	ctx, cancel := context.WithTimeout(ctx, api.Migrations.stepTimeout)
+	defer cancel()
+
+	for {
+		res, err := api.fetchFirehoseResource(ctx, urn)
+		if err != nil {
+			if !errors.Is(err, errors.ErrNotFound) {
+				return err
+			} else if deleted {
+				return nil
+			}
+		} else if !deleted {
+			switch res.GetState().GetStatus() {
+			case entropyv1beta1.ResourceState_STATUS_COMPLETED:
+				return nil
+
+			case entropyv1beta1.ResourceState_STATUS_ERROR:
+				return errors.Errorf("firehose ended in %s status", res.GetState().GetStatus())
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Errorf("timed out waiting for firehose: %v", ctx.Err())
+
+		case <-time.After(api.Migrations.pollInterval):
+		}
+	}
+}
+
+// recreatedResource returns the resource to recreate the firehose with on
+// the given cluster. The configs (incl. the consumer group) are retained.
+func recreatedResource(ctx context.Context, orig *entropyv1beta1.Resource, kubeCluster string, stopped bool) *entropyv1beta1.Resource {
+	reqCtx := reqctx.From(ctx)
+
+	labels := map[string]string{}
+	for k, v := range orig.GetLabels() {
+		labels[k] = v
+	}
+	delete(labels, labelUpdateReason)
+	labels["updated_by"] = reqCtx.UserID
+	labels["updated_by_email"] = reqCtx.UserEmail
+
+	configs, _ := proto.Clone(orig.GetSpec().GetConfigs()).(*structpb.Value)
+	if s := configs.GetStructValue(); s != nil && stopped {
+		s.Fields["state"] = structpb.NewStringValue(moduleStateStopped)
+	}
+
+	var deps []*entropyv1beta1.ResourceDependency
+	for _, dep := range orig.GetSpec().GetDependencies() {
+		if dep.GetKey() != kubeClusterDependencyKey {
+			deps = append(deps, dep)
+		}
+	}
+	deps = append(deps, &entropyv1beta1.ResourceDependency{Key: kubeClusterDependencyKey, Value: kubeCluster})
+
+	return &entropyv1beta1.Resource{
+		Kind:    orig.GetKind(),
+		Name:    orig.GetName(),
+		Project: orig.GetProject(),
+		Labels:  labels,
+		Spec: &entropyv1beta1.ResourceSpec{
+			Configs:      configs,
+			Dependencies: deps,
+		},
+	}
+}
+
+func getModuleState(res *entropyv1beta1.Resource) string {
+	state := res.GetSpec().GetConfigs().GetStructValue().GetFields()["state"].GetStringValue()
+	if state == "" {
+		return moduleStateRunning
+	}
+	return state
+}
@@ -0,0 +1,420 @@
+package firehose
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	entropyv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/entropy/v1beta1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/odpf/dex/internal/server/kafka"
+	"github.com/odpf/dex/internal/server/operation"
+	"github.com/odpf/dex/internal/server/secret"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+type fakeEntropy struct {
+	entropyv1beta1.ResourceServiceClient
+
+	mu         sync.Mutex
+	resources  map[string]*entropyv1beta1.Resource
+	badCluster string
+}
+
+func (fe *fakeEntropy) GetResource(_ context.Context, req *entropyv1beta1.GetResourceRequest, _ ...grpc.CallOption) (*entropyv1beta1.GetResourceResponse, error) {
+	fe.mu.Lock()
+	defer fe.mu.Unlock()
+
+	res, found := fe.resources[req.GetUrn()]
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	return &entropyv1beta1.GetResourceResponse{Resource: copyResource(res)}, nil
+}
+
+func (fe *fakeEntropy) CreateResource(_ context.Context, req *entropyv1beta1.CreateResourceRequest, _ ...grpc.CallOption) (*entropyv1beta1.CreateResourceResponse, error) {
+	fe.mu.Lock()
+	defer fe.mu.Unlock()
+
+	res := copyResource(req.GetResource())
+	res.Urn = "orn:entropy:firehose:" + res.GetProject() + ":" + res.GetName()
+	if _, found := fe.resources[res.Urn]; found {
+		return nil, status.Error(codes.AlreadyExists, "already exists")
+	}
+
+	res.State = &entropyv1beta1.ResourceState{Status: entropyv1beta1.ResourceState_STATUS_COMPLETED}
+	if getKubeCluster(res) == fe.badCluster {
+		res.State.Status = entropyv1beta1.ResourceState_STATUS_ERROR
+	}
+	fe.resources[res.Urn] = res
+	return &entropyv1beta1.CreateResourceResponse{Resource: copyResource(res)}, nil
+}
+
+func (fe *fakeEntropy) UpdateResource(_ context.Context, req *entropyv1beta1.UpdateResourceRequest, _ ...grpc.CallOption) (*entropyv1beta1.UpdateResourceResponse, error) {
+	fe.mu.Lock()
+	defer fe.mu.Unlock()
+
+	res, found := fe.resources[req.GetUrn()]
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	res.Labels = req.GetLabels()
+	res.Spec.Configs = req.GetNewSpec().GetConfigs()
+	return &entropyv1beta1.UpdateResourceResponse{Resource: copyResource(res)}, nil
+}
+
+func (fe *fakeEntropy) DeleteResource(_ context.Context, req *entropyv1beta1.DeleteResourceRequest, _ ...grpc.CallOption) (*entropyv1beta1.DeleteResourceResponse, error) {
+	fe.mu.Lock()
+	defer fe.mu.Unlock()
+
+	delete(fe.resources, req.GetUrn())
+	return &entropyv1beta1.DeleteResourceResponse{}, nil
+}
+
+func (fe *fakeEntropy) ApplyAction(_ context.Context, req *entropyv1beta1.ApplyActionRequest, _ ...grpc.CallOption) (*entropyv1beta1.ApplyActionResponse, error) {
+	fe.mu.Lock()
+	defer fe.mu.Unlock()
+
+	res, found := fe.resources[req.GetUrn()]
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	state := moduleStateRunning
+	if req.GetAction() == actionStop {
+		state = moduleStateStopped
+	}
+	res.GetSpec().GetConfigs().GetStructValue().Fields["state"] = structpb.NewStringValue(state)
+	if req.GetLabels() != nil {
+		res.Labels = req.GetLabels()
+	}
+	return &entropyv1beta1.ApplyActionResponse{Resource: copyResource(res)}, nil
+}
+
+func (fe *fakeEntropy) GetResourceRevisions(_ context.Context, req *entropyv1beta1.GetResourceRevisionsRequest, _ ...grpc.CallOption) (*entropyv1beta1.GetResourceRevisionsResponse, error) {
+	fe.mu.Lock()
+	defer fe.mu.Unlock()
+
+	res, found := fe.resources[req.GetUrn()]
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	revision := &entropyv1beta1.ResourceRevision{Id: "1", Urn: res.GetUrn(), Labels: res.GetLabels(), Spec: res.GetSpec()}
+	return &entropyv1beta1.GetResourceRevisionsResponse{Revisions: []*entropyv1beta1.ResourceRevision{revision}}, nil
+}
+
+func copyResource(res *entropyv1beta1.Resource) *entropyv1beta1.Resource {
+	cp := *res
+	cp.Spec = &entropyv1beta1.ResourceSpec{
+		Configs:      proto.Clone(res.GetSpec().GetConfigs()).(*structpb.Value),
+		Dependencies: res.GetSpec().GetDependencies(),
+	}
+	return &cp
+}
+
+type fakeOffsets struct {
+	err error
+}
+
+func (fo fakeOffsets) CommittedOffsets(_ context.Context, brokers []string, group, topic string) (kafka.Offsets, error) {
+	if fo.err != nil {
+		return nil, fo.err
+	}
+	return kafka.Offsets{topic: {0: 42}}, nil
+}
+
+func (fo fakeOffsets) ConsumerLag(_ context.Context, brokers []string, group, topic string) ([]kafka.PartitionLag, error) {
+	if fo.err != nil {
+		return nil, fo.err
+	}
+	return []kafka.PartitionLag{
+		{Topic: topic, Partition: 0, CommittedOffset: 42, LogEndOffset: 100, Lag: 58},
+		{Topic: topic, Partition: 1, CommittedOffset: -1, LogEndOffset: 7, Lag: -1},
+	}, nil
+}
+
+// fakeSecrets returns the secrets keyed by '<project>/<name>/<key>'.
+type fakeSecrets map[string]string
+
+func (fs fakeSecrets) Get(_ context.Context, project, name, key string) (string, error) {
+	v, found := fs[project+"/"+name+"/"+key]
+	if !found {
+		return "", errors.ErrNotFound
+	}
+	return v, nil
+}
+
+func TestRunMigration(t *testing.T) {
+	t.Parallel()
+
+	const urn = "orn:entropy:firehose:foo:bar"
+
+	setup := func(t *testing.T, badCluster string, offsetsErr error) (*firehoseAPI, *fakeEntropy, *entropyv1beta1.Resource) {
+		t.Helper()
+
+		configs, err := structpb.NewValue(map[string]any{
+			"state": moduleStateRunning,
+			"firehose": map[string]any{
+				"kafka_broker_address": "localhost:9092",
+				"kafka_topic":          "bookings",
+				"kafka_consumer_id":    "foo-bar-0001",
+				"env_variables": map[string]any{
+					"SINK_TYPE":          "LOG",
+					"SINK_JDBC_PASSWORD": "s3cret",
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		orig := &entropyv1beta1.Resource{
+			Urn:     urn,
+			Kind:    kindFirehose,
+			Name:    "bar",
+			Project: "foo",
+			Labels: map[string]string{
+				"title":         "Bar",
+				labelSecretRefs: `{"SINK_JDBC_PASSWORD":"secret://pg/password"}`,
+			},
+			State: &entropyv1beta1.ResourceState{Status: entropyv1beta1.ResourceState_STATUS_COMPLETED},
+			Spec: &entropyv1beta1.ResourceSpec{
+				Configs: configs,
+				Dependencies: []*entropyv1beta1.ResourceDependency{
+					{Key: kubeClusterDependencyKey, Value: "orn:entropy:kubernetes:foo:c1"},
+				},
+			},
+		}
+
+		entropy := &fakeEntropy{
+			resources:  map[string]*entropyv1beta1.Resource{urn: copyResource(orig)},
+			badCluster: badCluster,
+		}
+		ops := operation.NewWithStore(operation.NewMemoryStore(), time.Second, time.Minute)
+		t.Cleanup(func() { _ = ops.Close() })
+
+		api := &firehoseAPI{
+			Entropy:    entropy,
+			Kafka:      fakeOffsets{err: offsetsErr},
+			Secrets:    secret.NewResolver(fakeSecrets{"foo/pg/password": "s3cret"}),
+			Operations: ops,
+			Migrations: newMigrationTracker(ops, time.Millisecond, time.Second),
+		}
+		return api, entropy, orig
+	}
+
+	ctx := context.Background()
+
+	stepStatuses := func(m *migration) map[string]string {
+		res := map[string]string{}
+		for _, s := range m.Steps {
+			res[s.Name] = s.Status
+		}
+		return res
+	}
+
+	t.Run("Succeeded", func(t *testing.T) {
+		t.Parallel()
+
+		api, entropy, orig := setup(t, "", nil)
+		run, err := api.Migrations.start(ctx, "foo", orig, "orn:entropy:kubernetes:foo:c2")
+		require.NoError(t, err)
+
+		api.runMigration(ctx, run, orig)
+
+		m, err := api.Migrations.get(ctx, "foo", urn, run.op.ID)
+		require.NoError(t, err)
+		assert.Equal(t, migrationSucceeded, m.Status)
+		assert.Equal(t, kafka.Offsets{"bookings": {0: 42}}, m.Offsets)
+		assert.Equal(t, map[string]string{
+			stepStop:          stepSucceeded,
+			stepRecordOffsets: stepSucceeded,
+			stepDelete:        stepSucceeded,
+			stepCreate:        stepSucceeded,
+			stepStart:         stepSucceeded,
+		}, stepStatuses(m))
+
+		migrated := entropy.resources[urn]
+		assert.Equal(t, "orn:entropy:kubernetes:foo:c2", getKubeCluster(migrated))
+		assert.Equal(t, moduleStateRunning, getModuleState(migrated))
+		assert.Equal(t, "foo-bar-0001",
+			migrated.GetSpec().GetConfigs().GetStructValue().GetFields()["firehose"].GetStructValue().GetFields()["kafka_consumer_id"].GetStringValue())
+
+		op, err := api.Operations.Get(ctx, run.op.ID)
+		require.NoError(t, err)
+		assert.Equal(t, operation.StatusSucceeded, op.Status)
+		assert.NotContains(t, string(op.Checkpoint), "kafka_consumer_id", "source must be dropped once finished")
+
+		_, err = api.Migrations.start(ctx, "foo", migrated, "orn:entropy:kubernetes:foo:c1")
+		assert.NoError(t, err, "migration must be allowed once the previous one is finished")
+	})
+
+	t.Run("RolledBackOnFailedDeployment", func(t *testing.T) {
+		t.Parallel()
+
+		api, entropy, orig := setup(t, "orn:entropy:kubernetes:foo:bad", nil)
+		run, err := api.Migrations.start(ctx, "foo", orig, "orn:entropy:kubernetes:foo:bad")
+		require.NoError(t, err)
+
+		_, err = api.Migrations.start(ctx, "foo", orig, "orn:entropy:kubernetes:foo:c2")
+		assert.Error(t, err, "only one migration of a firehose can run at a time")
+
+		api.runMigration(ctx, run, orig)
+
+		m, err := api.Migrations.get(ctx, "foo", urn, run.op.ID)
+		require.NoError(t, err)
+		assert.Equal(t, migrationRolledBack, m.Status)
+		assert.Contains(t, m.Error, stepCreate)
+		assert.Equal(t, map[string]string{
+			stepStop:          stepSucceeded,
+			stepRecordOffsets: stepSucceeded,
+			stepDelete:        stepSucceeded,
+			stepCreate:        stepFailed,
+			stepStart:         stepSkipped,
+			stepRollback:      stepSucceeded,
+		}, stepStatuses(m))
+
+		restored := entropy.resources[urn]
+		assert.Equal(t, "orn:entropy:kubernetes:foo:c1", getKubeCluster(restored))
+		assert.Equal(t, moduleStateRunning, getModuleState(restored))
+	})
+
+	t.Run("RestartedWhenOffsetsUnavailable", func(t *testing.T) {
+		t.Parallel()
+
+		api, entropy, orig := setup(t, "", assert.AnError)
+		run, err := api.Migrations.start(ctx, "foo", orig, "orn:entropy:kubernetes:foo:c2")
+		require.NoError(t, err)
+
+		api.runMigration(ctx, run, orig)
+
+		m, err := api.Migrations.get(ctx, "foo", urn, run.op.ID)
+		require.NoError(t, err)
+		assert.Equal(t, migrationRolledBack, m.Status)
+		assert.Equal(t, stepSkipped, stepStatuses(m)[stepDelete])
+
+		restored := entropy.resources[urn]
+		assert.Equal(t, "orn:entropy:kubernetes:foo:c1", getKubeCluster(restored))
+		assert.Equal(t, moduleStateRunning, getModuleState(restored))
+	})
+	t.Run("RolledBackAfterRestart", func(t *testing.T) {
+		t.Parallel()
+
+		api, entropy, orig := setup(t, "", nil)
+		run, err := api.Migrations.start(ctx, "foo", orig, "orn:entropy:kubernetes:foo:c2")
+		require.NoError(t, err)
+
+		// the server stopped after deleting the firehose.
+		require.NoError(t, run.runStep(stepStop, false, func() error { return nil }))
+		require.NoError(t, run.runStep(stepRecordOffsets, false, func() error { return nil }))
+		run.setStep(stepDelete, func(s *migrationStep) { s.Status = stepRunning })
+		require.NoError(t, api.deleteAndWait(ctx, urn))
+
+		op, err := api.Operations.Get(ctx, run.op.ID)
+		require.NoError(t, err)
+		assert.Contains(t, string(op.Checkpoint), "secret://pg/password")
+		assert.NotContains(t, string(op.Checkpoint), "s3cret", "resolved secrets must not be persisted")
+
+		// another replica sharing the operations store.
+		replica := &firehoseAPI{
+			Entropy:    entropy,
+			Secrets:    api.Secrets,
+			Operations: api.Operations,
+			Migrations: newMigrationTracker(api.Operations, time.Millisecond, time.Second),
+		}
+		replica.Migrations.staleAfter = time.Hour
+		replica.recoverStaleMigrations(ctx)
+
+		m, err := replica.Migrations.get(ctx, "foo", urn, run.op.ID)
+		require.NoError(t, err)
+		assert.Equal(t, migrationRunning, m.Status, "migration saved recently must be left to its server")
+
+		_, err = replica.Migrations.get(ctx, "bar", urn, run.op.ID)
+		assert.ErrorIs(t, err, errors.ErrNotFound)
+
+		replica.Migrations.staleAfter = time.Millisecond
+		time.Sleep(2 * time.Millisecond)
+		replica.recoverStaleMigrations(ctx)
+
+		require.Eventually(t, func() bool {
+			m, err = replica.Migrations.get(ctx, "foo", urn, run.op.ID)
+			return err == nil && m.Status != migrationRunning
+		}, time.Second, time.Millisecond)
+
+		assert.Equal(t, migrationRolledBack, m.Status)
+		assert.Equal(t, map[string]string{
+			stepStop:          stepSucceeded,
+			stepRecordOffsets: stepSucceeded,
+			stepDelete:        stepFailed,
+			stepCreate:        stepSkipped,
+			stepStart:         stepSkipped,
+			stepRollback:      stepSucceeded,
+		}, stepStatuses(m))
+
+		restored := entropy.resources[urn]
+		require.NotNil(t, restored)
+		assert.Equal(t, "orn:entropy:kubernetes:foo:c1", getKubeCluster(restored))
+		assert.Equal(t, moduleStateRunning, getModuleState(restored))
+		assert.Equal(t, "Bar", restored.GetLabels()["title"])
+		assert.Equal(t, "s3cret", moduleEnvVariables(restored.GetSpec().GetConfigs()).GetFields()["SINK_JDBC_PASSWORD"].GetStringValue(),
+			"secrets must be resolved again when restored")
+	})
+}
+
+func TestMigrateUnknownCluster(t *testing.T) {
+	t.Parallel()
+
+	const urn = "orn:entropy:firehose:foo:bar"
+
+	configs, err := structpb.NewValue(map[string]any{
+		"state":    moduleStateRunning,
+		"firehose": map[string]any{"replicas": 1, "env_variables": map[string]any{"SINK_TYPE": "LOG"}},
+	})
+	require.NoError(t, err)
+
+	entropy := &fakeEntropy{resources: map[string]*entropyv1beta1.Resource{
+		urn: {
+			Urn:     urn,
+			Kind:    kindFirehose,
+			Name:    "bar",
+			Project: "foo",
+			State:   &entropyv1beta1.ResourceState{Status: entropyv1beta1.ResourceState_STATUS_COMPLETED},
+			Spec: &entropyv1beta1.ResourceSpec{
+				Configs: configs,
+				Dependencies: []*entropyv1beta1.ResourceDependency{
+					{Key: kubeClusterDependencyKey, Value: "orn:entropy:kubernetes:foo:c1"},
+				},
+			},
+		},
+		"orn:entropy:kubernetes:bar:c2": {Urn: "orn:entropy:kubernetes:bar:c2", Kind: kindKubernetes, Name: "c2", Project: "bar"},
+	}}
+
+	ops := operation.NewWithStore(operation.NewMemoryStore(), time.Second, time.Minute)
+	defer ops.Close()
+
+	router := chi.NewRouter()
+	router.Route("/projects/{projectSlug}/firehoses",
+		Routes(entropy, fakeShield{}, nil, nil, nil, nil, nil, nil, ops, nil, nil))
+
+	for _, target := range []string{"orn:entropy:kubernetes:foo:c2", "orn:entropy:kubernetes:bar:c2"} {
+		rec := httptest.NewRecorder()
+		body := strings.NewReader(`{"kube_cluster": "` + target + `"}`)
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/projects/foo/firehoses/"+urn+"/migrate", body))
+		assert.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+	}
+
+	list, err := ops.List(context.Background(), operation.Filter{URN: urn})
+	require.NoError(t, err)
+	assert.Empty(t, list, "migration must not be started")
+	assert.Equal(t, moduleStateRunning, getModuleState(entropy.resources[urn]))
+}
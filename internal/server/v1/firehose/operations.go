@@ -0,0 +1,161 @@
+package firehose
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	entropyv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/entropy/v1beta1"
+
+	"github.com/odpf/dex/internal/server/operation"
+	"github.com/odpf/dex/internal/server/reqctx"
+	"github.com/odpf/dex/internal/server/utils"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+const (
+	headerOperationID   = "X-Operation-Id"
+	headerMaskedChanges = "X-Masked-Changes"
+
+	defaultOperationsLimit = 20
+	maxOperationsLimit     = 100
+)
+
+// startOperation records the request as an operation that is tracked using
+// poll until it is complete. The id of the operation is returned in the
+// response header. Dry-run requests are not recorded.
+func (api *firehoseAPI) startOperation(w http.ResponseWriter, r *http.Request,
+	prjSlug, action, urn string, params any, poll operation.PollFunc,
+) {
+	if isDryRun(r) {
+		return
+	}
+
+	if id := api.recordOperation(r.Context(), prjSlug, action, urn, params, poll); id != "" {
+		w.Header().Set(headerOperationID, id)
+	}
+}
+
+// recordOperation records an operation on behalf of the user in ctx and
+// tracks it using poll until it is complete. Returns the id of the operation
+// or an empty string if it was not recorded.
+func (api *firehoseAPI) recordOperation(ctx context.Context,
+	prjSlug, action, urn string, params any, poll operation.PollFunc,
+) string {
+	if api.Operations == nil {
+		return ""
+	}
+
+	var rawParams json.RawMessage
+	if params != nil {
+		rawParams, _ = json.Marshal(params)
+	}
+
+	reqCtx := reqctx.From(ctx)
+	op, err := api.Operations.Start(ctx, operation.Operation{
+		Project:   prjSlug,
+		URN:       urn,
+		Action:    action,
+		Params:    rawParams,
+		Actor:     reqCtx.UserEmail,
+		ActorID:   reqCtx.UserID,
+		RequestID: reqCtx.RequestID,
+	}, poll)
+	if err != nil {
+		// the changes are already applied. so the request must not fail.
+		log.Printf("error: failed to record '%s' operation on '%s': %v", action, urn, err)
+		return ""
+	}
+	return op.ID
+}
+
+func (api *firehoseAPI) handleListOperations(w http.ResponseWriter, r *http.Request) {
+	urn := chi.URLParam(r, pathParamURN)
+
+	limit := defaultOperationsLimit
+	if s := strings.TrimSpace(r.URL.Query().Get("limit")); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil || n <= 0 {
+			utils.WriteErr(w, errors.ErrInvalid.WithMsgf("limit must be a positive integer"))
+			return
+		}
+		limit = n
+		if limit > maxOperationsLimit {
+			limit = maxOperationsLimit
+		}
+	}
+
+	ops, err := api.Operations.List(r.Context(), operation.Filter{
+		Project: chi.URLParam(r, pathParamProject),
+		URN:     urn,
+		Limit:   limit,
+	})
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+	if ops == nil {
+		ops = []operation.Operation{}
+	}
+	utils.WriteJSON(w, http.StatusOK, utils.NewListResponse(ops))
+}
+
+// pollFirehose returns a PollFunc that reports the operation as complete
+// once the changes to the firehose are applied.
+func (api *firehoseAPI) pollFirehose(urn string) operation.PollFunc {
+	return func(ctx context.Context) (operation.Observation, error) {
+		res, err := api.fetchFirehoseResource(ctx, urn)
+		if err != nil {
+			if errors.Is(err, errors.ErrNotFound) {
+				return operation.Observation{
+					Status: entropyv1beta1.ResourceState_STATUS_DELETED.String(),
+					Done:   true,
+					Err:    errors.New("firehose was deleted"),
+				}, nil
+			}
+			return operation.Observation{}, err
+		}
+
+		obs := operation.Observation{
+			Status: res.GetState().GetStatus().String(),
+			State:  getModuleState(res),
+		}
+		switch res.GetState().GetStatus() {
+		case entropyv1beta1.ResourceState_STATUS_COMPLETED:
+			obs.Done = true
+
+		case entropyv1beta1.ResourceState_STATUS_ERROR:
+			obs.Done = true
+			obs.Err = errors.Errorf("firehose ended in %s status", obs.Status)
+		}
+		return obs, nil
+	}
+}
+
+// pollDeletion returns a PollFunc that reports the operation as complete
+// once the firehose no longer exists.
+func (api *firehoseAPI) pollDeletion(urn string) operation.PollFunc {
+	return func(ctx context.Context) (operation.Observation, error) {
+		res, err := api.fetchFirehoseResource(ctx, urn)
+		if err != nil {
+			if errors.Is(err, errors.ErrNotFound) {
+				return operation.Observation{
+					Status: entropyv1beta1.ResourceState_STATUS_DELETED.String(),
+					Done:   true,
+				}, nil
+			}
+			return operation.Observation{}, err
+		}
+
+		obs := operation.Observation{Status: res.GetState().GetStatus().String()}
+		if res.GetState().GetStatus() == entropyv1beta1.ResourceState_STATUS_ERROR {
+			obs.Done = true
+			obs.Err = errors.Errorf("firehose ended in %s status", obs.Status)
+		}
+		return obs, nil
+	}
+}
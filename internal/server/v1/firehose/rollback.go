@@ -0,0 +1,196 @@
+package firehose
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	entropyv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/entropy/v1beta1"
+	"golang.org/x/exp/maps"
+
+	"github.com/odpf/dex/generated/models"
+	"github.com/odpf/dex/internal/server/utils"
+	"github.com/odpf/dex/pkg/diff"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+type rollbackRequest struct {
+	Revision  int       `json:"revision"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// rollbackView contains the fields of a firehose that are restored by a
+// rollback.
+type rollbackView struct {
+	Description string                 `json:"description,omitempty"`
+	Configs     *models.FirehoseConfig `json:"configs,omitempty"`
+}
+
+func (api *firehoseAPI) handleRollback(w http.ResponseWriter, r *http.Request) {
+	urn := chi.URLParam(r, pathParamURN)
+
+	var req rollbackRequest
+	if err := utils.ReadJSON(r, &req); err != nil {
+		utils.WriteErr(w, err)
+		return
+	} else if req.Revision < 0 || (req.Revision > 0) == !req.Timestamp.IsZero() {
+		utils.WriteErr(w, errors.ErrInvalid.WithMsgf("exactly one of revision or timestamp must be set"))
+		return
+	}
+
+	prj, err := api.getProject(r)
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	existing, err := api.getFirehose(r.Context(), chi.URLParam(r, pathParamProject), urn)
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	revisions, err := api.fetchRevisions(r.Context(), prj.GetSlug(), urn)
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	n, err := pickRevision(revisions, req)
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	} else if n == len(revisions) {
+		utils.WriteErr(w, errors.ErrInvalid.WithMsgf("revision %d is the current revision", n))
+		return
+	}
+
+	target, err := revisionFirehose(urn, revisions[n-1])
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	updates := firehoseUpdates{
+		Description: target.Description,
+		Configs:     *target.Configs,
+	}
+	cfgStruct, err := makeConfigStruct(r.Context(), &updates.Configs, existing.Configs, prj, api.Secrets)
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	changes, err := api.rollbackDiff(r, *existing, updates)
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	res := models.FirehoseRollback{
+		Revision: int64(n),
+		Reason:   fmt.Sprintf("rollback to revision %d", n),
+		Diff:     changes,
+	}
+
+	if isDryRun(r) {
+		res.Firehose = &models.Firehose{
+			Urn:         urn,
+			Description: updates.Description,
+			Configs:     &updates.Configs,
+		}
+		api.maskFirehoses(r, res.Firehose)
+		utils.WriteJSON(w, http.StatusOK, res)
+		return
+	}
+
+	res.Firehose, err = api.updateFirehose(r.Context(), *existing, updates, cfgStruct, res.Reason)
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	params := rollbackRequest{Revision: n, Timestamp: req.Timestamp}
+	api.startOperation(w, r, prj.GetSlug(), actionRollback, urn, params, api.pollFirehose(urn))
+	api.maskFirehoses(r, res.Firehose)
+	utils.WriteJSON(w, http.StatusOK, res)
+}
+
+// pickRevision returns the (1-based) number of the revision selected by the
+// request. revisions must be sorted oldest first.
+func pickRevision(revisions []*entropyv1beta1.ResourceRevision, req rollbackRequest) (int, error) {
+	if req.Revision > 0 {
+		if req.Revision > len(revisions) {
+			return 0, errors.ErrNotFound.WithMsgf("revision %d does not exist, firehose has %d revisions",
+				req.Revision, len(revisions))
+		}
+		return req.Revision, nil
+	}
+
+	n := 0
+	for i, revision := range revisions {
+		if revision.GetCreatedAt().AsTime().After(req.Timestamp) {
+			break
+		}
+		n = i + 1
+	}
+	if n == 0 {
+		return 0, errors.ErrNotFound.WithMsgf("no revision exists at or before %s", req.Timestamp.Format(time.RFC3339))
+	}
+	return n, nil
+}
+
+// revisionFirehose returns the firehose as it was at the revision. The env
+// vars contain the secret references instead of the resolved values.
+func revisionFirehose(urn string, revision *entropyv1beta1.ResourceRevision) (*models.Firehose, error) {
+	if revision.GetSpec().GetConfigs() == nil {
+		return nil, errors.ErrInternal.WithCausef("revision %s has no configs", revision.GetId())
+	}
+
+	return mapResourceToFirehose(&entropyv1beta1.Resource{
+		Urn:    urn,
+		Labels: revision.GetLabels(),
+		Spec:   revision.GetSpec(),
+	}, false)
+}
+
+// rollbackDiff returns the changes that will be applied to the existing
+// firehose by the updates. Values of the sensitive env vars are masked unless
+// reveal is set.
+func (api *firehoseAPI) rollbackDiff(r *http.Request, existing models.Firehose, updates firehoseUpdates) (json.RawMessage, error) {
+	view := func(description string, cfg *models.FirehoseConfig) ([]byte, error) {
+		v := rollbackView{Description: description}
+		if cfg != nil {
+			cfgCopy := *cfg
+			cfgCopy.EnvVars = maps.Clone(cfg.EnvVars)
+			v.Configs = &cfgCopy
+			if !isReveal(r) {
+				api.Masker.EnvVars(cfgCopy.EnvVars)
+			}
+		}
+		return json.Marshal(v)
+	}
+
+	before, err := view(existing.Description, existing.Configs)
+	if err != nil {
+		return nil, err
+	}
+
+	description := updates.Description
+	if description == "" {
+		// update retains the existing description if it is not set.
+		description = existing.Description
+	}
+	after, err := view(description, &updates.Configs)
+	if err != nil {
+		return nil, err
+	}
+
+	delta, err := diff.JSON(before, after)
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(delta), nil
+}
@@ -0,0 +1,52 @@
+package firehose
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	entropyv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/entropy/v1beta1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/odpf/dex/pkg/errors"
+)
+
+func TestPickRevision(t *testing.T) {
+	t.Parallel()
+
+	base := time.Date(2022, 10, 10, 10, 0, 0, 0, time.UTC)
+	revisions := []*entropyv1beta1.ResourceRevision{
+		{Id: "1", CreatedAt: timestamppb.New(base)},
+		{Id: "2", CreatedAt: timestamppb.New(base.Add(time.Hour))},
+		{Id: "3", CreatedAt: timestamppb.New(base.Add(2 * time.Hour))},
+	}
+
+	table := []struct {
+		title   string
+		req     rollbackRequest
+		want    int
+		wantErr error
+	}{
+		{title: "ByRevision", req: rollbackRequest{Revision: 2}, want: 2},
+		{title: "MissingRevision", req: rollbackRequest{Revision: 4}, wantErr: errors.ErrNotFound},
+		{title: "ExactTimestamp", req: rollbackRequest{Timestamp: base.Add(time.Hour)}, want: 2},
+		{title: "BetweenRevisions", req: rollbackRequest{Timestamp: base.Add(90 * time.Minute)}, want: 2},
+		{title: "AfterLatest", req: rollbackRequest{Timestamp: base.Add(24 * time.Hour)}, want: 3},
+		{title: "BeforeFirst", req: rollbackRequest{Timestamp: base.Add(-time.Minute)}, wantErr: errors.ErrNotFound},
+	}
+
+	for _, tt := range table {
+		tt := tt
+		t.Run(tt.title, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := pickRevision(revisions, tt.req)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,256 @@
+package firehose
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/odpf/dex/internal/server/reqctx"
+	"github.com/odpf/dex/internal/server/schedule"
+	"github.com/odpf/dex/internal/server/utils"
+	"github.com/odpf/dex/internal/server/v1/project"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+const pathParamScheduleID = "scheduleID"
+
+type scheduleRequest struct {
+	Cron    string          `json:"cron"`
+	Action  string          `json:"action"`
+	Params  json.RawMessage `json:"params"`
+	Enabled *bool           `json:"enabled"`
+}
+
+type scaleParams struct {
+	Replicas int `json:"replicas"`
+}
+
+func (api *firehoseAPI) handleCreateSchedule(w http.ResponseWriter, r *http.Request) {
+	urn := chi.URLParam(r, pathParamURN)
+
+	def, err := readScheduleRequest(r)
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	// Ensure that the URN refers to a valid firehose resource.
+	if _, err := api.getFirehose(r.Context(), chi.URLParam(r, pathParamProject), urn); err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	reqCtx := reqctx.From(r.Context())
+	def.Project = chi.URLParam(r, pathParamProject)
+	def.URN = urn
+	def.CreatedBy = reqCtx.UserID
+	def.CreatedByEmail = reqCtx.UserEmail
+
+	created, err := api.Schedules.Create(r.Context(), *def)
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+	utils.WriteJSON(w, http.StatusCreated, created)
+}
+
+func (api *firehoseAPI) handleListSchedules(w http.ResponseWriter, r *http.Request) {
+	list, err := api.Schedules.List(r.Context(), schedule.Filter{
+		Project: chi.URLParam(r, pathParamProject),
+		URN:     chi.URLParam(r, pathParamURN),
+	})
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+	if list == nil {
+		list = []schedule.Schedule{}
+	}
+	utils.WriteJSON(w, http.StatusOK, utils.NewListResponse(list))
+}
+
+func (api *firehoseAPI) handleGetSchedule(w http.ResponseWriter, r *http.Request) {
+	s, err := api.getSchedule(r)
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, s)
+}
+
+func (api *firehoseAPI) handleUpdateSchedule(w http.ResponseWriter, r *http.Request) {
+	def, err := readScheduleRequest(r)
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	existing, err := api.getSchedule(r)
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+	reqCtx := reqctx.From(r.Context())
+	def.ID = existing.ID
+	def.UpdatedBy = reqCtx.UserID
+	def.UpdatedByEmail = reqCtx.UserEmail
+
+	updated, err := api.Schedules.Update(r.Context(), *def)
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, updated)
+}
+
+func (api *firehoseAPI) handleDeleteSchedule(w http.ResponseWriter, r *http.Request) {
+	s, err := api.getSchedule(r)
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	if err := api.Schedules.Delete(r.Context(), s.ID); err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+	utils.WriteJSON(w, http.StatusNoContent, nil)
+}
+
+// getSchedule returns the schedule in the request path. Schedules of other
+// firehoses are reported as not found.
+func (api *firehoseAPI) getSchedule(r *http.Request) (*schedule.Schedule, error) {
+	errNotFound := errors.ErrNotFound.WithMsgf("no schedule with given id")
+
+	s, err := api.Schedules.Get(r.Context(), chi.URLParam(r, pathParamScheduleID))
+	if err != nil {
+		if errors.Is(err, errors.ErrNotFound) {
+			return nil, errNotFound
+		}
+		return nil, err
+	} else if s.URN != chi.URLParam(r, pathParamURN) || s.Project != chi.URLParam(r, pathParamProject) {
+		return nil, errNotFound
+	}
+	return s, nil
+}
+
+// deleteSchedules removes the schedules of a deleted firehose.
+func (api *firehoseAPI) deleteSchedules(ctx context.Context, prjSlug, urn string) {
+	if api.Schedules == nil {
+		return
+	}
+
+	list, err := api.Schedules.List(ctx, schedule.Filter{Project: prjSlug, URN: urn})
+	if err != nil {
+		log.Printf("error: failed to list schedules of '%s': %v", urn, err)
+		return
+	}
+	for _, s := range list {
+		if err := api.Schedules.Delete(ctx, s.ID); err != nil {
+			log.Printf("error: failed to delete schedule '%s' of '%s': %v", s.ID, urn, err)
+		}
+	}
+}
+
+// runSchedule applies the action of the schedule on behalf of the user who
+// last updated it (the creator for schedules that were never updated). The run is recorded in the history of the firehose with a
+// reason that refers to the schedule, and as an operation. The schedule is
+// disabled if the user no longer has the manage permission on the project.
+func (api *firehoseAPI) runSchedule(ctx context.Context, s schedule.Schedule) (string, error) {
+	actor, actorEmail := s.UpdatedBy, s.UpdatedByEmail
+	if actorEmail == "" {
+		actor, actorEmail = s.CreatedBy, s.CreatedByEmail
+	}
+	ctx = reqctx.With(ctx, reqctx.ReqCtx{
+		UserID:    actor,
+		UserEmail: actorEmail,
+		RequestID: actionSchedule + "-" + s.ID,
+	})
+
+	if err := api.Authz.CheckManageFor(ctx, s.Project); err != nil {
+		if errors.OneOf(err, errors.ErrForbidden, errors.ErrUnauthorized) {
+			s.Enabled = false
+			if _, updateErr := api.Schedules.Update(ctx, s); updateErr != nil {
+				log.Printf("error: failed to disable schedule '%s': %v", s.ID, updateErr)
+			}
+			return "", fmt.Errorf("%w (schedule disabled)", err)
+		}
+		return "", err
+	}
+
+	params, err := scheduleActionParams(s.Action, s.Params)
+	if err != nil {
+		return "", err
+	}
+
+	reason := fmt.Sprintf("scheduled %s (schedule %s)", s.Action, s.ID)
+	updatedFirehose, err := api.executeAction(ctx, s.Project, s.URN, s.Action, params, reason)
+	if err != nil {
+		return "", err
+	}
+
+	if s.Action == actionStop {
+		prj, err := project.FindProjectBySlug(ctx, api.Shield, s.Project)
+		if err != nil {
+			return "", err
+		}
+		if err := api.stopAlerts(ctx, *updatedFirehose, prj); err != nil {
+			return "", err
+		}
+	}
+
+	return api.recordOperation(ctx, s.Project, s.Action, s.URN, params, api.pollFirehose(s.URN)), nil
+}
+
+func readScheduleRequest(r *http.Request) (*schedule.Schedule, error) {
+	var req scheduleRequest
+	if err := utils.ReadJSON(r, &req); err != nil {
+		return nil, err
+	}
+
+	action := strings.ToLower(strings.TrimSpace(req.Action))
+	params, err := scheduleActionParams(action, req.Params)
+	if err != nil {
+		return nil, err
+	}
+
+	def := &schedule.Schedule{
+		Cron:    strings.TrimSpace(req.Cron),
+		Action:  action,
+		Enabled: req.Enabled == nil || *req.Enabled,
+	}
+	if p, ok := params.(scaleParams); ok {
+		def.Params, _ = json.Marshal(p)
+	}
+	return def, nil
+}
+
+// scheduleActionParams returns the params of the entropy action for the
+// scheduled action.
+func scheduleActionParams(action string, rawParams json.RawMessage) (any, error) {
+	switch action {
+	case actionStart, actionStop:
+		return struct{}{}, nil
+
+	case actionScale:
+		var p scaleParams
+		if len(rawParams) > 0 {
+			if err := json.Unmarshal(rawParams, &p); err != nil {
+				return nil, errors.ErrInvalid.WithMsgf("params of scale must be an object with replicas")
+			}
+		}
+		if p.Replicas <= 0 {
+			return nil, errors.ErrInvalid.WithMsgf("params.replicas must be a positive integer for scale")
+		}
+		return p, nil
+
+	default:
+		return nil, errors.ErrInvalid.WithMsgf("action must be one of '%s', '%s' or '%s'",
+			actionStart, actionStop, actionScale)
+	}
+}
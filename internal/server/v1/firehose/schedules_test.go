@@ -0,0 +1,156 @@
+package firehose
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	entropyv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/entropy/v1beta1"
+	shieldv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/shield/v1beta1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/odpf/dex/internal/server/authz"
+	"github.com/odpf/dex/internal/server/schedule"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+func TestScheduleActionParams(t *testing.T) {
+	t.Parallel()
+
+	table := []struct {
+		title   string
+		action  string
+		params  string
+		want    any
+		wantErr error
+	}{
+		{title: "Stop", action: actionStop, want: struct{}{}},
+		{title: "Scale", action: actionScale, params: `{"replicas": 2}`, want: scaleParams{Replicas: 2}},
+		{title: "ScaleWithoutReplicas", action: actionScale, params: `{}`, wantErr: errors.ErrInvalid},
+		{title: "ScaleWithInvalidParams", action: actionScale, params: `[1]`, wantErr: errors.ErrInvalid},
+		{title: "UnsupportedAction", action: actionUpgrade, wantErr: errors.ErrInvalid},
+	}
+
+	for _, tt := range table {
+		tt := tt
+		t.Run(tt.title, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := scheduleActionParams(tt.action, json.RawMessage(tt.params))
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRunSchedule(t *testing.T) {
+	t.Parallel()
+
+	const urn = "orn:entropy:firehose:foo:bar"
+
+	configs, err := structpb.NewValue(map[string]any{
+		"state": moduleStateRunning,
+		"firehose": map[string]any{
+			"replicas":      1,
+			"env_variables": map[string]any{"SINK_TYPE": "LOG"},
+		},
+	})
+	require.NoError(t, err)
+
+	entropy := &fakeEntropy{resources: map[string]*entropyv1beta1.Resource{
+		urn: {
+			Urn:     urn,
+			Kind:    kindFirehose,
+			Name:    "bar",
+			Project: "foo",
+			Labels:  map[string]string{"title": "Bar"},
+			State:   &entropyv1beta1.ResourceState{Status: entropyv1beta1.ResourceState_STATUS_COMPLETED},
+			Spec:    &entropyv1beta1.ResourceSpec{Configs: configs},
+		},
+	}}
+	shield := permissionShield{managers: map[string]bool{"john@example.com": true}}
+	schedules := schedule.NewWithStore(schedule.NewMemoryStore(), time.Hour)
+	defer schedules.Close()
+
+	api := &firehoseAPI{
+		Entropy:   entropy,
+		Shield:    shield,
+		Authz:     authz.New(authz.Config{Enabled: true, ManagePermission: "manage", IdentityHeader: "X-Shield-Email"}, shield),
+		Schedules: schedules,
+	}
+
+	_, err = api.runSchedule(context.Background(), schedule.Schedule{
+		ID:             "s1",
+		Project:        "foo",
+		URN:            urn,
+		Action:         actionScale,
+		Params:         json.RawMessage(`{"replicas": 3}`),
+		CreatedBy:      "u-1",
+		CreatedByEmail: "john@example.com",
+	})
+	require.NoError(t, err)
+
+	labels := entropy.resources[urn].GetLabels()
+	assert.Equal(t, "scheduled scale (schedule s1)", labels[labelUpdateReason])
+	assert.Equal(t, "john@example.com", labels["updated_by_email"])
+	assert.Equal(t, "Bar", labels["title"])
+
+	_, err = api.runSchedule(context.Background(), schedule.Schedule{
+		ID: "s2", Project: "foo", URN: "orn:entropy:firehose:foo:missing", Action: actionStart, CreatedByEmail: "john@example.com",
+	})
+	assert.ErrorIs(t, err, errors.ErrNotFound)
+
+	// the creator lost the manage permission since.
+	revoked, err := schedules.Create(context.Background(), schedule.Schedule{
+		Project: "foo", URN: urn, Cron: "0 22 * * *", Action: actionStop, Enabled: true, CreatedByEmail: "jane@example.com",
+	})
+	require.NoError(t, err)
+
+	_, err = api.runSchedule(context.Background(), *revoked)
+	assert.ErrorIs(t, err, errors.ErrForbidden)
+	assert.Equal(t, moduleStateRunning, getModuleState(entropy.resources[urn]))
+
+	revoked, err = schedules.Get(context.Background(), revoked.ID)
+	require.NoError(t, err)
+	assert.False(t, revoked.Enabled)
+
+	// runs are applied on behalf of the user who last updated the schedule.
+	s3, err := schedules.Create(context.Background(), schedule.Schedule{
+		Project: "foo", URN: urn, Cron: "0 22 * * *", Action: actionScale, Params: json.RawMessage(`{"replicas": 2}`),
+		Enabled: true, CreatedBy: "u-1", CreatedByEmail: "john@example.com",
+	})
+	require.NoError(t, err)
+
+	s3.Params = json.RawMessage(`{"replicas": 5}`)
+	s3.UpdatedBy, s3.UpdatedByEmail = "u-2", "jane@example.com"
+	s3, err = schedules.Update(context.Background(), *s3)
+	require.NoError(t, err)
+	assert.Equal(t, "john@example.com", s3.CreatedByEmail)
+	assert.Equal(t, "jane@example.com", s3.UpdatedByEmail)
+
+	_, err = api.runSchedule(context.Background(), *s3)
+	assert.ErrorIs(t, err, errors.ErrForbidden, "changes by users without the manage permission must not run")
+	assert.Equal(t, "john@example.com", entropy.resources[urn].GetLabels()["updated_by_email"])
+}
+
+// permissionShield grants the manage permission on all projects to the
+// managers.
+type permissionShield struct {
+	fakeShield
+	managers map[string]bool
+}
+
+func (ps permissionShield) CheckResourcePermission(ctx context.Context, _ *shieldv1beta1.CheckResourcePermissionRequest, _ ...grpc.CallOption) (*shieldv1beta1.CheckResourcePermissionResponse, error) {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	users := md.Get("X-Shield-Email")
+	return &shieldv1beta1.CheckResourcePermissionResponse{Status: len(users) > 0 && ps.managers[users[0]]}, nil
+}
@@ -2,6 +2,7 @@ package kubernetes
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-openapi/strfmt"
@@ -25,6 +26,12 @@ func handleListKubeClusters(shield shieldv1beta1.ShieldServiceClient, entropy en
 	return func(w http.ResponseWriter, r *http.Request) {
 		tag := r.URL.Query().Get("tag")
 
+		pageReq, err := utils.ReadPageRequest(r)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
 		prj, err := project.GetProject(r, shield)
 		if err != nil {
 			utils.WriteErr(w, err)
@@ -48,8 +55,20 @@ func handleListKubeClusters(shield shieldv1beta1.ShieldServiceClient, entropy en
 				arr = append(arr, mapResourceToKubernetes(kube))
 			}
 		}
-		utils.WriteJSON(w, http.StatusOK,
-			utils.ListResponse[models.Kubernetes]{Items: arr})
+
+		resp, err := utils.Paginate(arr, pageReq, func(kube models.Kubernetes) utils.PageKey {
+			return utils.PageKey{
+				ID:        kube.Urn,
+				Name:      kube.Name,
+				CreatedAt: time.Time(kube.CreatedAt),
+				UpdatedAt: time.Time(kube.UpdatedAt),
+			}
+		})
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+		utils.WriteJSON(w, http.StatusOK, resp)
 	}
 }
 
@@ -2,6 +2,7 @@ package project
 
 import (
 	"net/http"
+	"time"
 
 	shieldv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/shield/v1beta1"
 
@@ -22,6 +23,12 @@ func handleGetProject(shield shieldv1beta1.ShieldServiceClient) http.HandlerFunc
 
 func handleListProjects(shield shieldv1beta1.ShieldServiceClient) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		pageReq, err := utils.ReadPageRequest(r)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
 		listReq := &shieldv1beta1.ListProjectsRequest{}
 
 		resp, err := shield.ListProjects(r.Context(), listReq)
@@ -30,14 +37,26 @@ func handleListProjects(shield shieldv1beta1.ShieldServiceClient) http.HandlerFu
 			return
 		}
 
-		projects := utils.ListResponse[models.Project]{Items: []models.Project{}}
+		projects := []models.Project{}
 		for _, p := range resp.Projects {
 			if p == nil {
 				continue
 			}
-			projects.Items = append(projects.Items, mapShieldProjectToProject(p))
+			projects = append(projects, mapShieldProjectToProject(p))
 		}
 
-		utils.WriteJSON(w, http.StatusOK, projects)
+		page, err := utils.Paginate(projects, pageReq, func(prj models.Project) utils.PageKey {
+			return utils.PageKey{
+				ID:        prj.ID,
+				Name:      prj.Slug,
+				CreatedAt: time.Time(prj.CreatedAt),
+				UpdatedAt: time.Time(prj.UpdatedAt),
+			}
+		})
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+		utils.WriteJSON(w, http.StatusOK, page)
 	}
 }
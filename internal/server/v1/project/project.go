@@ -1,6 +1,7 @@
 package project
 
 import (
+	"context"
 	"net/http"
 	"strings"
 
@@ -27,21 +28,16 @@ func Routes(shield shieldv1beta1.ShieldServiceClient) func(r chi.Router) {
 }
 
 func GetProject(r *http.Request, shieldClient shieldv1beta1.ShieldServiceClient) (*shieldv1beta1.Project, error) {
+	return FindProject(r, shieldClient, chi.URLParam(r, pathParamSlug))
+}
+
+// FindProject returns the project with the given slug. If the request has
+// the project ID header set, it is used to fetch the project directly.
+func FindProject(r *http.Request, shieldClient shieldv1beta1.ShieldServiceClient, projectSlug string) (*shieldv1beta1.Project, error) {
 	projectID := strings.TrimSpace(r.Header.Get(headerProjectID))
-	projectSlug := chi.URLParam(r, pathParamSlug)
 
 	if projectID == "" {
-		// List everything and search by slug.
-		projects, err := shieldClient.ListProjects(r.Context(), &shieldv1beta1.ListProjectsRequest{})
-		if err != nil {
-			return nil, err
-		}
-		for _, prj := range projects.GetProjects() {
-			if prj.GetSlug() == projectSlug {
-				return prj, nil
-			}
-		}
-		return nil, errors.ErrNotFound
+		return FindProjectBySlug(r.Context(), shieldClient, projectSlug)
 	}
 
 	// Project ID is available. Use it to fetch the project directly.
@@ -58,6 +54,21 @@ func GetProject(r *http.Request, shieldClient shieldv1beta1.ShieldServiceClient)
 	return prj.GetProject(), nil
 }
 
+// FindProjectBySlug returns the project with the given slug by listing all
+// the projects.
+func FindProjectBySlug(ctx context.Context, shieldClient shieldv1beta1.ShieldServiceClient, projectSlug string) (*shieldv1beta1.Project, error) {
+	projects, err := shieldClient.ListProjects(ctx, &shieldv1beta1.ListProjectsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	for _, prj := range projects.GetProjects() {
+		if prj.GetSlug() == projectSlug {
+			return prj, nil
+		}
+	}
+	return nil, errors.ErrNotFound
+}
+
 func mapShieldProjectToProject(prj *shieldv1beta1.Project) models.Project {
 	return models.Project{
 		ID:        prj.Id,
@@ -0,0 +1,28 @@
+package sink
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/odpf/dex/generated/models"
+	"github.com/odpf/dex/internal/server/utils"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+// HandleGetSchema returns the handler for fetching the env var schema of
+// the sink type in the path.
+func HandleGetSchema() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		sinkType := models.FirehoseSinkType(strings.ToUpper(chi.URLParam(r, "sinkType")))
+
+		schema, found := GetSchema(sinkType)
+		if !found {
+			utils.WriteErr(w, errors.ErrNotFound.WithMsgf("no sink type '%s'", sinkType))
+			return
+		}
+
+		utils.WriteJSON(w, http.StatusOK, schema)
+	}
+}
@@ -0,0 +1,184 @@
+// Package sink provides the schemas of the sink-specific env vars of a
+// firehose and validates firehose configs against them.
+package sink
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/odpf/dex/generated/models"
+	"github.com/odpf/dex/internal/server/secret"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+// Types of the env var values.
+const (
+	TypeString  = "string"
+	TypeInteger = "integer"
+	TypeBoolean = "boolean"
+	TypeURL     = "url"
+	TypeJSON    = "json"
+	TypeEnum    = "enum"
+)
+
+const envVarsPath = "configs.env_vars."
+
+// Field describes a sink-specific env var.
+type Field struct {
+	Key         string   `json:"key"`
+	Type        string   `json:"type"`
+	Required    bool     `json:"required"`
+	Default     string   `json:"default,omitempty"`
+	Allowed     []string `json:"allowed,omitempty"`
+	Description string   `json:"description,omitempty"`
+}
+
+// Schema describes the env vars supported by a sink type.
+type Schema struct {
+	SinkType models.FirehoseSinkType `json:"sink_type"`
+	Fields   []Field                 `json:"fields"`
+}
+
+// GetSchema returns the schema registered for the sink type.
+func GetSchema(sinkType models.FirehoseSinkType) (Schema, bool) {
+	s, found := registry[sinkType]
+	return s, found
+}
+
+// Validate checks the firehose configs and returns all the problems found.
+// Env vars are validated against the schema of the sink type.
+func Validate(cfg *models.FirehoseConfig) []errors.FieldError {
+	if cfg == nil {
+		return []errors.FieldError{{Field: "configs", Message: "must be set"}}
+	}
+
+	var problems []errors.FieldError
+	required := map[string]bool{
+		"configs.bootstrap_servers":        cfg.BootstrapServers == nil,
+		"configs.topic_name":               cfg.TopicName == nil,
+		"configs.consumer_group_id":        cfg.ConsumerGroupID == nil,
+		"configs.sink_type":                cfg.SinkType == nil,
+		"configs.stream_name":              cfg.StreamName == nil,
+		"configs.input_schema_proto_class": cfg.InputSchemaProtoClass == nil,
+	}
+	for field, missing := range required {
+		if missing {
+			problems = append(problems, errors.FieldError{Field: field, Message: "must be set"})
+		}
+	}
+
+	if cfg.StopDate != "" {
+		if _, err := time.Parse(time.RFC3339, cfg.StopDate); err != nil {
+			problems = append(problems, errors.FieldError{Field: "configs.stop_date", Message: "must be a RFC3339 timestamp"})
+		}
+	}
+
+	if cfg.Replicas != nil && *cfg.Replicas < 0 {
+		problems = append(problems, errors.FieldError{Field: "configs.replicas", Message: "must not be negative"})
+	}
+
+	if cfg.SinkType != nil {
+		schema, found := GetSchema(*cfg.SinkType)
+		if !found {
+			problems = append(problems, errors.FieldError{
+				Field:   "configs.sink_type",
+				Message: fmt.Sprintf("'%s' is not a supported sink type", *cfg.SinkType),
+			})
+		} else {
+			problems = append(problems, schema.Validate(cfg.EnvVars)...)
+		}
+	}
+
+	sort.Slice(problems, func(i, j int) bool {
+		return problems[i].Field < problems[j].Field
+	})
+	return problems
+}
+
+// ValidateUpdate checks the configs replacing prev. Unlike Validate, the env
+// vars are validated against the schema only if they are added, changed or
+// removed, so that the firehoses created before the schema was introduced
+// can still be updated. All the env vars are validated if the sink type is
+// changed.
+func ValidateUpdate(cfg, prev *models.FirehoseConfig) []errors.FieldError {
+	problems := Validate(cfg)
+	if cfg == nil || prev == nil || cfg.SinkType == nil || prev.SinkType == nil || *cfg.SinkType != *prev.SinkType {
+		return problems
+	}
+
+	var res []errors.FieldError
+	for _, p := range problems {
+		key := strings.TrimPrefix(p.Field, envVarsPath)
+		if key == p.Field || envVarChanged(key, cfg.EnvVars, prev.EnvVars) {
+			res = append(res, p)
+		}
+	}
+	return res
+}
+
+func envVarChanged(key string, envVars, prevEnvVars map[string]string) bool {
+	v, found := envVars[key]
+	prevV, prevFound := prevEnvVars[key]
+	return found != prevFound || v != prevV
+}
+
+// Validate checks the env vars against the schema. Env vars that are not
+// part of the schema are not validated.
+func (s Schema) Validate(envVars map[string]string) []errors.FieldError {
+	var problems []errors.FieldError
+	for _, f := range s.Fields {
+		v, found := envVars[f.Key]
+		if !found || strings.TrimSpace(v) == "" {
+			if f.Required {
+				problems = append(problems, errors.FieldError{Field: envVarsPath + f.Key, Message: "must be set"})
+			}
+			continue
+		}
+
+		if secret.IsRef(v) {
+			continue // resolved values are validated by the secret resolver.
+		} else if msg := f.check(v); msg != "" {
+			problems = append(problems, errors.FieldError{Field: envVarsPath + f.Key, Message: msg})
+		}
+	}
+	return problems
+}
+
+func (f Field) check(v string) string {
+	switch f.Type {
+	case TypeInteger:
+		if _, err := strconv.ParseInt(v, 10, 64); err != nil {
+			return "must be an integer"
+		}
+
+	case TypeBoolean:
+		if _, err := strconv.ParseBool(v); err != nil {
+			return "must be a boolean"
+		}
+
+	case TypeURL:
+		u, err := url.Parse(v)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return "must be a valid URL"
+		}
+
+	case TypeJSON:
+		if !json.Valid([]byte(v)) {
+			return "must be valid JSON"
+		}
+
+	case TypeEnum:
+		for _, allowed := range f.Allowed {
+			if strings.EqualFold(v, allowed) {
+				return ""
+			}
+		}
+		return fmt.Sprintf("must be one of [%s]", strings.Join(f.Allowed, ", "))
+	}
+	return ""
+}
@@ -0,0 +1,109 @@
+package sink
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/odpf/dex/generated/models"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	strPtr := func(s string) *string { return &s }
+	sinkType := func(st models.FirehoseSinkType) *models.FirehoseSinkType { return &st }
+
+	validConfig := func(st models.FirehoseSinkType, envVars map[string]string) *models.FirehoseConfig {
+		return &models.FirehoseConfig{
+			BootstrapServers:      strPtr("localhost:9092"),
+			ConsumerGroupID:       strPtr("group"),
+			InputSchemaProtoClass: strPtr("com.example.Event"),
+			SinkType:              sinkType(st),
+			StreamName:            strPtr("main"),
+			TopicName:             strPtr("events"),
+			EnvVars:               envVars,
+		}
+	}
+
+	t.Run("AllRegistered", func(t *testing.T) {
+		for _, st := range []models.FirehoseSinkType{
+			models.FirehoseSinkTypeLOG, models.FirehoseSinkTypeHTTP, models.FirehoseSinkTypePOSTGRES,
+			models.FirehoseSinkTypeINFLUXDB, models.FirehoseSinkTypeELASTIC, models.FirehoseSinkTypeREDIS,
+			models.FirehoseSinkTypeGRPC, models.FirehoseSinkTypePROMETHEUS, models.FirehoseSinkTypeBIGQUERY,
+			models.FirehoseSinkTypeBLOB, models.FirehoseSinkTypeBIGTABLE,
+		} {
+			_, found := GetSchema(st)
+			assert.True(t, found, st)
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := validConfig(models.FirehoseSinkTypeHTTP, map[string]string{
+			"SINK_HTTP_SERVICE_URL":    "http://example.com/ingest",
+			"SINK_HTTP_REQUEST_METHOD": "POST",
+			"SOME_UNKNOWN_KEY":         "ignored",
+		})
+		assert.Empty(t, Validate(cfg))
+	})
+
+	t.Run("AllProblems", func(t *testing.T) {
+		cfg := validConfig(models.FirehoseSinkTypeHTTP, map[string]string{
+			"SINK_HTTP_SERVICE_URL":        "not-a-url",
+			"SINK_HTTP_REQUEST_METHOD":     "get",
+			"SINK_HTTP_REQUEST_TIMEOUT_MS": "10s",
+			"SINK_HTTP_JSON_BODY_TEMPLATE": "{",
+		})
+		cfg.TopicName = nil
+
+		want := []errors.FieldError{
+			{Field: "configs.env_vars.SINK_HTTP_JSON_BODY_TEMPLATE", Message: "must be valid JSON"},
+			{Field: "configs.env_vars.SINK_HTTP_REQUEST_METHOD", Message: "must be one of [put, post, patch, delete]"},
+			{Field: "configs.env_vars.SINK_HTTP_REQUEST_TIMEOUT_MS", Message: "must be an integer"},
+			{Field: "configs.env_vars.SINK_HTTP_SERVICE_URL", Message: "must be a valid URL"},
+			{Field: "configs.topic_name", Message: "must be set"},
+		}
+		assert.Equal(t, want, Validate(cfg))
+	})
+
+	t.Run("MissingRequired", func(t *testing.T) {
+		cfg := validConfig(models.FirehoseSinkTypeBIGQUERY, map[string]string{
+			"SINK_BIGQUERY_GOOGLE_CLOUD_PROJECT_ID": "project",
+			"SINK_BIGQUERY_TABLE_NAME":              "table",
+			"SINK_BIGQUERY_CREDENTIAL_PATH":         "/creds.json",
+		})
+
+		want := []errors.FieldError{
+			{Field: "configs.env_vars.SINK_BIGQUERY_DATASET_NAME", Message: "must be set"},
+		}
+		assert.Equal(t, want, Validate(cfg))
+	})
+
+	t.Run("UpdateOfLegacyConfig", func(t *testing.T) {
+		// created before SINK_HTTP_SERVICE_URL was required.
+		prev := validConfig(models.FirehoseSinkTypeHTTP, map[string]string{
+			"SINK_HTTP_REQUEST_METHOD":     "get",
+			"SINK_HTTP_REQUEST_TIMEOUT_MS": "1000",
+		})
+
+		cfg := validConfig(models.FirehoseSinkTypeHTTP, map[string]string{
+			"SINK_HTTP_REQUEST_METHOD":     "get",
+			"SINK_HTTP_REQUEST_TIMEOUT_MS": "2000",
+		})
+		assert.Empty(t, ValidateUpdate(cfg, prev), "unchanged env vars must not be validated")
+
+		cfg.EnvVars["SINK_HTTP_REQUEST_TIMEOUT_MS"] = "2s"
+		cfg.StreamName = nil
+		want := []errors.FieldError{
+			{Field: "configs.env_vars.SINK_HTTP_REQUEST_TIMEOUT_MS", Message: "must be an integer"},
+			{Field: "configs.stream_name", Message: "must be set"},
+		}
+		assert.Equal(t, want, ValidateUpdate(cfg, prev))
+
+		cfg = validConfig(models.FirehoseSinkTypeHTTP, map[string]string{"SINK_HTTP_REQUEST_METHOD": "get"})
+		cfg.SinkType = sinkType(models.FirehoseSinkTypeLOG)
+		assert.Empty(t, ValidateUpdate(cfg, prev))
+		assert.Equal(t, Validate(prev), ValidateUpdate(prev, cfg), "all env vars must be validated when the sink type changes")
+	})
+}
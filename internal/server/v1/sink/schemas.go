@@ -0,0 +1,153 @@
+package sink
+
+import "github.com/odpf/dex/generated/models"
+
+var registry = map[models.FirehoseSinkType]Schema{
+	models.FirehoseSinkTypeLOG: {
+		SinkType: models.FirehoseSinkTypeLOG,
+		Fields:   []Field{},
+	},
+
+	models.FirehoseSinkTypeHTTP: {
+		SinkType: models.FirehoseSinkTypeHTTP,
+		Fields: []Field{
+			{Key: "SINK_HTTP_SERVICE_URL", Type: TypeURL, Required: true, Description: "URL of the HTTP endpoint to which the messages are sent."},
+			{Key: "SINK_HTTP_REQUEST_METHOD", Type: TypeEnum, Default: "put", Allowed: []string{"put", "post", "patch", "delete"}, Description: "HTTP verb of the requests."},
+			{Key: "SINK_HTTP_REQUEST_TIMEOUT_MS", Type: TypeInteger, Default: "10000", Description: "Timeout of the requests in milliseconds."},
+			{Key: "SINK_HTTP_MAX_CONNECTIONS", Type: TypeInteger, Default: "10", Description: "Maximum number of HTTP connections."},
+			{Key: "SINK_HTTP_HEADERS", Type: TypeString, Description: "Comma separated 'name:value' headers to add to the requests."},
+			{Key: "SINK_HTTP_PARAMETER_SOURCE", Type: TypeEnum, Default: "disabled", Allowed: []string{"disabled", "key", "message"}, Description: "Source of the parameterised headers or query params."},
+			{Key: "SINK_HTTP_DATA_FORMAT", Type: TypeEnum, Default: "proto", Allowed: []string{"proto", "json"}, Description: "Format of the request body."},
+			{Key: "SINK_HTTP_JSON_BODY_TEMPLATE", Type: TypeJSON, Description: "Template of the JSON request body."},
+			{Key: "SINK_HTTP_RETRY_STATUS_CODE_RANGES", Type: TypeString, Default: "400-600", Description: "Response status code ranges to retry."},
+		},
+	},
+
+	models.FirehoseSinkTypePOSTGRES: {
+		SinkType: models.FirehoseSinkTypePOSTGRES,
+		Fields: []Field{
+			{Key: "SINK_JDBC_URL", Type: TypeString, Required: true, Description: "JDBC URL of the database."},
+			{Key: "SINK_JDBC_TABLE_NAME", Type: TypeString, Required: true, Description: "Name of the table to write to."},
+			{Key: "SINK_JDBC_USERNAME", Type: TypeString, Required: true, Description: "Username to connect with."},
+			{Key: "SINK_JDBC_PASSWORD", Type: TypeString, Required: true, Description: "Password to connect with."},
+			{Key: "INPUT_SCHEMA_PROTO_TO_COLUMN_MAPPING", Type: TypeJSON, Required: true, Description: "Mapping of proto field indexes to column names."},
+			{Key: "SINK_JDBC_UNIQUE_KEYS", Type: TypeString, Description: "Comma separated unique keys of the table for upserts."},
+			{Key: "SINK_JDBC_CONNECTION_POOL_MAX_SIZE", Type: TypeInteger, Default: "10", Description: "Maximum size of the connection pool."},
+			{Key: "SINK_JDBC_CONNECTION_POOL_TIMEOUT_MS", Type: TypeInteger, Default: "1000", Description: "Timeout for acquiring a connection in milliseconds."},
+		},
+	},
+
+	models.FirehoseSinkTypeINFLUXDB: {
+		SinkType: models.FirehoseSinkTypeINFLUXDB,
+		Fields: []Field{
+			{Key: "SINK_INFLUX_URL", Type: TypeURL, Required: true, Description: "URL of the InfluxDB server."},
+			{Key: "SINK_INFLUX_USERNAME", Type: TypeString, Required: true, Description: "Username to connect with."},
+			{Key: "SINK_INFLUX_PASSWORD", Type: TypeString, Required: true, Description: "Password to connect with."},
+			{Key: "SINK_INFLUX_DB_NAME", Type: TypeString, Required: true, Description: "Name of the database."},
+			{Key: "SINK_INFLUX_MEASUREMENT_NAME", Type: TypeString, Required: true, Description: "Name of the measurement."},
+			{Key: "SINK_INFLUX_FIELD_NAME_PROTO_INDEX_MAPPING", Type: TypeJSON, Required: true, Description: "Mapping of proto field indexes to field names."},
+			{Key: "SINK_INFLUX_TAG_NAME_PROTO_INDEX_MAPPING", Type: TypeJSON, Description: "Mapping of proto field indexes to tag names."},
+			{Key: "SINK_INFLUX_PROTO_EVENT_TIMESTAMP_INDEX", Type: TypeInteger, Required: true, Description: "Index of the proto field with the event timestamp."},
+			{Key: "SINK_INFLUX_RETENTION_POLICY", Type: TypeString, Default: "autogen", Description: "Retention policy of the database."},
+		},
+	},
+
+	models.FirehoseSinkTypeELASTIC: {
+		SinkType: models.FirehoseSinkTypeELASTIC,
+		Fields: []Field{
+			{Key: "SINK_ES_CONNECTION_URLS", Type: TypeString, Required: true, Description: "Comma separated 'host:port' of the Elasticsearch nodes."},
+			{Key: "SINK_ES_INDEX_NAME", Type: TypeString, Required: true, Description: "Name of the index to write to."},
+			{Key: "SINK_ES_ID_FIELD", Type: TypeString, Description: "Field of the message used as document ID."},
+			{Key: "SINK_ES_MODE", Type: TypeEnum, Default: "insert", Allowed: []string{"insert", "update"}, Description: "Whether documents are inserted or updated."},
+			{Key: "SINK_ES_INPUT_MESSAGE_TYPE", Type: TypeEnum, Default: "JSON", Allowed: []string{"JSON", "PROTOBUF"}, Description: "Format of the messages in the topic."},
+			{Key: "SINK_ES_TYPE_NAME", Type: TypeString, Description: "Type name of the documents."},
+			{Key: "SINK_ES_SHARDS", Type: TypeInteger, Default: "1", Description: "Number of shards of the index."},
+			{Key: "SINK_ES_REPLICAS", Type: TypeInteger, Default: "1", Description: "Number of replicas of the index."},
+			{Key: "SINK_ES_REQUEST_TIMEOUT_MS", Type: TypeInteger, Default: "60000", Description: "Timeout of the requests in milliseconds."},
+			{Key: "SINK_ES_ROUTING_KEY_NAME", Type: TypeString, Description: "Field of the message used for routing."},
+		},
+	},
+
+	models.FirehoseSinkTypeREDIS: {
+		SinkType: models.FirehoseSinkTypeREDIS,
+		Fields: []Field{
+			{Key: "SINK_REDIS_URLS", Type: TypeString, Required: true, Description: "Comma separated 'host:port' of the Redis nodes."},
+			{Key: "SINK_REDIS_KEY_TEMPLATE", Type: TypeString, Required: true, Description: "Template of the keys."},
+			{Key: "SINK_REDIS_DATA_TYPE", Type: TypeEnum, Required: true, Allowed: []string{"LIST", "HASHSET", "KEYVALUE"}, Description: "Redis data type to write."},
+			{Key: "SINK_REDIS_LIST_DATA_PROTO_INDEX", Type: TypeInteger, Description: "Index of the proto field pushed to the list."},
+			{Key: "SINK_REDIS_KEY_VALUE_DATA_PROTO_INDEX", Type: TypeInteger, Description: "Index of the proto field used as value."},
+			{Key: "SINK_REDIS_TTL_TYPE", Type: TypeEnum, Default: "DISABLE", Allowed: []string{"DISABLE", "DURATION", "EXACT_TIME"}, Description: "How the TTL of the keys is set."},
+			{Key: "SINK_REDIS_TTL_VALUE", Type: TypeInteger, Default: "0", Description: "TTL of the keys."},
+			{Key: "SINK_REDIS_DEPLOYMENT_TYPE", Type: TypeEnum, Default: "STANDALONE", Allowed: []string{"STANDALONE", "CLUSTER"}, Description: "Deployment type of Redis."},
+		},
+	},
+
+	models.FirehoseSinkTypeGRPC: {
+		SinkType: models.FirehoseSinkTypeGRPC,
+		Fields: []Field{
+			{Key: "SINK_GRPC_SERVICE_HOST", Type: TypeString, Required: true, Description: "Host of the gRPC service."},
+			{Key: "SINK_GRPC_SERVICE_PORT", Type: TypeInteger, Required: true, Description: "Port of the gRPC service."},
+			{Key: "SINK_GRPC_METHOD_URL", Type: TypeString, Required: true, Description: "Full name of the gRPC method to call."},
+			{Key: "SINK_GRPC_RESPONSE_SCHEMA_PROTO_CLASS", Type: TypeString, Required: true, Description: "Proto class of the response."},
+		},
+	},
+
+	models.FirehoseSinkTypePROMETHEUS: {
+		SinkType: models.FirehoseSinkTypePROMETHEUS,
+		Fields: []Field{
+			{Key: "SINK_PROM_SERVICE_URL", Type: TypeURL, Required: true, Description: "Remote write URL of the Prometheus server."},
+			{Key: "SINK_PROM_METRIC_NAME_PROTO_INDEX_MAPPING", Type: TypeJSON, Required: true, Description: "Mapping of proto field indexes to metric names."},
+			{Key: "SINK_PROM_LABEL_NAME_PROTO_INDEX_MAPPING", Type: TypeJSON, Description: "Mapping of proto field indexes to label names."},
+			{Key: "SINK_PROM_REQUEST_TIMEOUT_MS", Type: TypeInteger, Default: "10000", Description: "Timeout of the requests in milliseconds."},
+			{Key: "SINK_PROM_HEADERS", Type: TypeString, Description: "Comma separated 'name:value' headers to add to the requests."},
+			{Key: "SINK_PROM_RETRY_STATUS_CODE_RANGES", Type: TypeString, Default: "400-600", Description: "Response status code ranges to retry."},
+			{Key: "SINK_PROM_WITH_EVENT_TIMESTAMP", Type: TypeBoolean, Default: "false", Description: "Whether the event timestamp is sent with the metrics."},
+			{Key: "SINK_PROM_PROTO_EVENT_TIMESTAMP_INDEX", Type: TypeInteger, Description: "Index of the proto field with the event timestamp."},
+		},
+	},
+
+	models.FirehoseSinkTypeBIGQUERY: {
+		SinkType: models.FirehoseSinkTypeBIGQUERY,
+		Fields: []Field{
+			{Key: "SINK_BIGQUERY_GOOGLE_CLOUD_PROJECT_ID", Type: TypeString, Required: true, Description: "Google Cloud project of the dataset."},
+			{Key: "SINK_BIGQUERY_DATASET_NAME", Type: TypeString, Required: true, Description: "Name of the dataset."},
+			{Key: "SINK_BIGQUERY_TABLE_NAME", Type: TypeString, Required: true, Description: "Name of the table."},
+			{Key: "SINK_BIGQUERY_CREDENTIAL_PATH", Type: TypeString, Required: true, Description: "Path to the service account credentials."},
+			{Key: "SINK_BIGQUERY_DATASET_LOCATION", Type: TypeString, Default: "asia-southeast1", Description: "Location of the dataset."},
+			{Key: "SINK_BIGQUERY_TABLE_PARTITIONING_ENABLE", Type: TypeBoolean, Default: "false", Description: "Whether the table is partitioned."},
+			{Key: "SINK_BIGQUERY_TABLE_PARTITION_KEY", Type: TypeString, Description: "Field used to partition the table."},
+			{Key: "SINK_BIGQUERY_ROW_INSERT_ID_ENABLE", Type: TypeBoolean, Default: "true", Description: "Whether insert IDs are used for de-duplication."},
+			{Key: "SINK_BIGQUERY_CLIENT_READ_TIMEOUT_MS", Type: TypeInteger, Default: "-1", Description: "Read timeout of the client in milliseconds."},
+			{Key: "SINK_BIGQUERY_CLIENT_CONNECT_TIMEOUT_MS", Type: TypeInteger, Default: "-1", Description: "Connect timeout of the client in milliseconds."},
+			{Key: "SINK_BIGQUERY_ADD_METADATA_ENABLED", Type: TypeBoolean, Default: "true", Description: "Whether Kafka metadata is added to the rows."},
+			{Key: "SINK_BIGQUERY_METADATA_NAMESPACE", Type: TypeString, Description: "Column under which the Kafka metadata is added."},
+		},
+	},
+
+	models.FirehoseSinkTypeBLOB: {
+		SinkType: models.FirehoseSinkTypeBLOB,
+		Fields: []Field{
+			{Key: "SINK_BLOB_STORAGE_TYPE", Type: TypeEnum, Required: true, Allowed: []string{"GCS"}, Description: "Type of the object storage."},
+			{Key: "SINK_BLOB_GCS_GOOGLE_CLOUD_PROJECT_ID", Type: TypeString, Required: true, Description: "Google Cloud project of the bucket."},
+			{Key: "SINK_BLOB_GCS_BUCKET_NAME", Type: TypeString, Required: true, Description: "Name of the bucket."},
+			{Key: "SINK_BLOB_GCS_CREDENTIAL_PATH", Type: TypeString, Required: true, Description: "Path to the service account credentials."},
+			{Key: "SINK_BLOB_LOCAL_FILE_WRITER_TYPE", Type: TypeEnum, Default: "PARQUET", Allowed: []string{"PARQUET"}, Description: "Format of the written files."},
+			{Key: "SINK_BLOB_FILE_PARTITION_PROTO_TIMESTAMP_FIELD_NAME", Type: TypeString, Description: "Timestamp field used to partition the files."},
+			{Key: "SINK_BLOB_FILE_PARTITION_TIME_GRANULARITY_TYPE", Type: TypeEnum, Default: "day", Allowed: []string{"day", "hour"}, Description: "Granularity of the time partitions."},
+			{Key: "SINK_BLOB_LOCAL_FILE_ROTATION_MAX_SIZE_BYTES", Type: TypeInteger, Description: "Size after which the local file is rotated."},
+			{Key: "SINK_BLOB_LOCAL_FILE_ROTATION_DURATION_MS", Type: TypeInteger, Description: "Duration after which the local file is rotated."},
+		},
+	},
+
+	models.FirehoseSinkTypeBIGTABLE: {
+		SinkType: models.FirehoseSinkTypeBIGTABLE,
+		Fields: []Field{
+			{Key: "SINK_BIGTABLE_GOOGLE_CLOUD_PROJECT_ID", Type: TypeString, Required: true, Description: "Google Cloud project of the instance."},
+			{Key: "SINK_BIGTABLE_INSTANCE_ID", Type: TypeString, Required: true, Description: "ID of the Bigtable instance."},
+			{Key: "SINK_BIGTABLE_TABLE_ID", Type: TypeString, Required: true, Description: "ID of the table."},
+			{Key: "SINK_BIGTABLE_CREDENTIAL_PATH", Type: TypeString, Required: true, Description: "Path to the service account credentials."},
+			{Key: "SINK_BIGTABLE_ROW_KEY_TEMPLATE", Type: TypeString, Required: true, Description: "Template of the row keys."},
+			{Key: "SINK_BIGTABLE_COLUMN_FAMILY_MAPPING", Type: TypeJSON, Required: true, Description: "Mapping of column families to proto fields."},
+		},
+	},
+}
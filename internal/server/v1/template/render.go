@@ -0,0 +1,246 @@
+package template
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/odpf/dex/generated/models"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+// Built-in variables that are available to all templates.
+const (
+	VarProject = "project"
+	VarName    = "name"
+	VarTitle   = "title"
+)
+
+var (
+	placeholderPattern  = regexp.MustCompile(`\$\{([^}]*)\}`)
+	variableNamePattern = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+	templateNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+	builtinVars = map[string]bool{VarProject: true, VarName: true, VarTitle: true}
+)
+
+// ParseVariables parses the variable values given in 'name=value' format.
+func ParseVariables(pairs []string) (map[string]string, error) {
+	values := map[string]string{}
+	for _, pair := range pairs {
+		name, value, found := strings.Cut(pair, "=")
+		name = strings.TrimSpace(name)
+		if !found || name == "" {
+			return nil, errors.ErrInvalid.
+				WithMsgf("template variable must be in 'name=value' format, not '%s'", pair)
+		}
+		values[name] = value
+	}
+	return values, nil
+}
+
+// Render fills the configs of the firehose using the template. Configs
+// that are already set on the firehose take precedence over the ones in the
+// template. Env vars are merged.
+func Render(tpl models.FirehoseTemplate, def *models.Firehose, project string, values map[string]string) error {
+	vars, err := resolveVariables(tpl, values)
+	if err != nil {
+		return err
+	}
+	vars[VarProject] = project
+	vars[VarName] = def.Name
+	vars[VarTitle] = def.Title
+
+	src := tpl.Configs
+	if src == nil {
+		src = &models.FirehoseTemplateConfig{}
+	}
+	if def.Configs == nil {
+		def.Configs = &models.FirehoseConfig{}
+	}
+	cfg := def.Configs
+
+	var undefined []string
+	render := func(s string) string {
+		return placeholderPattern.ReplaceAllStringFunc(s, func(m string) string {
+			name := placeholderPattern.FindStringSubmatch(m)[1]
+			v, ok := vars[name]
+			if !ok {
+				undefined = append(undefined, name)
+			}
+			return v
+		})
+	}
+	fill := func(dst **string, s string) {
+		if *dst == nil && s != "" {
+			rendered := render(s)
+			*dst = &rendered
+		}
+	}
+
+	fill(&cfg.StreamName, src.StreamName)
+	fill(&cfg.BootstrapServers, src.BootstrapServers)
+	fill(&cfg.ConsumerGroupID, src.ConsumerGroupID)
+	fill(&cfg.TopicName, src.TopicName)
+	fill(&cfg.InputSchemaProtoClass, src.InputSchemaProtoClass)
+	if cfg.StopDate == "" && src.StopDate != "" {
+		cfg.StopDate = render(src.StopDate)
+	}
+	if cfg.Replicas == nil && src.Replicas > 0 {
+		replicas := src.Replicas
+		cfg.Replicas = &replicas
+	}
+	if cfg.SinkType == nil && src.SinkType != "" {
+		sinkType := models.FirehoseSinkType(render(src.SinkType))
+		if err := sinkType.Validate(strfmt.Default); err != nil {
+			return errors.ErrInvalid.WithMsgf("rendered sink_type is not valid: %s", sinkType)
+		}
+		cfg.SinkType = &sinkType
+	}
+
+	if len(src.EnvVars) > 0 {
+		envVars := make(map[string]string, len(src.EnvVars)+len(cfg.EnvVars))
+		for k, v := range src.EnvVars {
+			envVars[k] = render(v)
+		}
+		for k, v := range cfg.EnvVars {
+			envVars[k] = v
+		}
+		cfg.EnvVars = envVars
+	}
+
+	if len(undefined) > 0 {
+		return errors.ErrInvalid.WithMsgf("template refers to undefined variables: %s",
+			strings.Join(uniqueSorted(undefined), ", "))
+	}
+	return nil
+}
+
+func resolveVariables(tpl models.FirehoseTemplate, values map[string]string) (map[string]string, error) {
+	declared := map[string]bool{}
+	vars := map[string]string{}
+
+	var missing []string
+	for _, v := range tpl.Variables {
+		if v == nil || v.Name == nil {
+			continue
+		}
+		name := *v.Name
+		declared[name] = true
+
+		if val, ok := values[name]; ok {
+			vars[name] = val
+		} else if v.Default != "" {
+			vars[name] = v.Default
+		} else if v.Required {
+			missing = append(missing, name)
+		} else {
+			vars[name] = ""
+		}
+	}
+
+	var unknown []string
+	for name := range values {
+		if !declared[name] {
+			unknown = append(unknown, name)
+		}
+	}
+
+	switch {
+	case len(missing) > 0:
+		return nil, errors.ErrInvalid.WithMsgf("values must be given for template variables: %s",
+			strings.Join(uniqueSorted(missing), ", "))
+
+	case len(unknown) > 0:
+		return nil, errors.ErrInvalid.WithMsgf("template does not declare variables: %s",
+			strings.Join(uniqueSorted(unknown), ", "))
+	}
+	return vars, nil
+}
+
+// validateTemplate sanitises the template and ensures that its name and
+// variables are valid and that all the placeholders refer to declared or
+// built-in variables.
+func validateTemplate(tpl *models.FirehoseTemplate) error {
+	name := strings.TrimSpace(nameOf(*tpl))
+	if !templateNamePattern.MatchString(name) {
+		return errors.ErrInvalid.WithMsgf("name must contain only lowercase letters, digits, '-' and '_'")
+	}
+	tpl.Name = &name
+	tpl.Title = strings.TrimSpace(tpl.Title)
+	tpl.Description = strings.TrimSpace(tpl.Description)
+
+	declared := map[string]bool{}
+	for i, v := range tpl.Variables {
+		if v == nil || v.Name == nil {
+			return errors.ErrInvalid.WithMsgf("variables[%d]: name must be set", i)
+		}
+
+		varName := strings.TrimSpace(*v.Name)
+		v.Name = &varName
+		switch {
+		case !variableNamePattern.MatchString(varName):
+			return errors.ErrInvalid.WithMsgf("variables[%d]: name '%s' is not valid", i, varName)
+
+		case builtinVars[varName]:
+			return errors.ErrInvalid.WithMsgf("variables[%d]: '%s' is a built-in variable", i, varName)
+
+		case declared[varName]:
+			return errors.ErrInvalid.WithMsgf("variables[%d]: '%s' is declared more than once", i, varName)
+		}
+		declared[varName] = true
+	}
+
+	if tpl.Configs == nil {
+		return nil
+	}
+	cfg := tpl.Configs
+
+	if cfg.SinkType != "" && !placeholderPattern.MatchString(cfg.SinkType) {
+		if err := models.FirehoseSinkType(cfg.SinkType).Validate(strfmt.Default); err != nil {
+			return errors.ErrInvalid.WithMsgf("configs.sink_type '%s' is not valid", cfg.SinkType)
+		}
+	}
+
+	fields := map[string]string{
+		"configs.stream_name":              cfg.StreamName,
+		"configs.bootstrap_servers":        cfg.BootstrapServers,
+		"configs.consumer_group_id":        cfg.ConsumerGroupID,
+		"configs.sink_type":                cfg.SinkType,
+		"configs.stop_date":                cfg.StopDate,
+		"configs.topic_name":               cfg.TopicName,
+		"configs.input_schema_proto_class": cfg.InputSchemaProtoClass,
+	}
+	for k, v := range cfg.EnvVars {
+		fields[fmt.Sprintf("configs.env_vars.%s", k)] = v
+	}
+
+	var problems []string
+	for field, s := range fields {
+		for _, m := range placeholderPattern.FindAllStringSubmatch(s, -1) {
+			if !declared[m[1]] && !builtinVars[m[1]] {
+				problems = append(problems, fmt.Sprintf("%s refers to undeclared variable '%s'", field, m[1]))
+			}
+		}
+	}
+	if len(problems) > 0 {
+		return errors.ErrInvalid.WithMsgf("%s", strings.Join(uniqueSorted(problems), "; "))
+	}
+	return nil
+}
+
+func uniqueSorted(items []string) []string {
+	set := map[string]bool{}
+	var res []string
+	for _, item := range items {
+		if !set[item] {
+			set[item] = true
+			res = append(res, item)
+		}
+	}
+	sort.Strings(res)
+	return res
+}
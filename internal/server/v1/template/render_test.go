@@ -0,0 +1,92 @@
+package template
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/odpf/dex/generated/models"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+func strPtr(s string) *string { return &s }
+
+func sampleTemplate() models.FirehoseTemplate {
+	return models.FirehoseTemplate{
+		Name: strPtr("kafka-to-bq"),
+		Configs: &models.FirehoseTemplateConfig{
+			BootstrapServers: "kafka:9092",
+			ConsumerGroupID:  "${project}-${name}",
+			TopicName:        "${topic}",
+			SinkType:         "BIGQUERY",
+			StreamName:       "main",
+			EnvVars: map[string]string{
+				"SINK_BIGQUERY_DATASET_NAME": "${dataset}",
+				"SINK_BIGQUERY_TABLE_NAME":   "${topic}",
+			},
+		},
+		Variables: []*models.FirehoseTemplateVariable{
+			{Name: strPtr("topic"), Required: true},
+			{Name: strPtr("dataset"), Default: "raw"},
+		},
+	}
+}
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success", func(t *testing.T) {
+		def := &models.Firehose{
+			Name: "bookings",
+			Configs: &models.FirehoseConfig{
+				StreamName: strPtr("override"),
+				EnvVars:    map[string]string{"SINK_BIGQUERY_DATASET_NAME": "custom"},
+			},
+		}
+
+		err := Render(sampleTemplate(), def, "foo", map[string]string{"topic": "booking-log"})
+		require.NoError(t, err)
+
+		cfg := def.Configs
+		assert.Equal(t, "kafka:9092", *cfg.BootstrapServers)
+		assert.Equal(t, "foo-bookings", *cfg.ConsumerGroupID)
+		assert.Equal(t, "booking-log", *cfg.TopicName)
+		assert.Equal(t, models.FirehoseSinkTypeBIGQUERY, *cfg.SinkType)
+		assert.Equal(t, "override", *cfg.StreamName)
+		assert.Equal(t, map[string]string{
+			"SINK_BIGQUERY_DATASET_NAME": "custom",
+			"SINK_BIGQUERY_TABLE_NAME":   "booking-log",
+		}, cfg.EnvVars)
+	})
+
+	t.Run("MissingRequired", func(t *testing.T) {
+		err := Render(sampleTemplate(), &models.Firehose{}, "foo", nil)
+		assert.ErrorIs(t, err, errors.ErrInvalid)
+	})
+
+	t.Run("UnknownVariable", func(t *testing.T) {
+		err := Render(sampleTemplate(), &models.Firehose{}, "foo",
+			map[string]string{"topic": "x", "unknown": "y"})
+		assert.ErrorIs(t, err, errors.ErrInvalid)
+	})
+}
+
+func TestValidateTemplate(t *testing.T) {
+	t.Parallel()
+
+	valid := sampleTemplate()
+	assert.NoError(t, validateTemplate(&valid))
+
+	undeclared := sampleTemplate()
+	undeclared.Configs.TopicName = "${missing}"
+	assert.ErrorIs(t, validateTemplate(&undeclared), errors.ErrInvalid)
+
+	builtin := sampleTemplate()
+	builtin.Variables = append(builtin.Variables, &models.FirehoseTemplateVariable{Name: strPtr(VarProject)})
+	assert.ErrorIs(t, validateTemplate(&builtin), errors.ErrInvalid)
+
+	badName := sampleTemplate()
+	badName.Name = strPtr("Not Valid")
+	assert.ErrorIs(t, validateTemplate(&badName), errors.ErrInvalid)
+}
@@ -0,0 +1,207 @@
+package template
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/odpf/dex/generated/models"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+var (
+	errTemplateNotFound = errors.ErrNotFound.WithMsgf("no firehose template with given name")
+	errTemplateExists   = errors.ErrConflict.WithMsgf("firehose template with given name already exists")
+)
+
+// Store persists the firehose templates of all projects.
+type Store interface {
+	List(ctx context.Context, project string) ([]models.FirehoseTemplate, error)
+	Get(ctx context.Context, project, name string) (*models.FirehoseTemplate, error)
+	Create(ctx context.Context, project string, tpl models.FirehoseTemplate) error
+	Update(ctx context.Context, project string, tpl models.FirehoseTemplate) error
+	Delete(ctx context.Context, project, name string) error
+}
+
+// Config contains the configurations for the template store. If Path is
+// empty, templates are kept only in memory.
+type Config struct {
+	Path string `mapstructure:"path"`
+}
+
+// NewStore returns a Store as per the given config.
+func NewStore(cfg Config) (Store, error) {
+	st := &fileStore{
+		path:      cfg.Path,
+		templates: map[string]map[string]models.FirehoseTemplate{},
+	}
+	if err := st.load(); err != nil {
+		return nil, err
+	}
+	return st, nil
+}
+
+// fileStore keeps the templates in memory and, if path is set, writes all
+// of them to the file as JSON on every change.
+type fileStore struct {
+	mu        sync.RWMutex
+	path      string
+	templates map[string]map[string]models.FirehoseTemplate
+}
+
+func (fs *fileStore) List(_ context.Context, project string) ([]models.FirehoseTemplate, error) {
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
+
+	res := []models.FirehoseTemplate{}
+	for _, tpl := range fs.templates[project] {
+		res = append(res, cloneTemplate(tpl))
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return nameOf(res[i]) < nameOf(res[j])
+	})
+	return res, nil
+}
+
+func (fs *fileStore) Get(_ context.Context, project, name string) (*models.FirehoseTemplate, error) {
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
+
+	tpl, found := fs.templates[project][name]
+	if !found {
+		return nil, errTemplateNotFound
+	}
+	tpl = cloneTemplate(tpl)
+	return &tpl, nil
+}
+
+func (fs *fileStore) Create(_ context.Context, project string, tpl models.FirehoseTemplate) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	name := nameOf(tpl)
+	if _, found := fs.templates[project][name]; found {
+		return errTemplateExists
+	}
+
+	if fs.templates[project] == nil {
+		fs.templates[project] = map[string]models.FirehoseTemplate{}
+	}
+	fs.templates[project][name] = cloneTemplate(tpl)
+	return fs.persist()
+}
+
+func (fs *fileStore) Update(_ context.Context, project string, tpl models.FirehoseTemplate) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	name := nameOf(tpl)
+	if _, found := fs.templates[project][name]; !found {
+		return errTemplateNotFound
+	}
+	fs.templates[project][name] = cloneTemplate(tpl)
+	return fs.persist()
+}
+
+func (fs *fileStore) Delete(_ context.Context, project, name string) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	if _, found := fs.templates[project][name]; !found {
+		return errTemplateNotFound
+	}
+	delete(fs.templates[project], name)
+	return fs.persist()
+}
+
+func (fs *fileStore) load() error {
+	if fs.path == "" {
+		return nil
+	}
+
+	b, err := os.ReadFile(fs.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read templates file: %w", err)
+	}
+
+	if err := json.Unmarshal(b, &fs.templates); err != nil {
+		return fmt.Errorf("failed to parse templates file: %w", err)
+	}
+	return nil
+}
+
+// persist writes the templates to a temporary file and renames it so that
+// the file is never left partially written.
+func (fs *fileStore) persist() error {
+	if fs.path == "" {
+		return nil
+	}
+
+	b, err := json.MarshalIndent(fs.templates, "", "  ")
+	if err != nil {
+		return errors.ErrInternal.WithCausef(err.Error())
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(fs.path), filepath.Base(fs.path)+".*")
+	if err != nil {
+		return errors.ErrInternal.WithCausef(err.Error())
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(b); err != nil {
+		_ = tmp.Close()
+		return errors.ErrInternal.WithCausef(err.Error())
+	} else if err := tmp.Close(); err != nil {
+		return errors.ErrInternal.WithCausef(err.Error())
+	}
+
+	if err := os.Rename(tmp.Name(), fs.path); err != nil {
+		return errors.ErrInternal.WithCausef(err.Error())
+	}
+	return nil
+}
+
+func cloneTemplate(tpl models.FirehoseTemplate) models.FirehoseTemplate {
+	clone := tpl
+	if tpl.Name != nil {
+		name := *tpl.Name
+		clone.Name = &name
+	}
+	if tpl.Configs != nil {
+		cfg := *tpl.Configs
+		if tpl.Configs.EnvVars != nil {
+			cfg.EnvVars = make(map[string]string, len(tpl.Configs.EnvVars))
+			for k, v := range tpl.Configs.EnvVars {
+				cfg.EnvVars[k] = v
+			}
+		}
+		clone.Configs = &cfg
+	}
+
+	clone.Variables = nil
+	for _, v := range tpl.Variables {
+		if v != nil {
+			cp := *v
+			if v.Name != nil {
+				name := *v.Name
+				cp.Name = &name
+			}
+			clone.Variables = append(clone.Variables, &cp)
+		}
+	}
+	return clone
+}
+
+func nameOf(tpl models.FirehoseTemplate) string {
+	if tpl.Name == nil {
+		return ""
+	}
+	return *tpl.Name
+}
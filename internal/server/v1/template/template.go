@@ -0,0 +1,182 @@
+package template
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-openapi/strfmt"
+	shieldv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/shield/v1beta1"
+
+	"github.com/odpf/dex/generated/models"
+	"github.com/odpf/dex/internal/server/mask"
+	"github.com/odpf/dex/internal/server/reqctx"
+	"github.com/odpf/dex/internal/server/utils"
+	"github.com/odpf/dex/internal/server/v1/project"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+const pathParamName = "name"
+
+func Routes(shield shieldv1beta1.ShieldServiceClient, store Store, masker *mask.Masker) func(chi.Router) {
+	api := &templateAPI{Shield: shield, Store: store, Masker: masker}
+
+	return func(r chi.Router) {
+		r.Get("/", api.handleList)
+		r.Post("/", api.handleCreate)
+		r.Get("/{name}", api.handleGet)
+		r.Put("/{name}", api.handleUpdate)
+		r.Delete("/{name}", api.handleDelete)
+	}
+}
+
+type templateAPI struct {
+	Shield shieldv1beta1.ShieldServiceClient
+	Store  Store
+	Masker *mask.Masker
+}
+
+func (api *templateAPI) handleList(w http.ResponseWriter, r *http.Request) {
+	prj, err := project.GetProject(r, api.Shield)
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	templates, err := api.Store.List(r.Context(), prj.GetSlug())
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	for i := range templates {
+		api.maskTemplate(r, &templates[i])
+	}
+	utils.WriteJSON(w, http.StatusOK, utils.NewListResponse(templates))
+}
+
+func (api *templateAPI) handleGet(w http.ResponseWriter, r *http.Request) {
+	prj, err := project.GetProject(r, api.Shield)
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	tpl, err := api.Store.Get(r.Context(), prj.GetSlug(), chi.URLParam(r, pathParamName))
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	api.maskTemplate(r, tpl)
+	utils.WriteJSON(w, http.StatusOK, tpl)
+}
+
+func (api *templateAPI) handleCreate(w http.ResponseWriter, r *http.Request) {
+	var tpl models.FirehoseTemplate
+	if err := utils.ReadJSON(r, &tpl); err != nil {
+		utils.WriteErr(w, err)
+		return
+	} else if err := validateTemplate(&tpl); err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	prj, err := project.GetProject(r, api.Shield)
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	reqCtx := reqctx.From(r.Context())
+	now := strfmt.DateTime(time.Now().UTC())
+	tpl.CreatedAt, tpl.UpdatedAt = now, now
+	tpl.CreatedByEmail, tpl.UpdatedByEmail = reqCtx.UserEmail, reqCtx.UserEmail
+
+	if err := api.Store.Create(r.Context(), prj.GetSlug(), tpl); err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	api.maskTemplate(r, &tpl)
+	utils.WriteJSON(w, http.StatusCreated, tpl)
+}
+
+func (api *templateAPI) handleUpdate(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, pathParamName)
+
+	var tpl models.FirehoseTemplate
+	if err := utils.ReadJSON(r, &tpl); err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	if tpl.Name == nil {
+		tpl.Name = &name
+	} else if *tpl.Name != name {
+		utils.WriteErr(w, errors.ErrInvalid.WithMsgf("name cannot be changed"))
+		return
+	}
+
+	if err := validateTemplate(&tpl); err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	prj, err := project.GetProject(r, api.Shield)
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	existing, err := api.Store.Get(r.Context(), prj.GetSlug(), name)
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	if tpl.Configs != nil && existing.Configs != nil {
+		api.Masker.Restore(tpl.Configs.EnvVars, existing.Configs.EnvVars)
+	}
+	tpl.CreatedAt = existing.CreatedAt
+	tpl.CreatedByEmail = existing.CreatedByEmail
+	tpl.UpdatedAt = strfmt.DateTime(time.Now().UTC())
+	tpl.UpdatedByEmail = reqctx.From(r.Context()).UserEmail
+
+	if err := api.Store.Update(r.Context(), prj.GetSlug(), tpl); err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	api.maskTemplate(r, &tpl)
+	utils.WriteJSON(w, http.StatusOK, tpl)
+}
+
+func (api *templateAPI) handleDelete(w http.ResponseWriter, r *http.Request) {
+	prj, err := project.GetProject(r, api.Shield)
+	if err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	if err := api.Store.Delete(r.Context(), prj.GetSlug(), chi.URLParam(r, pathParamName)); err != nil {
+		utils.WriteErr(w, err)
+		return
+	}
+
+	utils.WriteJSON(w, http.StatusNoContent, nil)
+}
+
+// maskTemplate masks the values of the sensitive env vars of the template
+// unless the caller asked for them to be revealed. The authorizer allows
+// this only for the callers with manage permission.
+func (api *templateAPI) maskTemplate(r *http.Request, tpl *models.FirehoseTemplate) {
+	if reveal, _ := strconv.ParseBool(r.URL.Query().Get("reveal")); reveal {
+		return
+	}
+
+	if tpl != nil && tpl.Configs != nil {
+		api.Masker.EnvVars(tpl.Configs.EnvVars)
+	}
+}
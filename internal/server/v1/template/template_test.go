@@ -0,0 +1,88 @@
+package template
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	shieldv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/shield/v1beta1"
+	"google.golang.org/grpc"
+
+	"github.com/odpf/dex/generated/models"
+	"github.com/odpf/dex/internal/server/mask"
+)
+
+type fakeShield struct {
+	shieldv1beta1.ShieldServiceClient
+}
+
+func (fakeShield) ListProjects(_ context.Context, _ *shieldv1beta1.ListProjectsRequest, _ ...grpc.CallOption) (*shieldv1beta1.ListProjectsResponse, error) {
+	return &shieldv1beta1.ListProjectsResponse{Projects: []*shieldv1beta1.Project{{Slug: "foo"}}}, nil
+}
+
+func TestTemplateMasking(t *testing.T) {
+	t.Parallel()
+
+	store, err := NewStore(Config{})
+	require.NoError(t, err)
+
+	tpl := sampleTemplate()
+	tpl.Configs.EnvVars["SINK_BIGQUERY_CREDENTIAL_PATH"] = "/etc/creds.json"
+	require.NoError(t, store.Create(context.Background(), "foo", tpl))
+
+	masker, err := mask.New(mask.Config{})
+	require.NoError(t, err)
+
+	router := chi.NewRouter()
+	router.Route("/projects/{projectSlug}/firehoseTemplates", Routes(fakeShield{}, store, masker))
+
+	call := func(t *testing.T, method, path string, body any) (int, map[string]string) {
+		t.Helper()
+
+		var reqBody strings.Builder
+		if body != nil {
+			require.NoError(t, json.NewEncoder(&reqBody).Encode(body))
+		}
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(method, "/projects/foo/firehoseTemplates"+path, strings.NewReader(reqBody.String())))
+
+		var got models.FirehoseTemplate
+		if strings.HasPrefix(path, "/") {
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got), rec.Body.String())
+		} else {
+			var list struct {
+				Items []models.FirehoseTemplate `json:"items"`
+			}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &list), rec.Body.String())
+			require.Len(t, list.Items, 1)
+			got = list.Items[0]
+		}
+		return rec.Code, got.Configs.EnvVars
+	}
+
+	_, envVars := call(t, http.MethodGet, "", nil)
+	assert.Equal(t, mask.Placeholder, envVars["SINK_BIGQUERY_CREDENTIAL_PATH"])
+
+	_, envVars = call(t, http.MethodGet, "/kafka-to-bq", nil)
+	assert.Equal(t, mask.Placeholder, envVars["SINK_BIGQUERY_CREDENTIAL_PATH"])
+	assert.Equal(t, "${dataset}", envVars["SINK_BIGQUERY_DATASET_NAME"])
+
+	_, envVars = call(t, http.MethodGet, "/kafka-to-bq?reveal=true", nil)
+	assert.Equal(t, "/etc/creds.json", envVars["SINK_BIGQUERY_CREDENTIAL_PATH"])
+
+	// a template read with masked values can be written back as is.
+	tpl.Configs.EnvVars["SINK_BIGQUERY_CREDENTIAL_PATH"] = mask.Placeholder
+	code, envVars := call(t, http.MethodPut, "/kafka-to-bq", tpl)
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, mask.Placeholder, envVars["SINK_BIGQUERY_CREDENTIAL_PATH"])
+
+	stored, err := store.Get(context.Background(), "foo", "kafka-to-bq")
+	require.NoError(t, err)
+	assert.Equal(t, "/etc/creds.json", stored.Configs.EnvVars["SINK_BIGQUERY_CREDENTIAL_PATH"])
+}
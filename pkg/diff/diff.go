@@ -0,0 +1,224 @@
+package diff
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/yudai/gojsondiff"
+	"github.com/yudai/gojsondiff/formatter"
+)
+
+// Supported formats of the difference.
+const (
+	FormatDelta     = "delta"
+	FormatUnified   = "unified"
+	FormatJSONPatch = "jsonpatch"
+)
+
+// JSON compares the given JSON documents and returns the difference in
+// the gojsondiff delta format.
+func JSON(left, right []byte) (string, error) {
+	differ := gojsondiff.New()
+	compare, err := differ.Compare(left, right)
+	if err != nil {
+		return "", err
+	}
+
+	diffString, err := formatter.NewDeltaFormatter().Format(compare)
+	if err != nil {
+		return "", err
+	}
+
+	return diffString, nil
+}
+
+// Unified compares the given JSON documents and returns the difference
+// between their indented forms as a unified diff.
+func Unified(left, right []byte, fromName, toName string) (string, error) {
+	leftLines, err := indentedLines(left)
+	if err != nil {
+		return "", err
+	}
+	rightLines, err := indentedLines(right)
+	if err != nil {
+		return "", err
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        leftLines,
+		B:        rightLines,
+		FromFile: fromName,
+		ToFile:   toName,
+		Context:  3,
+	})
+}
+
+// Format compares the given JSON documents and returns the difference in
+// the given format as a JSON value. Unified diff is returned as a string.
+func Format(left, right []byte, format string) (json.RawMessage, error) {
+	switch format {
+	case FormatDelta, "":
+		d, err := JSON(left, right)
+		if err != nil {
+			return nil, err
+		}
+		return json.RawMessage(d), nil
+
+	case FormatUnified:
+		d, err := Unified(left, right, "a", "b")
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(d)
+
+	case FormatJSONPatch:
+		ops, err := JSONPatch(left, right)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(ops)
+
+	default:
+		return nil, fmt.Errorf("format must be one of '%s', '%s' or '%s', not '%s'",
+			FormatDelta, FormatUnified, FormatJSONPatch, format)
+	}
+}
+
+// indentedLines returns the lines of the JSON document indented with keys
+// sorted, so that equal documents produce equal lines.
+func indentedLines(doc []byte) ([]string, error) {
+	var v any
+	if err := json.Unmarshal(doc, &v); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return difflib.SplitLines(strings.TrimSuffix(buf.String(), "\n")), nil
+}
+
+// PatchOp is an operation of a JSON Patch (RFC 6902).
+type PatchOp struct {
+	Op    string `json:"op"`
+	Path  string `json:"path"`
+	Value any    `json:"value"`
+}
+
+// MarshalJSON omits the value of remove operations.
+func (op PatchOp) MarshalJSON() ([]byte, error) {
+	if op.Op == "remove" {
+		return json.Marshal(struct {
+			Op   string `json:"op"`
+			Path string `json:"path"`
+		}{op.Op, op.Path})
+	}
+
+	type patchOp PatchOp
+	return json.Marshal(patchOp(op))
+}
+
+// JSONPatch compares the given JSON documents and returns the JSON Patch
+// (RFC 6902) that transforms left into right. Object keys are visited in
+// sorted order so that the patch is deterministic.
+func JSONPatch(left, right []byte) ([]PatchOp, error) {
+	var l, r any
+	if err := json.Unmarshal(left, &l); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(right, &r); err != nil {
+		return nil, err
+	}
+
+	ops := []PatchOp{}
+	patchValue(&ops, "", l, r)
+	return ops, nil
+}
+
+func patchValue(ops *[]PatchOp, path string, l, r any) {
+	switch lv := l.(type) {
+	case map[string]any:
+		if rv, ok := r.(map[string]any); ok {
+			patchObject(ops, path, lv, rv)
+			return
+		}
+
+	case []any:
+		if rv, ok := r.([]any); ok {
+			patchArray(ops, path, lv, rv)
+			return
+		}
+
+	default:
+		if jsonEqual(l, r) {
+			return
+		}
+	}
+	*ops = append(*ops, PatchOp{Op: "replace", Path: path, Value: r})
+}
+
+func patchObject(ops *[]PatchOp, path string, l, r map[string]any) {
+	keys := make([]string, 0, len(l)+len(r))
+	for k := range l {
+		keys = append(keys, k)
+	}
+	for k := range r {
+		if _, found := l[k]; !found {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		child := path + "/" + escapePointer(k)
+		lv, inLeft := l[k]
+		rv, inRight := r[k]
+		switch {
+		case !inRight:
+			*ops = append(*ops, PatchOp{Op: "remove", Path: child})
+		case !inLeft:
+			*ops = append(*ops, PatchOp{Op: "add", Path: child, Value: rv})
+		default:
+			patchValue(ops, child, lv, rv)
+		}
+	}
+}
+
+// patchArray compares the items at the same index. Extra items are removed
+// from the end (so that the indices of the earlier items remain valid) or
+// appended.
+func patchArray(ops *[]PatchOp, path string, l, r []any) {
+	common := len(l)
+	if len(r) < common {
+		common = len(r)
+	}
+
+	for i := 0; i < common; i++ {
+		patchValue(ops, fmt.Sprintf("%s/%d", path, i), l[i], r[i])
+	}
+	for i := len(l) - 1; i >= common; i-- {
+		*ops = append(*ops, PatchOp{Op: "remove", Path: fmt.Sprintf("%s/%d", path, i)})
+	}
+	for i := common; i < len(r); i++ {
+		*ops = append(*ops, PatchOp{Op: "add", Path: path + "/-", Value: r[i]})
+	}
+}
+
+func jsonEqual(l, r any) bool {
+	lb, _ := json.Marshal(l)
+	rb, _ := json.Marshal(r)
+	return bytes.Equal(lb, rb)
+}
+
+// escapePointer escapes the key for use in a JSON pointer (RFC 6901).
+func escapePointer(key string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(key)
+}
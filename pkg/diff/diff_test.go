@@ -0,0 +1,59 @@
+package diff_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/odpf/dex/pkg/diff"
+)
+
+func TestJSONPatch(t *testing.T) {
+	t.Parallel()
+
+	left := []byte(`{"a": 1, "b": {"c": "x", "d/e": true}, "list": [1, 2, 3], "gone": null}`)
+	right := []byte(`{"a": 2, "b": {"c": "x", "d/e": false, "f": [1]}, "list": [1, 5], "new": "v"}`)
+
+	ops, err := diff.JSONPatch(left, right)
+	require.NoError(t, err)
+
+	got, err := json.Marshal(ops)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[
+		{"op": "replace", "path": "/a", "value": 2},
+		{"op": "replace", "path": "/b/d~1e", "value": false},
+		{"op": "add", "path": "/b/f", "value": [1]},
+		{"op": "remove", "path": "/gone"},
+		{"op": "replace", "path": "/list/1", "value": 5},
+		{"op": "remove", "path": "/list/2"},
+		{"op": "add", "path": "/new", "value": "v"}
+	]`, string(got))
+
+	ops, err = diff.JSONPatch(left, left)
+	require.NoError(t, err)
+	assert.Empty(t, ops)
+}
+
+func TestUnified(t *testing.T) {
+	t.Parallel()
+
+	got, err := diff.Unified([]byte(`{"b": 1, "a": "x"}`), []byte(`{"a": "y", "b": 1}`), "rev1", "rev2")
+	require.NoError(t, err)
+	assert.Equal(t, "--- rev1\n+++ rev2\n@@ -1,4 +1,4 @@\n {\n-  \"a\": \"x\",\n+  \"a\": \"y\",\n   \"b\": 1\n }\n", got)
+}
+
+func TestFormat(t *testing.T) {
+	t.Parallel()
+
+	_, err := diff.Format([]byte(`{}`), []byte(`{}`), "xml")
+	assert.Error(t, err)
+
+	got, err := diff.Format([]byte(`{"a": 1}`), []byte(`{"a": 2}`), diff.FormatUnified)
+	require.NoError(t, err)
+
+	var s string
+	require.NoError(t, json.Unmarshal(got, &s))
+	assert.Contains(t, s, "+  \"a\": 2")
+}
@@ -35,6 +35,18 @@ var (
 		Status:  http.StatusConflict,
 	}
 
+	ErrUnauthorized = Error{
+		Code:    "unauthorized",
+		Message: "Request is not authenticated",
+		Status:  http.StatusUnauthorized,
+	}
+
+	ErrForbidden = Error{
+		Code:    "forbidden",
+		Message: "Not allowed to perform the requested operation",
+		Status:  http.StatusForbidden,
+	}
+
 	ErrInternal = Error{
 		Code:    "internal_error",
 		Message: "Some unexpected error occurred",
@@ -45,11 +57,19 @@ var (
 // Error represents any error returned by the Entropy components along with any
 // relevant context.
 type Error struct {
-	Op      string `json:"op"`
-	Code    string `json:"code"`
-	Cause   string `json:"cause,omitempty"`
+	Op      string       `json:"op"`
+	Code    string       `json:"code"`
+	Cause   string       `json:"cause,omitempty"`
+	Message string       `json:"message"`
+	Status  int          `json:"status"`
+	Details []FieldError `json:"details,omitempty"`
+}
+
+// FieldError describes a problem with a specific field of the request.
+// Field is the path to the field (e.g., 'configs.env_vars.SINK_HTTP_SERVICE_URL').
+type FieldError struct {
+	Field   string `json:"field"`
 	Message string `json:"message"`
-	Status  int    `json:"status"`
 }
 
 // WithOp can be used to add the name of the op where the error occurred.
@@ -79,6 +99,15 @@ func (err Error) WithMsgf(format string, args ...interface{}) Error {
 	return cloned
 }
 
+// WithDetails returns a clone of the error with the field-level problems
+// added. Use this when all the problems with the request must be reported
+// at once.
+func (err Error) WithDetails(details ...FieldError) Error {
+	cloned := err.clone()
+	cloned.Details = append(append([]FieldError(nil), err.Details...), details...)
+	return cloned
+}
+
 // Is checks if 'other' is of type Error and has the same code.
 // See https://blog.golang.org/go1.13-errors.
 func (err Error) Is(other error) bool {